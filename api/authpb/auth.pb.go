@@ -11,6 +11,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/golang/protobuf/proto"
+	_ "go.etcd.io/etcd/api/v3/versionpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,18 +31,30 @@ const (
 	READ      Permission_Type = 0
 	WRITE     Permission_Type = 1
 	READWRITE Permission_Type = 2
+	// WATCH allows watching keys without granting range reads.
+	WATCH Permission_Type = 3
+	// LEASE_ATTACH allows attaching a lease to keys on put.
+	LEASE_ATTACH Permission_Type = 4
+	// DELETE allows deleting keys without granting puts.
+	DELETE Permission_Type = 5
 )
 
 var Permission_Type_name = map[int32]string{
 	0: "READ",
 	1: "WRITE",
 	2: "READWRITE",
+	3: "WATCH",
+	4: "LEASE_ATTACH",
+	5: "DELETE",
 }
 
 var Permission_Type_value = map[string]int32{
-	"READ":      0,
-	"WRITE":     1,
-	"READWRITE": 2,
+	"READ":         0,
+	"WRITE":        1,
+	"READWRITE":    2,
+	"WATCH":        3,
+	"LEASE_ATTACH": 4,
+	"DELETE":       5,
 }
 
 func (x Permission_Type) String() string {
@@ -138,12 +151,15 @@ var xxx_messageInfo_User proto.InternalMessageInfo
 
 // Permission is a single entity
 type Permission struct {
-	PermType             Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
-	Key                  []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RangeEnd             []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PermType Permission_Type `protobuf:"varint,1,opt,name=permType,proto3,enum=authpb.Permission_Type" json:"permType,omitempty"`
+	Key      []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// deny turns the permission into an explicit denial. A denied key is
+	// rejected even if another permission or role allows it.
+	Deny                 bool     `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Permission) Reset()         { *m = Permission{} }
@@ -232,29 +248,34 @@ func init() {
 func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
 
 var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xea, 0x40,
-	0x14, 0xc6, 0x3b, 0xb4, 0x70, 0xdb, 0xc3, 0x85, 0x90, 0x13, 0x72, 0x6f, 0x83, 0x49, 0x6d, 0xba,
-	0x6a, 0x5c, 0x54, 0x85, 0x8d, 0x5b, 0x8c, 0x2c, 0x5c, 0x49, 0x26, 0x18, 0x97, 0xa4, 0xa4, 0x13,
-	0x24, 0xc0, 0x4c, 0x33, 0x83, 0x31, 0x6c, 0x7c, 0x0e, 0x17, 0x3e, 0x10, 0x4b, 0x1e, 0x41, 0xf0,
-	0x45, 0x4c, 0x67, 0xf8, 0x13, 0xa2, 0xbb, 0xef, 0x7c, 0xe7, 0xfb, 0x66, 0x7e, 0x99, 0x01, 0x48,
-	0x5f, 0x16, 0xcf, 0x49, 0x2e, 0xc5, 0x42, 0x60, 0xa5, 0xd0, 0xf9, 0xa8, 0xd5, 0x1c, 0x8b, 0xb1,
-	0xd0, 0xd6, 0x65, 0xa1, 0xcc, 0x36, 0xba, 0x86, 0xfa, 0xa3, 0x62, 0xb2, 0x9b, 0x65, 0x0f, 0xf9,
-	0x62, 0x22, 0xb8, 0xc2, 0x73, 0xa8, 0x72, 0x31, 0xcc, 0x53, 0xa5, 0x5e, 0x85, 0xcc, 0x7c, 0x12,
-	0x92, 0xd8, 0xa5, 0xc0, 0x45, 0x7f, 0xe7, 0x44, 0x6f, 0xe0, 0x14, 0x15, 0x44, 0x70, 0x78, 0x3a,
-	0x67, 0x3a, 0xf1, 0x97, 0x6a, 0x8d, 0x2d, 0x70, 0x0f, 0xcd, 0x92, 0xf6, 0x0f, 0x33, 0x36, 0xa1,
-	0x2c, 0xc5, 0x8c, 0x29, 0xdf, 0x0e, 0xed, 0xd8, 0xa3, 0x66, 0xc0, 0x2b, 0xf8, 0x23, 0xcc, 0xcd,
-	0xbe, 0x13, 0x92, 0xb8, 0xda, 0xfe, 0x97, 0x18, 0xe0, 0xe4, 0x94, 0x8b, 0xee, 0x63, 0xd1, 0x07,
-	0x01, 0xe8, 0x33, 0x39, 0x9f, 0x28, 0x35, 0x11, 0x1c, 0x3b, 0xe0, 0xe6, 0x4c, 0xce, 0x07, 0xcb,
-	0xdc, 0xa0, 0xd4, 0xdb, 0xff, 0xf7, 0x27, 0x1c, 0x53, 0x49, 0xb1, 0xa6, 0x87, 0x20, 0x36, 0xc0,
-	0x9e, 0xb2, 0xe5, 0x0e, 0xb1, 0x90, 0x78, 0x06, 0x9e, 0x4c, 0xf9, 0x98, 0x0d, 0x19, 0xcf, 0x7c,
-	0xdb, 0xa0, 0x6b, 0xa3, 0xc7, 0xb3, 0xe8, 0x02, 0x1c, 0x5d, 0x73, 0xc1, 0xa1, 0xbd, 0xee, 0x5d,
-	0xc3, 0x42, 0x0f, 0xca, 0x4f, 0xf4, 0x7e, 0xd0, 0x6b, 0x10, 0xac, 0x81, 0x57, 0x98, 0x66, 0x2c,
-	0x45, 0x03, 0x70, 0xa8, 0x98, 0xb1, 0x5f, 0x9f, 0xe7, 0x06, 0x6a, 0x53, 0xb6, 0x3c, 0x62, 0xf9,
-	0xa5, 0xd0, 0x8e, 0xab, 0x6d, 0xfc, 0x09, 0x4c, 0x4f, 0x83, 0xb7, 0xfe, 0x6a, 0x13, 0x58, 0xeb,
-	0x4d, 0x60, 0xad, 0xb6, 0x01, 0x59, 0x6f, 0x03, 0xf2, 0xb9, 0x0d, 0xc8, 0xfb, 0x57, 0x60, 0x8d,
-	0x2a, 0xfa, 0x23, 0x3b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61, 0x66, 0xc6, 0x9d, 0xf4, 0x01,
-	0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0x86, 0xbd, 0xd6, 0xda, 0x96, 0xc7, 0x49, 0x10, 0xd3, 0xd0, 0xaa, 0x0e, 0xa8, 0x42, 0x27,
+	0x9f, 0xe4, 0xd6, 0x86, 0xd2, 0xab, 0x92, 0x2c, 0xa4, 0x10, 0x68, 0xd8, 0xaa, 0xe4, 0x68, 0xe4,
+	0x6a, 0x71, 0x4d, 0xe2, 0xdd, 0x65, 0xe5, 0xb6, 0xf8, 0xd2, 0x07, 0xe8, 0x13, 0x94, 0xbe, 0x47,
+	0xdf, 0x21, 0xc7, 0x3c, 0x42, 0xe3, 0x3e, 0x47, 0xa1, 0x68, 0x37, 0xb6, 0x09, 0xcd, 0xed, 0x9f,
+	0x6f, 0xfe, 0x1d, 0xfd, 0x33, 0x08, 0xa0, 0xf8, 0xbc, 0xfc, 0x94, 0x6a, 0xa3, 0x96, 0x0a, 0xdb,
+	0xb5, 0xd6, 0xd3, 0xfe, 0xe1, 0x4c, 0xcd, 0x94, 0x45, 0xc3, 0x5a, 0xb9, 0x6e, 0x3f, 0x16, 0xcb,
+	0x8f, 0xe5, 0xb0, 0xd0, 0xf3, 0xe1, 0x17, 0x61, 0xaa, 0xb9, 0x92, 0x7a, 0xba, 0x51, 0xce, 0x91,
+	0xbc, 0x82, 0x83, 0x0f, 0x95, 0x30, 0x59, 0x59, 0xbe, 0xd3, 0xcb, 0xb9, 0x92, 0x15, 0xbe, 0x80,
+	0x9e, 0x54, 0x13, 0x5d, 0x54, 0xd5, 0x57, 0x65, 0xca, 0x90, 0xc4, 0x64, 0xe0, 0x73, 0x90, 0xea,
+	0xe2, 0x9e, 0x24, 0xdf, 0x80, 0xd6, 0x4f, 0x10, 0x81, 0xca, 0x62, 0x21, 0xac, 0x63, 0x8f, 0x5b,
+	0x8d, 0x7d, 0xf0, 0xb7, 0x2f, 0x9b, 0x96, 0x6f, 0x6b, 0x3c, 0x84, 0x96, 0x51, 0xd7, 0xa2, 0x0a,
+	0xbd, 0xd8, 0x1b, 0x74, 0xb9, 0x2b, 0xf0, 0x25, 0x74, 0x94, 0xfb, 0x72, 0x48, 0x63, 0x32, 0xe8,
+	0x8d, 0x9e, 0xa6, 0x6e, 0xa5, 0xf4, 0x61, 0x2e, 0xbe, 0xb1, 0x25, 0x7f, 0x09, 0xc0, 0x85, 0x30,
+	0x8b, 0x79, 0x55, 0xef, 0x81, 0x63, 0xf0, 0xb5, 0x30, 0x8b, 0x7c, 0xa5, 0x5d, 0x94, 0x83, 0xd1,
+	0xb3, 0xcd, 0x84, 0x9d, 0x2b, 0xad, 0xdb, 0x7c, 0x6b, 0xc4, 0x00, 0xbc, 0x2b, 0xb1, 0xba, 0x8f,
+	0x58, 0x4b, 0x3c, 0x82, 0xae, 0x29, 0xe4, 0x4c, 0x4c, 0x84, 0x2c, 0x43, 0xcf, 0x45, 0xb7, 0x80,
+	0xc9, 0x12, 0x8f, 0x80, 0x96, 0x42, 0xae, 0x6c, 0x42, 0xff, 0xb8, 0xf3, 0xfd, 0x57, 0xe8, 0x8d,
+	0xd3, 0xd7, 0xdc, 0xc2, 0x44, 0x02, 0xb5, 0x33, 0x7d, 0xa0, 0x9c, 0x65, 0xa7, 0x41, 0x03, 0xbb,
+	0xd0, 0xba, 0xe4, 0x6f, 0x73, 0x16, 0x10, 0xdc, 0x87, 0x6e, 0x0d, 0x5d, 0xd9, 0x44, 0x84, 0xd6,
+	0x65, 0x96, 0x9f, 0x9c, 0x05, 0x5e, 0xbf, 0xf3, 0xd3, 0x4d, 0xc1, 0xe7, 0xb0, 0x77, 0xce, 0xb2,
+	0xf7, 0x6c, 0x92, 0xe5, 0x79, 0x76, 0x72, 0x16, 0xd0, 0x5d, 0xeb, 0x09, 0xb4, 0x4f, 0xd9, 0x39,
+	0xcb, 0x59, 0xd0, 0xda, 0xc2, 0x24, 0x07, 0xca, 0xd5, 0xb5, 0x78, 0xf4, 0xfe, 0x6f, 0x60, 0xff,
+	0x4a, 0xac, 0x76, 0x7b, 0x87, 0xcd, 0xd8, 0x1b, 0xf4, 0x46, 0xf8, 0xff, 0x45, 0xf8, 0x43, 0xe3,
+	0x71, 0x78, 0x73, 0x17, 0x35, 0x6e, 0xef, 0xa2, 0xc6, 0xcd, 0x3a, 0x22, 0xb7, 0xeb, 0x88, 0xfc,
+	0x5e, 0x47, 0xe4, 0xc7, 0x9f, 0xa8, 0x31, 0x6d, 0xdb, 0x3f, 0x65, 0xfc, 0x2f, 0x00, 0x00, 0xff,
+	0xff, 0x67, 0x43, 0x35, 0xd7, 0x77, 0x02, 0x00, 0x00,
 }
 
 func (m *UserAddOptions) Marshal() (dAtA []byte, err error) {
@@ -380,6 +401,16 @@ func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Deny {
+		i--
+		if m.Deny {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RangeEnd) > 0 {
 		i -= len(m.RangeEnd)
 		copy(dAtA[i:], m.RangeEnd)
@@ -523,6 +554,9 @@ func (m *Permission) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if m.Deny {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -931,6 +965,26 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				m.RangeEnd = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deny = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -31,7 +31,10 @@ type RequestHeader struct {
 	// username is a username that is associated with an auth token of gRPC connection
 	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	// auth_revision is a revision number of auth.authStore. It is not related to mvcc
-	AuthRevision         uint64   `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	AuthRevision uint64 `protobuf:"varint,3,opt,name=auth_revision,json=authRevision,proto3" json:"auth_revision,omitempty"`
+	// time is the unix time, in nanoseconds, at which the member proposed the
+	// request. It is only informational and is not used while applying.
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -85,6 +88,10 @@ type InternalRaftRequest struct {
 	LeaseRevoke              *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
 	Alarm                    *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
 	LeaseCheckpoint          *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
+	QuotaSet                 *QuotaSetRequest                          `protobuf:"bytes,12,opt,name=quota_set,json=quotaSet,proto3" json:"quota_set,omitempty"`
+	KeyExpire                *KeyExpireRequest                         `protobuf:"bytes,13,opt,name=key_expire,json=keyExpire,proto3" json:"key_expire,omitempty"`
+	LeaseAttach              *LeaseAttachRequest                       `protobuf:"bytes,14,opt,name=lease_attach,json=leaseAttach,proto3" json:"lease_attach,omitempty"`
+	LeaseDetach              *LeaseDetachRequest                       `protobuf:"bytes,15,opt,name=lease_detach,json=leaseDetach,proto3" json:"lease_detach,omitempty"`
 	AuthEnable               *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
 	AuthStatus               *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
@@ -238,73 +245,80 @@ func init() {
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xad, 0xd3, 0x34, 0x89, 0xc7, 0x49, 0x9a, 0x4e, 0x52, 0x3a, 0x38, 0x92, 0x71, 0x03, 0x2d,
-	0x01, 0x8a, 0x53, 0x1c, 0x78, 0xe1, 0x05, 0x5c, 0x3b, 0x4a, 0x83, 0x4a, 0x15, 0x6d, 0x0b, 0xaa,
-	0x84, 0xd0, 0x32, 0xde, 0xbd, 0xb1, 0xb7, 0x59, 0xef, 0x2e, 0x33, 0x63, 0x37, 0x7d, 0xe5, 0x91,
-	0x67, 0x40, 0xfc, 0x0c, 0x3e, 0xff, 0x43, 0x85, 0xf8, 0x28, 0xf0, 0x07, 0x20, 0xbc, 0xf0, 0x0e,
-	0xbc, 0xa3, 0xf9, 0xd8, 0x5d, 0xaf, 0x3d, 0xce, 0xdb, 0xfa, 0xde, 0x73, 0xcf, 0x39, 0x33, 0x73,
-	0xef, 0x78, 0xd0, 0x3a, 0xa3, 0x47, 0xc2, 0x0d, 0x22, 0x01, 0x2c, 0xa2, 0x61, 0x23, 0x61, 0xb1,
-	0x88, 0xf1, 0x32, 0x08, 0xcf, 0xe7, 0xc0, 0x46, 0xc0, 0x92, 0x6e, 0x75, 0xa3, 0x17, 0xf7, 0x62,
-	0x95, 0xd8, 0x91, 0x5f, 0x1a, 0x53, 0x5d, 0xcb, 0x31, 0x26, 0x52, 0x66, 0x89, 0x67, 0x3e, 0xeb,
-	0x32, 0xb9, 0x43, 0x93, 0x60, 0x67, 0x04, 0x8c, 0x07, 0x71, 0x94, 0x74, 0xd3, 0x2f, 0x83, 0xb8,
-	0x9e, 0x21, 0x06, 0x30, 0xe8, 0x02, 0xe3, 0xfd, 0x20, 0x49, 0xba, 0x63, 0x3f, 0x34, 0x6e, 0x8b,
-	0xa1, 0x15, 0x07, 0x3e, 0x1e, 0x02, 0x17, 0xb7, 0x81, 0xfa, 0xc0, 0xf0, 0x2a, 0x9a, 0x3b, 0xe8,
-	0x90, 0x52, 0xbd, 0xb4, 0x3d, 0xef, 0xcc, 0x1d, 0x74, 0x70, 0x15, 0x2d, 0x0d, 0xb9, 0x34, 0x3f,
-	0x00, 0x32, 0x57, 0x2f, 0x6d, 0x97, 0x9d, 0xec, 0x37, 0xbe, 0x81, 0x56, 0xe8, 0x50, 0xf4, 0x5d,
-	0x06, 0xa3, 0x40, 0x6a, 0x93, 0xf3, 0xb2, 0xec, 0xd6, 0xe2, 0xa7, 0xdf, 0x93, 0xf3, 0xbb, 0x8d,
-	0xd7, 0x9c, 0x65, 0x99, 0x75, 0x4c, 0xf2, 0xcd, 0xc5, 0x4f, 0x54, 0xf8, 0xe6, 0xd6, 0x0f, 0x18,
-	0xad, 0x1f, 0x98, 0x1d, 0x71, 0xe8, 0x91, 0x30, 0x06, 0xf0, 0x2e, 0x5a, 0xe8, 0x2b, 0x13, 0xc4,
-	0xaf, 0x97, 0xb6, 0x2b, 0xcd, 0xcd, 0xc6, 0xf8, 0x3e, 0x35, 0x0a, 0x3e, 0x1d, 0x03, 0x9d, 0xf2,
-	0x7b, 0x0d, 0xcd, 0x8d, 0x9a, 0xca, 0x69, 0xa5, 0x79, 0xd9, 0x4a, 0xe0, 0xcc, 0x8d, 0x9a, 0xf8,
-	0x26, 0xba, 0xc0, 0x68, 0xd4, 0x03, 0x65, 0xb9, 0xd2, 0xac, 0x4e, 0x20, 0x65, 0x2a, 0x85, 0x6b,
-	0x20, 0x7e, 0x19, 0x9d, 0x4f, 0x86, 0x82, 0xcc, 0x2b, 0x3c, 0x29, 0xe2, 0x0f, 0x87, 0xe9, 0x22,
-	0x1c, 0x09, 0xc2, 0x6d, 0xb4, 0xec, 0x43, 0x08, 0x02, 0x5c, 0x2d, 0x72, 0x41, 0x15, 0xd5, 0x8b,
-	0x45, 0x1d, 0x85, 0x28, 0x48, 0x55, 0xfc, 0x3c, 0x26, 0x05, 0xc5, 0x49, 0x44, 0x16, 0x6c, 0x82,
-	0xf7, 0x4f, 0xa2, 0x4c, 0x50, 0x9c, 0x44, 0xf8, 0x2d, 0x84, 0xbc, 0x78, 0x90, 0x50, 0x4f, 0xc8,
-	0x63, 0x58, 0x54, 0x25, 0xcf, 0x15, 0x4b, 0xda, 0x59, 0x3e, 0xad, 0x1c, 0x2b, 0xc1, 0x6f, 0xa3,
-	0x4a, 0x08, 0x94, 0x83, 0xdb, 0x63, 0x34, 0x12, 0x64, 0xc9, 0xc6, 0x70, 0x47, 0x02, 0xf6, 0x65,
-	0x3e, 0x63, 0x08, 0xb3, 0x90, 0x5c, 0xb3, 0x66, 0x60, 0x30, 0x8a, 0x8f, 0x81, 0x94, 0x6d, 0x6b,
-	0x56, 0x14, 0x8e, 0x02, 0x64, 0x6b, 0x0e, 0xf3, 0x98, 0x3c, 0x16, 0x1a, 0x52, 0x36, 0x20, 0xc8,
-	0x76, 0x2c, 0x2d, 0x99, 0xca, 0x8e, 0x45, 0x01, 0xf1, 0x03, 0xb4, 0xa6, 0x65, 0xbd, 0x3e, 0x78,
-	0xc7, 0x49, 0x1c, 0x44, 0x82, 0x54, 0x54, 0xf1, 0x0b, 0x16, 0xe9, 0x76, 0x06, 0x32, 0x34, 0x69,
-	0xb3, 0xbe, 0xee, 0x5c, 0x0c, 0x8b, 0x00, 0xdc, 0x42, 0x15, 0xd5, 0xdd, 0x10, 0xd1, 0x6e, 0x08,
-	0xe4, 0x6f, 0xeb, 0xae, 0xb6, 0x86, 0xa2, 0xbf, 0xa7, 0x00, 0xd9, 0x9e, 0xd0, 0x2c, 0x84, 0x3b,
-	0x48, 0x8d, 0x80, 0xeb, 0x07, 0x5c, 0x71, 0xfc, 0xb3, 0x68, 0xdb, 0x14, 0xc9, 0xd1, 0xd1, 0x88,
-	0x6c, 0x53, 0x68, 0x1e, 0xc3, 0xef, 0x18, 0x23, 0x5c, 0x50, 0x31, 0xe4, 0xe4, 0xbf, 0x99, 0x46,
-	0xee, 0x29, 0xc0, 0xc4, 0xca, 0xde, 0xd0, 0x8e, 0x74, 0x0e, 0xdf, 0xd5, 0x8e, 0x20, 0x12, 0x81,
-	0x47, 0x05, 0x90, 0x7f, 0x35, 0xd9, 0x4b, 0x45, 0xb2, 0x74, 0x3a, 0x5b, 0x63, 0xd0, 0xd4, 0x5a,
-	0xa1, 0x1e, 0xef, 0x99, 0x2b, 0x40, 0xde, 0x09, 0x2e, 0xf5, 0x7d, 0xf2, 0xe3, 0xd2, 0xac, 0x25,
-	0xbe, 0xc7, 0x81, 0xb5, 0x7c, 0xbf, 0xb0, 0x44, 0x13, 0xc3, 0x77, 0xd1, 0x5a, 0x4e, 0xa3, 0x87,
-	0x80, 0xfc, 0xa4, 0x99, 0x9e, 0xb7, 0x33, 0x99, 0xe9, 0x31, 0x64, 0xab, 0xb4, 0x10, 0x2e, 0xda,
-	0xea, 0x81, 0x20, 0x3f, 0x9f, 0x69, 0x6b, 0x1f, 0xc4, 0x94, 0xad, 0x7d, 0x10, 0xb8, 0x87, 0x9e,
-	0xcd, 0x69, 0xbc, 0xbe, 0x1c, 0x4b, 0x37, 0xa1, 0x9c, 0x3f, 0x8a, 0x99, 0x4f, 0x7e, 0xd1, 0x94,
-	0xaf, 0xd8, 0x29, 0xdb, 0x0a, 0x7d, 0x68, 0xc0, 0x29, 0xfb, 0x33, 0xd4, 0x9a, 0xc6, 0x0f, 0xd0,
-	0xc6, 0x98, 0x5f, 0x39, 0x4f, 0x2e, 0x8b, 0x43, 0x20, 0x4f, 0xb5, 0xc6, 0xf5, 0x19, 0xb6, 0xd5,
-	0x2c, 0xc6, 0x79, 0xdb, 0x5c, 0xa2, 0x93, 0x19, 0xfc, 0x01, 0xba, 0x9c, 0x33, 0xeb, 0xd1, 0xd4,
-	0xd4, 0xbf, 0x6a, 0xea, 0x17, 0xed, 0xd4, 0x66, 0x46, 0xc7, 0xb8, 0x31, 0x9d, 0x4a, 0xe1, 0xdb,
-	0x68, 0x35, 0x27, 0x0f, 0x03, 0x2e, 0xc8, 0x6f, 0x9a, 0xf5, 0xaa, 0x9d, 0xf5, 0x4e, 0xc0, 0x45,
-	0xa1, 0x8f, 0xd2, 0x60, 0xc6, 0x24, 0xad, 0x69, 0xa6, 0xdf, 0x67, 0x32, 0x49, 0xe9, 0x29, 0xa6,
-	0x34, 0x98, 0x1d, 0xbd, 0x62, 0x92, 0x1d, 0xf9, 0x55, 0x79, 0xd6, 0xd1, 0xcb, 0x9a, 0xc9, 0x8e,
-	0x34, 0xb1, 0xac, 0x23, 0x15, 0x8d, 0xe9, 0xc8, 0xaf, 0xcb, 0xb3, 0x3a, 0x52, 0x56, 0x59, 0x3a,
-	0x32, 0x0f, 0x17, 0x6d, 0xc9, 0x8e, 0xfc, 0xe6, 0x4c, 0x5b, 0x93, 0x1d, 0x69, 0x62, 0xf8, 0x21,
-	0xaa, 0x8e, 0xd1, 0xa8, 0x46, 0x49, 0x80, 0x0d, 0x02, 0xae, 0xfe, 0x7f, 0xbf, 0xd5, 0x9c, 0x37,
-	0x66, 0x70, 0x4a, 0xf8, 0x61, 0x86, 0x4e, 0xf9, 0xaf, 0x50, 0x7b, 0x1e, 0x0f, 0xd0, 0x66, 0xae,
-	0x65, 0x5a, 0x67, 0x4c, 0xec, 0x3b, 0x2d, 0xf6, 0xaa, 0x5d, 0x4c, 0x77, 0xc9, 0xb4, 0x1a, 0xa1,
-	0x33, 0x00, 0xf8, 0x23, 0xb4, 0xee, 0x85, 0x43, 0x2e, 0x80, 0xb9, 0xe6, 0x2d, 0xe3, 0x72, 0x10,
-	0xe4, 0x33, 0x64, 0x46, 0x60, 0xfc, 0x21, 0xd3, 0x68, 0x6b, 0xe4, 0xfb, 0x1a, 0x78, 0x0f, 0xc4,
-	0xd4, 0xad, 0x77, 0xc9, 0x9b, 0x84, 0xe0, 0x87, 0xe8, 0x4a, 0xaa, 0xa0, 0xc9, 0x5c, 0x2a, 0x04,
-	0x53, 0x2a, 0x9f, 0x23, 0x73, 0x0f, 0xda, 0x54, 0xde, 0x55, 0xb1, 0x96, 0x10, 0xcc, 0x26, 0xb4,
-	0xe1, 0x59, 0x50, 0xf8, 0x43, 0x84, 0xfd, 0xf8, 0x51, 0xd4, 0x63, 0xd4, 0x07, 0x37, 0x88, 0x8e,
-	0x62, 0x25, 0xf3, 0x85, 0x96, 0xb9, 0x56, 0x94, 0xe9, 0xa4, 0xc0, 0x83, 0xe8, 0x28, 0xb6, 0x49,
-	0xac, 0xf9, 0x13, 0x88, 0xfc, 0x31, 0x75, 0x11, 0xad, 0xec, 0x0d, 0x12, 0xf1, 0xd8, 0x01, 0x9e,
-	0xc4, 0x11, 0x87, 0xad, 0xc7, 0x68, 0xf3, 0x8c, 0xeb, 0x1b, 0x63, 0x34, 0xaf, 0xde, 0x72, 0x25,
-	0xf5, 0x96, 0x53, 0xdf, 0xf2, 0x8d, 0x97, 0xdd, 0x6a, 0xe6, 0x8d, 0x97, 0xfe, 0xc6, 0x57, 0xd1,
-	0x32, 0x0f, 0x06, 0x49, 0x08, 0xae, 0x88, 0x8f, 0x41, 0x3f, 0xf1, 0xca, 0x4e, 0x45, 0xc7, 0xee,
-	0xcb, 0x50, 0xe6, 0xe5, 0xd6, 0xc6, 0x93, 0x3f, 0x6b, 0xe7, 0x9e, 0x9c, 0xd6, 0x4a, 0x4f, 0x4f,
-	0x6b, 0xa5, 0x3f, 0x4e, 0x6b, 0xa5, 0x2f, 0xff, 0xaa, 0x9d, 0xeb, 0x2e, 0xa8, 0x97, 0xe6, 0xee,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0x36, 0x53, 0xc6, 0x0b, 0x0b, 0x00, 0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0x4b, 0x77, 0xdb, 0x44,
+	0x14, 0xc7, 0xeb, 0x24, 0x4d, 0xe2, 0x71, 0x5e, 0x9d, 0xa4, 0x74, 0x48, 0x0e, 0xc6, 0x0d, 0xb4,
+	0x04, 0x28, 0x49, 0x71, 0x68, 0x17, 0x6c, 0xc0, 0xb5, 0x43, 0x1a, 0x08, 0x3d, 0x41, 0x2d, 0x9c,
+	0x9e, 0xc3, 0xe1, 0x88, 0xb1, 0x74, 0x63, 0xab, 0x96, 0x25, 0x65, 0x34, 0x76, 0x93, 0x2d, 0x4b,
+	0x36, 0x6c, 0x80, 0xc3, 0xc7, 0xe0, 0xf9, 0x1d, 0xba, 0xe0, 0x51, 0xe0, 0x0b, 0x40, 0xd8, 0xb0,
+	0x2f, 0xec, 0x7b, 0xe6, 0x21, 0xc9, 0xb2, 0xc7, 0xd9, 0x49, 0xf7, 0xfe, 0xe7, 0xf7, 0xbf, 0xa3,
+	0xb9, 0xbe, 0x1e, 0xb4, 0xcc, 0xe8, 0x21, 0xb7, 0xbd, 0x80, 0x03, 0x0b, 0xa8, 0xbf, 0x19, 0xb1,
+	0x90, 0x87, 0x78, 0x0e, 0xb8, 0xe3, 0xc6, 0xc0, 0xfa, 0xc0, 0xa2, 0xe6, 0xea, 0x4a, 0x2b, 0x6c,
+	0x85, 0x32, 0xb1, 0x25, 0x9e, 0x94, 0x66, 0x75, 0x29, 0xd3, 0xe8, 0x48, 0x91, 0x45, 0x8e, 0x7e,
+	0xac, 0x88, 0xe4, 0x16, 0x8d, 0xbc, 0xad, 0x3e, 0xb0, 0xd8, 0x0b, 0x83, 0xa8, 0x99, 0x3c, 0x69,
+	0xc5, 0xd5, 0x54, 0xd1, 0x85, 0x6e, 0x13, 0x58, 0xdc, 0xf6, 0xa2, 0xa8, 0x39, 0xf0, 0xa2, 0x74,
+	0xeb, 0x5f, 0x14, 0xd0, 0xbc, 0x05, 0x47, 0x3d, 0x88, 0xf9, 0x6d, 0xa0, 0x2e, 0x30, 0xbc, 0x80,
+	0x26, 0xf6, 0x1a, 0xa4, 0x50, 0x29, 0x6c, 0x4c, 0x59, 0x13, 0x7b, 0x0d, 0xbc, 0x8a, 0x66, 0x7b,
+	0xb1, 0xa8, 0xbe, 0x0b, 0x64, 0xa2, 0x52, 0xd8, 0x28, 0x5a, 0xe9, 0x3b, 0xbe, 0x86, 0xe6, 0x69,
+	0x8f, 0xb7, 0x6d, 0x06, 0x7d, 0x4f, 0x98, 0x93, 0x49, 0xb1, 0xec, 0xd6, 0xcc, 0xe7, 0x3f, 0x91,
+	0xc9, 0xed, 0xcd, 0xd7, 0xad, 0x39, 0x91, 0xb5, 0x74, 0x12, 0xaf, 0xa1, 0x29, 0xee, 0x75, 0x81,
+	0x4c, 0x55, 0x0a, 0x1b, 0x93, 0x89, 0xe8, 0xa6, 0x25, 0x83, 0x6f, 0xce, 0x7c, 0x26, 0x5f, 0xaf,
+	0xaf, 0x3f, 0x59, 0x41, 0xcb, 0x7b, 0xfa, 0x7b, 0x59, 0xf4, 0x90, 0xeb, 0xea, 0xf0, 0x36, 0x9a,
+	0x6e, 0xcb, 0x0a, 0x89, 0x5b, 0x29, 0x6c, 0x94, 0xaa, 0x6b, 0x9b, 0x83, 0x5f, 0x71, 0x33, 0xb7,
+	0x09, 0x4b, 0x4b, 0x47, 0x36, 0x73, 0x05, 0x4d, 0xf4, 0xab, 0x72, 0x1b, 0xa5, 0xea, 0x45, 0x23,
+	0xc0, 0x9a, 0xe8, 0x57, 0xf1, 0x75, 0x74, 0x9e, 0xd1, 0xa0, 0x05, 0x72, 0x3f, 0xa5, 0xea, 0xea,
+	0x90, 0x52, 0xa4, 0x12, 0xb9, 0x12, 0xe2, 0x57, 0xd0, 0x64, 0xd4, 0xe3, 0x72, 0x6b, 0xa5, 0x2a,
+	0xc9, 0xeb, 0x0f, 0x7a, 0xc9, 0x26, 0x2c, 0x21, 0xc2, 0x75, 0x34, 0xe7, 0x82, 0x0f, 0x1c, 0x6c,
+	0x65, 0x72, 0x5e, 0x2e, 0xaa, 0xe4, 0x17, 0x35, 0xa4, 0x22, 0x67, 0x55, 0x72, 0xb3, 0x98, 0x30,
+	0xe4, 0xc7, 0x01, 0x99, 0x36, 0x19, 0xde, 0x3b, 0x0e, 0x52, 0x43, 0x7e, 0x1c, 0xe0, 0xb7, 0x10,
+	0x72, 0xc2, 0x6e, 0x44, 0x1d, 0x2e, 0xce, 0x68, 0x46, 0x2e, 0x79, 0x3e, 0xbf, 0xa4, 0x9e, 0xe6,
+	0x93, 0x95, 0x03, 0x4b, 0xf0, 0xdb, 0xa8, 0xe4, 0x03, 0x8d, 0xc1, 0x6e, 0x31, 0x1a, 0x70, 0x32,
+	0x6b, 0x22, 0xec, 0x0b, 0xc1, 0xae, 0xc8, 0xa7, 0x04, 0x3f, 0x0d, 0x89, 0x3d, 0x2b, 0x02, 0x83,
+	0x7e, 0xd8, 0x01, 0x52, 0x34, 0xed, 0x59, 0x22, 0x2c, 0x29, 0x48, 0xf7, 0xec, 0x67, 0x31, 0x71,
+	0x2c, 0xd4, 0xa7, 0xac, 0x4b, 0x90, 0xe9, 0x58, 0x6a, 0x22, 0x95, 0x1e, 0x8b, 0x14, 0xe2, 0xfb,
+	0x68, 0x49, 0xd9, 0x3a, 0x6d, 0x70, 0x3a, 0x51, 0xe8, 0x05, 0x9c, 0x94, 0xe4, 0xe2, 0x17, 0x0d,
+	0xd6, 0xf5, 0x54, 0xa4, 0x31, 0x49, 0x93, 0xbe, 0x61, 0x2d, 0xfa, 0x79, 0x01, 0xae, 0xa3, 0xe2,
+	0x51, 0x2f, 0xe4, 0xd4, 0x8e, 0x81, 0x93, 0x39, 0x89, 0x7c, 0x2e, 0x8f, 0xfc, 0x40, 0xa4, 0xef,
+	0xc2, 0x30, 0xeb, 0xa6, 0x35, 0x7b, 0xa4, 0x33, 0xf8, 0x1d, 0x84, 0x3a, 0x70, 0x62, 0xc3, 0x71,
+	0xe4, 0x31, 0x20, 0xf3, 0x92, 0x52, 0xce, 0x53, 0xde, 0x83, 0x93, 0x1d, 0x99, 0x1e, 0xc1, 0x14,
+	0x3b, 0x49, 0x0a, 0xef, 0x27, 0x5f, 0x97, 0x72, 0x4e, 0x9d, 0x36, 0x59, 0x18, 0xfb, 0x75, 0x6b,
+	0x52, 0x30, 0xc2, 0x52, 0x9f, 0x59, 0x25, 0x33, 0x9a, 0x0b, 0x92, 0xb6, 0x38, 0x96, 0xd6, 0x80,
+	0x33, 0x68, 0x2a, 0x89, 0x6b, 0xa8, 0x24, 0x67, 0x04, 0x04, 0xb4, 0xe9, 0x03, 0xf9, 0xd7, 0xd8,
+	0x7e, 0xb5, 0x1e, 0x6f, 0xef, 0x48, 0x41, 0xda, 0x3c, 0x34, 0x0d, 0xe1, 0x06, 0x92, 0x83, 0xc4,
+	0x76, 0xbd, 0x58, 0x32, 0x9e, 0xcc, 0x98, 0x2a, 0x12, 0x8c, 0x86, 0x52, 0xa4, 0xdd, 0x43, 0xb3,
+	0x18, 0x7e, 0x57, 0x17, 0x12, 0x73, 0xca, 0x7b, 0x31, 0xf9, 0x7f, 0x6c, 0x21, 0x77, 0xa5, 0x60,
+	0x68, 0x57, 0x37, 0x54, 0x45, 0x2a, 0x87, 0xef, 0xa8, 0x8a, 0x20, 0xe0, 0x9e, 0x43, 0x39, 0x90,
+	0xff, 0x14, 0xec, 0xe5, 0x3c, 0x2c, 0x19, 0x63, 0xb5, 0x01, 0x69, 0x52, 0x5a, 0x6e, 0x3d, 0xde,
+	0xd1, 0x83, 0x54, 0x4c, 0x56, 0x9b, 0xba, 0x2e, 0xf9, 0x79, 0x76, 0xdc, 0x16, 0x3f, 0x8c, 0x81,
+	0xd5, 0x5c, 0x37, 0xb7, 0x45, 0x1d, 0xc3, 0x77, 0xd0, 0x52, 0x86, 0x51, 0xd3, 0x82, 0xfc, 0xa2,
+	0x48, 0x2f, 0x98, 0x49, 0x7a, 0xcc, 0x68, 0xd8, 0x02, 0xcd, 0x85, 0xf3, 0x65, 0xb5, 0x80, 0x93,
+	0x5f, 0xcf, 0x2c, 0x6b, 0x37, 0x6d, 0xf6, 0xac, 0xac, 0x5d, 0xe0, 0xb8, 0x85, 0x9e, 0xcd, 0x30,
+	0x4e, 0x5b, 0xcc, 0x2f, 0x3b, 0xa2, 0x71, 0xfc, 0x30, 0x64, 0x2e, 0xf9, 0x4d, 0x21, 0x5f, 0x35,
+	0x23, 0xeb, 0x52, 0x7d, 0xa0, 0xc5, 0x09, 0xfd, 0x19, 0x6a, 0x4c, 0xe3, 0xfb, 0x68, 0x65, 0xa0,
+	0x5e, 0x31, 0x78, 0x6c, 0x16, 0xfa, 0x40, 0x1e, 0x2b, 0x8f, 0xab, 0x63, 0xca, 0x96, 0x43, 0x2b,
+	0xcc, 0xda, 0xe6, 0x02, 0x1d, 0xce, 0xe0, 0x8f, 0xd1, 0xc5, 0x8c, 0xac, 0x66, 0x98, 0x42, 0xff,
+	0xae, 0xd0, 0x2f, 0x99, 0xd1, 0x7a, 0x98, 0x0d, 0xb0, 0x31, 0x1d, 0x49, 0xe1, 0xdb, 0x68, 0x21,
+	0x83, 0xfb, 0x5e, 0xcc, 0xc9, 0x1f, 0x8a, 0x7a, 0xd9, 0x4c, 0xdd, 0xf7, 0x62, 0x9e, 0xeb, 0xa3,
+	0x24, 0x98, 0x92, 0x44, 0x69, 0x8a, 0xf4, 0xe7, 0x58, 0x92, 0xb0, 0x1e, 0x21, 0x25, 0xc1, 0xf4,
+	0xe8, 0x25, 0x49, 0x74, 0xe4, 0xb7, 0xc5, 0x71, 0x47, 0x2f, 0xd6, 0x0c, 0x77, 0xa4, 0x8e, 0xa5,
+	0x1d, 0x29, 0x31, 0xba, 0x23, 0xbf, 0x2b, 0x8e, 0xeb, 0x48, 0xb1, 0xca, 0xd0, 0x91, 0x59, 0x38,
+	0x5f, 0x96, 0xe8, 0xc8, 0xef, 0xcf, 0x2c, 0x6b, 0xb8, 0x23, 0x75, 0x0c, 0x3f, 0x40, 0xab, 0x03,
+	0x18, 0xd9, 0x28, 0x11, 0xb0, 0xae, 0x17, 0xcb, 0x5b, 0xcc, 0x0f, 0x8a, 0x79, 0x6d, 0x0c, 0x53,
+	0xc8, 0x0f, 0x52, 0x75, 0xc2, 0xbf, 0x44, 0xcd, 0x79, 0xdc, 0x45, 0x6b, 0x99, 0x97, 0x6e, 0x9d,
+	0x01, 0xb3, 0x1f, 0x95, 0xd9, 0x6b, 0x66, 0x33, 0xd5, 0x25, 0xa3, 0x6e, 0x84, 0x8e, 0x11, 0xe0,
+	0x4f, 0xd1, 0xb2, 0xe3, 0xf7, 0x62, 0x0e, 0xcc, 0xd6, 0x57, 0x42, 0xf9, 0x17, 0xf5, 0x25, 0xd2,
+	0x3f, 0x81, 0xc1, 0xfb, 0xe0, 0x66, 0x5d, 0x29, 0x3f, 0x52, 0xc2, 0xd1, 0x3f, 0xab, 0x1b, 0xd6,
+	0x05, 0x67, 0x58, 0x82, 0x1f, 0xa0, 0x4b, 0x89, 0x83, 0x82, 0x89, 0xbf, 0x1d, 0x26, 0x5d, 0xbe,
+	0x42, 0x7a, 0x0e, 0x9a, 0x5c, 0xde, 0x97, 0xb1, 0x1a, 0xe7, 0xcc, 0x64, 0xb4, 0xe2, 0x18, 0x54,
+	0xf8, 0x13, 0x84, 0xdd, 0xf0, 0x61, 0xd0, 0x62, 0xd4, 0x05, 0xdb, 0x0b, 0x0e, 0x43, 0x69, 0xf3,
+	0xb5, 0xb2, 0xb9, 0x92, 0xb7, 0x69, 0x24, 0xc2, 0xbd, 0xe0, 0x30, 0x34, 0x59, 0x2c, 0xb9, 0x43,
+	0x8a, 0xec, 0xd6, 0xb9, 0x88, 0xe6, 0x77, 0xba, 0x11, 0x3f, 0xb1, 0x20, 0x8e, 0xc2, 0x20, 0x86,
+	0xf5, 0x13, 0xb4, 0x76, 0xc6, 0xf8, 0xc6, 0x18, 0x4d, 0xc9, 0x1b, 0x71, 0x41, 0xde, 0x88, 0xe5,
+	0xb3, 0xb8, 0x29, 0xa7, 0x53, 0x4d, 0xdf, 0x94, 0x93, 0x77, 0x7c, 0x19, 0xcd, 0xc5, 0x5e, 0x37,
+	0xf2, 0xc1, 0xe6, 0x61, 0x07, 0xd4, 0x45, 0xb9, 0x68, 0x95, 0x54, 0xec, 0x9e, 0x08, 0xa5, 0xb5,
+	0xdc, 0x5a, 0x79, 0xf4, 0x77, 0xf9, 0xdc, 0xa3, 0xd3, 0x72, 0xe1, 0xf1, 0x69, 0xb9, 0xf0, 0xd7,
+	0x69, 0xb9, 0xf0, 0xcd, 0x3f, 0xe5, 0x73, 0xcd, 0x69, 0x79, 0x61, 0xdf, 0x7e, 0x1a, 0x00, 0x00,
+	0xff, 0xff, 0x40, 0xba, 0x9f, 0xc8, 0x52, 0x0c, 0x00, 0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -331,6 +345,11 @@ func (m *RequestHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Time != 0 {
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AuthRevision != 0 {
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRevision))
 		i--
@@ -669,6 +688,54 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.LeaseDetach != nil {
+		{
+			size, err := m.LeaseDetach.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.LeaseAttach != nil {
+		{
+			size, err := m.LeaseAttach.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.KeyExpire != nil {
+		{
+			size, err := m.KeyExpire.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.QuotaSet != nil {
+		{
+			size, err := m.QuotaSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.LeaseCheckpoint != nil {
 		{
 			size, err := m.LeaseCheckpoint.MarshalToSizedBuffer(dAtA[:i])
@@ -899,6 +966,9 @@ func (m *RequestHeader) Size() (n int) {
 	if m.AuthRevision != 0 {
 		n += 1 + sovRaftInternal(uint64(m.AuthRevision))
 	}
+	if m.Time != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Time))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -954,6 +1024,22 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.LeaseCheckpoint.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.QuotaSet != nil {
+		l = m.QuotaSet.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.KeyExpire != nil {
+		l = m.KeyExpire.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.LeaseAttach != nil {
+		l = m.LeaseAttach.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.LeaseDetach != nil {
+		l = m.LeaseDetach.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1185,6 +1271,25 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftInternal(dAtA[iNdEx:])
@@ -1615,6 +1720,150 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaSet == nil {
+				m.QuotaSet = &QuotaSetRequest{}
+			}
+			if err := m.QuotaSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyExpire", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyExpire == nil {
+				m.KeyExpire = &KeyExpireRequest{}
+			}
+			if err := m.KeyExpire.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseAttach", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseAttach == nil {
+				m.LeaseAttach = &LeaseAttachRequest{}
+			}
+			if err := m.LeaseAttach.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseDetach", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseDetach == nil {
+				m.LeaseDetach = &LeaseDetachRequest{}
+			}
+			if err := m.LeaseDetach.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
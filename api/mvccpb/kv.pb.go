@@ -29,16 +29,19 @@ type Event_EventType int32
 const (
 	PUT    Event_EventType = 0
 	DELETE Event_EventType = 1
+	LEASE  Event_EventType = 2
 )
 
 var Event_EventType_name = map[int32]string{
 	0: "PUT",
 	1: "DELETE",
+	2: "LEASE",
 }
 
 var Event_EventType_value = map[string]int32{
 	"PUT":    0,
 	"DELETE": 1,
+	"LEASE":  2,
 }
 
 func (x Event_EventType) String() string {
@@ -65,7 +68,10 @@ type KeyValue struct {
 	// lease is the ID of the lease that attached to key.
 	// When the attached lease expires, the key will be deleted.
 	// If lease is 0, then no lease is attached to the key.
-	Lease                int64    `protobuf:"varint,6,opt,name=lease,proto3" json:"lease,omitempty"`
+	Lease int64 `protobuf:"varint,6,opt,name=lease,proto3" json:"lease,omitempty"`
+	// expire_time is the unix time, in seconds, after which the key is deleted.
+	// If expire_time is 0, then the key does not expire.
+	ExpireTime           int64    `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -107,13 +113,17 @@ var xxx_messageInfo_KeyValue proto.InternalMessageInfo
 type Event struct {
 	// type is the kind of event. If type is a PUT, it indicates
 	// new data has been stored to the key. If type is a DELETE,
-	// it indicates the key was deleted.
+	// it indicates the key was deleted. If type is a LEASE, it
+	// indicates only the lease of the key was changed; its value,
+	// version and modification revision are unchanged.
 	Type Event_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=mvccpb.Event_EventType" json:"type,omitempty"`
 	// kv holds the KeyValue for the event.
 	// A PUT event contains current kv pair.
 	// A PUT event with kv.Version=1 indicates the creation of a key.
 	// A DELETE/EXPIRE event contains the deleted key with
 	// its modification revision set to the revision of deletion.
+	// A LEASE event contains the kv pair with its new lease and
+	// its modification revision set to the revision of the change.
 	Kv *KeyValue `protobuf:"bytes,2,opt,name=kv,proto3" json:"kv,omitempty"`
 	// prev_kv holds the key-value pair before the event happens.
 	PrevKv               *KeyValue `protobuf:"bytes,3,opt,name=prev_kv,json=prevKv,proto3" json:"prev_kv,omitempty"`
@@ -164,26 +174,28 @@ func init() {
 func init() { proto.RegisterFile("kv.proto", fileDescriptor_2216fe83c9c12408) }
 
 var fileDescriptor_2216fe83c9c12408 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0x86, 0x3b, 0x14, 0x0a, 0x3e, 0x08, 0x36, 0x13, 0x12, 0x27, 0x2e, 0x26, 0x95, 0x8d, 0x18,
-	0x13, 0x4c, 0xf0, 0x06, 0xc6, 0xae, 0x70, 0x61, 0x1a, 0x74, 0x4b, 0x4a, 0x79, 0x21, 0xa4, 0x94,
-	0x69, 0x4a, 0x9d, 0xa4, 0x37, 0x71, 0xef, 0xde, 0x73, 0xb0, 0xe4, 0x08, 0x52, 0x2f, 0x62, 0xfa,
-	0xc6, 0xe2, 0xc6, 0xcd, 0xe4, 0xfd, 0xff, 0xff, 0x65, 0xe6, 0x7f, 0x03, 0x9d, 0x58, 0x8f, 0xd3,
-	0x4c, 0xe5, 0x8a, 0x3b, 0x89, 0x8e, 0xa2, 0x74, 0x71, 0x39, 0x58, 0xa9, 0x95, 0x22, 0xeb, 0xae,
-	0x9a, 0x4c, 0x3a, 0xfc, 0x64, 0xd0, 0x99, 0x62, 0xf1, 0x1a, 0x6e, 0xde, 0x90, 0xbb, 0x60, 0xc7,
-	0x58, 0x08, 0xe6, 0xb1, 0x51, 0x2f, 0xa8, 0x46, 0x7e, 0x0d, 0xe7, 0x51, 0x86, 0x61, 0x8e, 0xf3,
-	0x0c, 0xf5, 0x7a, 0xb7, 0x56, 0x5b, 0xd1, 0xf0, 0xd8, 0xc8, 0x0e, 0xfa, 0xc6, 0x0e, 0x7e, 0x5d,
-	0x7e, 0x05, 0xbd, 0x44, 0x2d, 0xff, 0x28, 0x9b, 0xa8, 0x6e, 0xa2, 0x96, 0x27, 0x44, 0x40, 0x5b,
-	0x63, 0x46, 0x69, 0x93, 0xd2, 0x5a, 0xf2, 0x01, 0xb4, 0x74, 0x55, 0x40, 0xb4, 0xe8, 0x65, 0x23,
-	0x2a, 0x77, 0x83, 0xe1, 0x0e, 0x85, 0x43, 0xb4, 0x11, 0xc3, 0x0f, 0x06, 0x2d, 0x5f, 0xe3, 0x36,
-	0xe7, 0xb7, 0xd0, 0xcc, 0x8b, 0x14, 0xa9, 0x6e, 0x7f, 0x72, 0x31, 0x36, 0x7b, 0x8e, 0x29, 0x34,
-	0xe7, 0xac, 0x48, 0x31, 0x20, 0x88, 0x7b, 0xd0, 0x88, 0x35, 0x75, 0xef, 0x4e, 0xdc, 0x1a, 0xad,
-	0x17, 0x0f, 0x1a, 0xb1, 0xe6, 0x37, 0xd0, 0x4e, 0x33, 0xd4, 0xf3, 0x58, 0x53, 0xf9, 0xff, 0x30,
-	0xa7, 0x02, 0xa6, 0x7a, 0xe8, 0xc1, 0xd9, 0xe9, 0x7e, 0xde, 0x06, 0xfb, 0xf9, 0x65, 0xe6, 0x5a,
-	0x1c, 0xc0, 0x79, 0xf4, 0x9f, 0xfc, 0x99, 0xef, 0xb2, 0x07, 0xb1, 0x3f, 0x4a, 0xeb, 0x70, 0x94,
-	0xd6, 0xbe, 0x94, 0xec, 0x50, 0x4a, 0xf6, 0x55, 0x4a, 0xf6, 0xfe, 0x2d, 0xad, 0x85, 0x43, 0xff,
-	0x7e, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x45, 0x92, 0x5d, 0xa1, 0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4d, 0x6e, 0xe2, 0x40,
+	0x10, 0x85, 0xdd, 0x36, 0xb6, 0xa1, 0x40, 0x8c, 0xd5, 0x42, 0x9a, 0xd6, 0x2c, 0x3c, 0x0c, 0x9b,
+	0x21, 0x42, 0x22, 0x12, 0x39, 0x41, 0xa2, 0x78, 0x05, 0x8b, 0xc8, 0x71, 0xb2, 0x45, 0xfc, 0x94,
+	0x90, 0x65, 0x4c, 0x5b, 0xc6, 0x69, 0xc5, 0x37, 0xc9, 0x29, 0x72, 0x0e, 0x76, 0xe1, 0x08, 0x81,
+	0x5c, 0x24, 0x72, 0x75, 0x20, 0x9b, 0x6c, 0xac, 0x7a, 0xef, 0x7d, 0x72, 0xf7, 0xab, 0x86, 0x7a,
+	0xa2, 0x86, 0x59, 0x2e, 0x0b, 0xc9, 0x9d, 0x54, 0x2d, 0x16, 0xd9, 0xfc, 0x4f, 0x67, 0x25, 0x57,
+	0x92, 0xac, 0xcb, 0x6a, 0xd2, 0x69, 0xef, 0x8d, 0x41, 0x7d, 0x8c, 0xe5, 0xe3, 0x6c, 0xfd, 0x84,
+	0xdc, 0x03, 0x2b, 0xc1, 0x52, 0xb0, 0x2e, 0xeb, 0xb7, 0xc2, 0x6a, 0xe4, 0xff, 0xe1, 0xd7, 0x22,
+	0xc7, 0x59, 0x81, 0xd3, 0x1c, 0x55, 0xbc, 0x8d, 0xe5, 0x46, 0x98, 0x5d, 0xd6, 0xb7, 0xc2, 0xb6,
+	0xb6, 0xc3, 0x2f, 0x97, 0xff, 0x83, 0x56, 0x2a, 0x97, 0xdf, 0x94, 0x45, 0x54, 0x33, 0x95, 0xcb,
+	0x33, 0x22, 0xc0, 0x55, 0x98, 0x53, 0x5a, 0xa3, 0xf4, 0x24, 0x79, 0x07, 0x6c, 0x55, 0x5d, 0x40,
+	0xd8, 0x74, 0xb2, 0x16, 0x95, 0xbb, 0xc6, 0xd9, 0x16, 0x85, 0x43, 0xb4, 0x16, 0xfc, 0x2f, 0x34,
+	0xf1, 0x39, 0x8b, 0x73, 0x9c, 0x16, 0x71, 0x8a, 0xc2, 0xa5, 0x0c, 0xb4, 0x15, 0xc5, 0x29, 0xf6,
+	0x5e, 0x19, 0xd8, 0x81, 0xc2, 0x4d, 0xc1, 0x07, 0x50, 0x2b, 0xca, 0x0c, 0xa9, 0x4f, 0x7b, 0xf4,
+	0x7b, 0xa8, 0x17, 0x31, 0xa4, 0x50, 0x7f, 0xa3, 0x32, 0xc3, 0x90, 0x20, 0xde, 0x05, 0x33, 0x51,
+	0x54, 0xae, 0x39, 0xf2, 0x4e, 0xe8, 0x69, 0x33, 0xa1, 0x99, 0x28, 0x7e, 0x01, 0x6e, 0x96, 0xa3,
+	0x9a, 0x26, 0x8a, 0xda, 0xfd, 0x84, 0x39, 0x15, 0x30, 0x56, 0xbd, 0x01, 0x34, 0xce, 0xff, 0xe7,
+	0x2e, 0x58, 0x77, 0x0f, 0x91, 0x67, 0x70, 0x00, 0xe7, 0x36, 0x98, 0x04, 0x51, 0xe0, 0x31, 0xde,
+	0x00, 0x7b, 0x12, 0x5c, 0xdf, 0x07, 0x9e, 0x79, 0x23, 0x76, 0x07, 0xdf, 0xd8, 0x1f, 0x7c, 0x63,
+	0x77, 0xf4, 0xd9, 0xfe, 0xe8, 0xb3, 0xf7, 0xa3, 0xcf, 0x5e, 0x3e, 0x7c, 0x63, 0xee, 0xd0, 0x1b,
+	0x5d, 0x7d, 0x06, 0x00, 0x00, 0xff, 0xff, 0x97, 0x46, 0xe6, 0x0a, 0xcd, 0x01, 0x00, 0x00,
 }
 
 func (m *KeyValue) Marshal() (dAtA []byte, err error) {
@@ -210,6 +222,11 @@ func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintKv(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Lease != 0 {
 		i = encodeVarintKv(dAtA, i, uint64(m.Lease))
 		i--
@@ -340,6 +357,9 @@ func (m *KeyValue) Size() (n int) {
 	if m.Lease != 0 {
 		n += 1 + sovKv(uint64(m.Lease))
 	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovKv(uint64(m.ExpireTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -548,6 +568,25 @@ func (m *KeyValue) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKv(dAtA[iNdEx:])
@@ -29,13 +29,29 @@ var (
 	ErrGRPCDuplicateKey            = status.New(codes.InvalidArgument, "etcdserver: duplicate key given in txn request").Err()
 	ErrGRPCInvalidClientAPIVersion = status.New(codes.InvalidArgument, "etcdserver: invalid client api version").Err()
 	ErrGRPCInvalidSortOption       = status.New(codes.InvalidArgument, "etcdserver: invalid sort option").Err()
+	ErrGRPCInvalidContinueToken    = status.New(codes.InvalidArgument, "etcdserver: invalid continue token").Err()
+	ErrGRPCInvalidBaseRevision     = status.New(codes.InvalidArgument, "etcdserver: incremental snapshot base revision must be positive").Err()
+	ErrGRPCInvalidKeyTTL           = status.New(codes.InvalidArgument, "etcdserver: key ttl or expire time is negative").Err()
+	ErrGRPCInvalidHistoryRange     = status.New(codes.InvalidArgument, "etcdserver: invalid history revision range or limit").Err()
 	ErrGRPCCompacted               = status.New(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted").Err()
 	ErrGRPCFutureRev               = status.New(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision").Err()
 	ErrGRPCNoSpace                 = status.New(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded").Err()
 
-	ErrGRPCLeaseNotFound    = status.New(codes.NotFound, "etcdserver: requested lease not found").Err()
-	ErrGRPCLeaseExist       = status.New(codes.FailedPrecondition, "etcdserver: lease already exists").Err()
-	ErrGRPCLeaseTTLTooLarge = status.New(codes.OutOfRange, "etcdserver: too large lease TTL").Err()
+	ErrGRPCQuotaPrefixEmpty    = status.New(codes.InvalidArgument, "etcdserver: quota prefix is not provided").Err()
+	ErrGRPCInvalidQuota        = status.New(codes.InvalidArgument, "etcdserver: quota limit is negative").Err()
+	ErrGRPCQuotaNotFound       = status.New(codes.NotFound, "etcdserver: quota not found").Err()
+	ErrGRPCPrefixQuotaExceeded = status.New(codes.ResourceExhausted, "etcdserver: prefix quota exceeded").Err()
+	ErrGRPCQuotaNotSupported   = status.New(codes.FailedPrecondition, "etcdserver: prefix quotas are not supported by the cluster version").Err()
+
+	ErrGRPCRetentionNotSupported = status.New(codes.FailedPrecondition, "etcdserver: compaction retention is not supported by the cluster version").Err()
+
+	ErrGRPCLeaseNotFound       = status.New(codes.NotFound, "etcdserver: requested lease not found").Err()
+	ErrGRPCLeaseExist          = status.New(codes.FailedPrecondition, "etcdserver: lease already exists").Err()
+	ErrGRPCLeaseTTLTooLarge    = status.New(codes.OutOfRange, "etcdserver: too large lease TTL").Err()
+	ErrGRPCParentLeaseNotFound = status.New(codes.NotFound, "etcdserver: parent lease not found").Err()
+	ErrGRPCAttachNotSupported  = status.New(codes.FailedPrecondition, "etcdserver: lease attach and detach are not supported by the cluster version").Err()
+	ErrGRPCExpiryNotSupported  = status.New(codes.FailedPrecondition, "etcdserver: key expiry is not supported by the cluster version").Err()
+	ErrGRPCParentNotSupported  = status.New(codes.FailedPrecondition, "etcdserver: parent leases are not supported by the cluster version").Err()
 
 	ErrGRPCWatchCanceled = status.New(codes.Canceled, "etcdserver: watch canceled").Err()
 
@@ -51,23 +67,24 @@ var (
 	ErrGRPCRequestTooLarge        = status.New(codes.InvalidArgument, "etcdserver: request is too large").Err()
 	ErrGRPCRequestTooManyRequests = status.New(codes.ResourceExhausted, "etcdserver: too many requests").Err()
 
-	ErrGRPCRootUserNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not exist").Err()
-	ErrGRPCRootRoleNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not have root role").Err()
-	ErrGRPCUserAlreadyExist     = status.New(codes.FailedPrecondition, "etcdserver: user name already exists").Err()
-	ErrGRPCUserEmpty            = status.New(codes.InvalidArgument, "etcdserver: user name is empty").Err()
-	ErrGRPCUserNotFound         = status.New(codes.FailedPrecondition, "etcdserver: user name not found").Err()
-	ErrGRPCRoleAlreadyExist     = status.New(codes.FailedPrecondition, "etcdserver: role name already exists").Err()
-	ErrGRPCRoleNotFound         = status.New(codes.FailedPrecondition, "etcdserver: role name not found").Err()
-	ErrGRPCRoleEmpty            = status.New(codes.InvalidArgument, "etcdserver: role name is empty").Err()
-	ErrGRPCAuthFailed           = status.New(codes.InvalidArgument, "etcdserver: authentication failed, invalid user ID or password").Err()
-	ErrGRPCPermissionNotGiven   = status.New(codes.InvalidArgument, "etcdserver: permission not given").Err()
-	ErrGRPCPermissionDenied     = status.New(codes.PermissionDenied, "etcdserver: permission denied").Err()
-	ErrGRPCRoleNotGranted       = status.New(codes.FailedPrecondition, "etcdserver: role is not granted to the user").Err()
-	ErrGRPCPermissionNotGranted = status.New(codes.FailedPrecondition, "etcdserver: permission is not granted to the role").Err()
-	ErrGRPCAuthNotEnabled       = status.New(codes.FailedPrecondition, "etcdserver: authentication is not enabled").Err()
-	ErrGRPCInvalidAuthToken     = status.New(codes.Unauthenticated, "etcdserver: invalid auth token").Err()
-	ErrGRPCInvalidAuthMgmt      = status.New(codes.InvalidArgument, "etcdserver: invalid auth management").Err()
-	ErrGRPCAuthOldRevision      = status.New(codes.InvalidArgument, "etcdserver: revision of auth store is old").Err()
+	ErrGRPCRootUserNotExist       = status.New(codes.FailedPrecondition, "etcdserver: root user does not exist").Err()
+	ErrGRPCRootRoleNotExist       = status.New(codes.FailedPrecondition, "etcdserver: root user does not have root role").Err()
+	ErrGRPCUserAlreadyExist       = status.New(codes.FailedPrecondition, "etcdserver: user name already exists").Err()
+	ErrGRPCUserEmpty              = status.New(codes.InvalidArgument, "etcdserver: user name is empty").Err()
+	ErrGRPCUserNotFound           = status.New(codes.FailedPrecondition, "etcdserver: user name not found").Err()
+	ErrGRPCRoleAlreadyExist       = status.New(codes.FailedPrecondition, "etcdserver: role name already exists").Err()
+	ErrGRPCRoleNotFound           = status.New(codes.FailedPrecondition, "etcdserver: role name not found").Err()
+	ErrGRPCRoleEmpty              = status.New(codes.InvalidArgument, "etcdserver: role name is empty").Err()
+	ErrGRPCAuthFailed             = status.New(codes.InvalidArgument, "etcdserver: authentication failed, invalid user ID or password").Err()
+	ErrGRPCPermissionNotGiven     = status.New(codes.InvalidArgument, "etcdserver: permission not given").Err()
+	ErrGRPCPermissionDenied       = status.New(codes.PermissionDenied, "etcdserver: permission denied").Err()
+	ErrGRPCRoleNotGranted         = status.New(codes.FailedPrecondition, "etcdserver: role is not granted to the user").Err()
+	ErrGRPCPermissionNotGranted   = status.New(codes.FailedPrecondition, "etcdserver: permission is not granted to the role").Err()
+	ErrGRPCPermissionNotSupported = status.New(codes.FailedPrecondition, "etcdserver: permission is not supported by the cluster version").Err()
+	ErrGRPCAuthNotEnabled         = status.New(codes.FailedPrecondition, "etcdserver: authentication is not enabled").Err()
+	ErrGRPCInvalidAuthToken       = status.New(codes.Unauthenticated, "etcdserver: invalid auth token").Err()
+	ErrGRPCInvalidAuthMgmt        = status.New(codes.InvalidArgument, "etcdserver: invalid auth management").Err()
+	ErrGRPCAuthOldRevision        = status.New(codes.InvalidArgument, "etcdserver: revision of auth store is old").Err()
 
 	ErrGRPCNoLeader                   = status.New(codes.Unavailable, "etcdserver: no leader").Err()
 	ErrGRPCNotLeader                  = status.New(codes.FailedPrecondition, "etcdserver: not leader").Err()
@@ -98,16 +115,32 @@ var (
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
 
-		ErrorDesc(ErrGRPCTooManyOps):        ErrGRPCTooManyOps,
-		ErrorDesc(ErrGRPCDuplicateKey):      ErrGRPCDuplicateKey,
-		ErrorDesc(ErrGRPCInvalidSortOption): ErrGRPCInvalidSortOption,
-		ErrorDesc(ErrGRPCCompacted):         ErrGRPCCompacted,
-		ErrorDesc(ErrGRPCFutureRev):         ErrGRPCFutureRev,
-		ErrorDesc(ErrGRPCNoSpace):           ErrGRPCNoSpace,
+		ErrorDesc(ErrGRPCTooManyOps):           ErrGRPCTooManyOps,
+		ErrorDesc(ErrGRPCDuplicateKey):         ErrGRPCDuplicateKey,
+		ErrorDesc(ErrGRPCInvalidSortOption):    ErrGRPCInvalidSortOption,
+		ErrorDesc(ErrGRPCInvalidContinueToken): ErrGRPCInvalidContinueToken,
+		ErrorDesc(ErrGRPCInvalidBaseRevision):  ErrGRPCInvalidBaseRevision,
+		ErrorDesc(ErrGRPCInvalidKeyTTL):        ErrGRPCInvalidKeyTTL,
+		ErrorDesc(ErrGRPCInvalidHistoryRange):  ErrGRPCInvalidHistoryRange,
+		ErrorDesc(ErrGRPCCompacted):            ErrGRPCCompacted,
+		ErrorDesc(ErrGRPCFutureRev):            ErrGRPCFutureRev,
+		ErrorDesc(ErrGRPCNoSpace):              ErrGRPCNoSpace,
+
+		ErrorDesc(ErrGRPCQuotaPrefixEmpty):    ErrGRPCQuotaPrefixEmpty,
+		ErrorDesc(ErrGRPCInvalidQuota):        ErrGRPCInvalidQuota,
+		ErrorDesc(ErrGRPCQuotaNotFound):       ErrGRPCQuotaNotFound,
+		ErrorDesc(ErrGRPCPrefixQuotaExceeded): ErrGRPCPrefixQuotaExceeded,
+		ErrorDesc(ErrGRPCQuotaNotSupported):   ErrGRPCQuotaNotSupported,
+
+		ErrorDesc(ErrGRPCRetentionNotSupported): ErrGRPCRetentionNotSupported,
 
-		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
-		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
-		ErrorDesc(ErrGRPCLeaseTTLTooLarge): ErrGRPCLeaseTTLTooLarge,
+		ErrorDesc(ErrGRPCLeaseNotFound):       ErrGRPCLeaseNotFound,
+		ErrorDesc(ErrGRPCLeaseExist):          ErrGRPCLeaseExist,
+		ErrorDesc(ErrGRPCLeaseTTLTooLarge):    ErrGRPCLeaseTTLTooLarge,
+		ErrorDesc(ErrGRPCParentLeaseNotFound): ErrGRPCParentLeaseNotFound,
+		ErrorDesc(ErrGRPCAttachNotSupported):  ErrGRPCAttachNotSupported,
+		ErrorDesc(ErrGRPCExpiryNotSupported):  ErrGRPCExpiryNotSupported,
+		ErrorDesc(ErrGRPCParentNotSupported):  ErrGRPCParentNotSupported,
 
 		ErrorDesc(ErrGRPCMemberExist):            ErrGRPCMemberExist,
 		ErrorDesc(ErrGRPCPeerURLExist):           ErrGRPCPeerURLExist,
@@ -121,22 +154,23 @@ var (
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
 
-		ErrorDesc(ErrGRPCRootUserNotExist):     ErrGRPCRootUserNotExist,
-		ErrorDesc(ErrGRPCRootRoleNotExist):     ErrGRPCRootRoleNotExist,
-		ErrorDesc(ErrGRPCUserAlreadyExist):     ErrGRPCUserAlreadyExist,
-		ErrorDesc(ErrGRPCUserEmpty):            ErrGRPCUserEmpty,
-		ErrorDesc(ErrGRPCUserNotFound):         ErrGRPCUserNotFound,
-		ErrorDesc(ErrGRPCRoleAlreadyExist):     ErrGRPCRoleAlreadyExist,
-		ErrorDesc(ErrGRPCRoleNotFound):         ErrGRPCRoleNotFound,
-		ErrorDesc(ErrGRPCRoleEmpty):            ErrGRPCRoleEmpty,
-		ErrorDesc(ErrGRPCAuthFailed):           ErrGRPCAuthFailed,
-		ErrorDesc(ErrGRPCPermissionDenied):     ErrGRPCPermissionDenied,
-		ErrorDesc(ErrGRPCRoleNotGranted):       ErrGRPCRoleNotGranted,
-		ErrorDesc(ErrGRPCPermissionNotGranted): ErrGRPCPermissionNotGranted,
-		ErrorDesc(ErrGRPCAuthNotEnabled):       ErrGRPCAuthNotEnabled,
-		ErrorDesc(ErrGRPCInvalidAuthToken):     ErrGRPCInvalidAuthToken,
-		ErrorDesc(ErrGRPCInvalidAuthMgmt):      ErrGRPCInvalidAuthMgmt,
-		ErrorDesc(ErrGRPCAuthOldRevision):      ErrGRPCAuthOldRevision,
+		ErrorDesc(ErrGRPCRootUserNotExist):       ErrGRPCRootUserNotExist,
+		ErrorDesc(ErrGRPCRootRoleNotExist):       ErrGRPCRootRoleNotExist,
+		ErrorDesc(ErrGRPCUserAlreadyExist):       ErrGRPCUserAlreadyExist,
+		ErrorDesc(ErrGRPCUserEmpty):              ErrGRPCUserEmpty,
+		ErrorDesc(ErrGRPCUserNotFound):           ErrGRPCUserNotFound,
+		ErrorDesc(ErrGRPCRoleAlreadyExist):       ErrGRPCRoleAlreadyExist,
+		ErrorDesc(ErrGRPCRoleNotFound):           ErrGRPCRoleNotFound,
+		ErrorDesc(ErrGRPCRoleEmpty):              ErrGRPCRoleEmpty,
+		ErrorDesc(ErrGRPCAuthFailed):             ErrGRPCAuthFailed,
+		ErrorDesc(ErrGRPCPermissionDenied):       ErrGRPCPermissionDenied,
+		ErrorDesc(ErrGRPCRoleNotGranted):         ErrGRPCRoleNotGranted,
+		ErrorDesc(ErrGRPCPermissionNotGranted):   ErrGRPCPermissionNotGranted,
+		ErrorDesc(ErrGRPCPermissionNotSupported): ErrGRPCPermissionNotSupported,
+		ErrorDesc(ErrGRPCAuthNotEnabled):         ErrGRPCAuthNotEnabled,
+		ErrorDesc(ErrGRPCInvalidAuthToken):       ErrGRPCInvalidAuthToken,
+		ErrorDesc(ErrGRPCInvalidAuthMgmt):        ErrGRPCInvalidAuthMgmt,
+		ErrorDesc(ErrGRPCAuthOldRevision):        ErrGRPCAuthOldRevision,
 
 		ErrorDesc(ErrGRPCNoLeader):                   ErrGRPCNoLeader,
 		ErrorDesc(ErrGRPCNotLeader):                  ErrGRPCNotLeader,
@@ -161,20 +195,36 @@ var (
 
 // client-side error
 var (
-	ErrEmptyKey          = Error(ErrGRPCEmptyKey)
-	ErrKeyNotFound       = Error(ErrGRPCKeyNotFound)
-	ErrValueProvided     = Error(ErrGRPCValueProvided)
-	ErrLeaseProvided     = Error(ErrGRPCLeaseProvided)
-	ErrTooManyOps        = Error(ErrGRPCTooManyOps)
-	ErrDuplicateKey      = Error(ErrGRPCDuplicateKey)
-	ErrInvalidSortOption = Error(ErrGRPCInvalidSortOption)
-	ErrCompacted         = Error(ErrGRPCCompacted)
-	ErrFutureRev         = Error(ErrGRPCFutureRev)
-	ErrNoSpace           = Error(ErrGRPCNoSpace)
-
-	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
-	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
-	ErrLeaseTTLTooLarge = Error(ErrGRPCLeaseTTLTooLarge)
+	ErrEmptyKey             = Error(ErrGRPCEmptyKey)
+	ErrKeyNotFound          = Error(ErrGRPCKeyNotFound)
+	ErrValueProvided        = Error(ErrGRPCValueProvided)
+	ErrLeaseProvided        = Error(ErrGRPCLeaseProvided)
+	ErrTooManyOps           = Error(ErrGRPCTooManyOps)
+	ErrDuplicateKey         = Error(ErrGRPCDuplicateKey)
+	ErrInvalidSortOption    = Error(ErrGRPCInvalidSortOption)
+	ErrInvalidContinueToken = Error(ErrGRPCInvalidContinueToken)
+	ErrInvalidBaseRevision  = Error(ErrGRPCInvalidBaseRevision)
+	ErrInvalidKeyTTL        = Error(ErrGRPCInvalidKeyTTL)
+	ErrInvalidHistoryRange  = Error(ErrGRPCInvalidHistoryRange)
+	ErrCompacted            = Error(ErrGRPCCompacted)
+	ErrFutureRev            = Error(ErrGRPCFutureRev)
+	ErrNoSpace              = Error(ErrGRPCNoSpace)
+
+	ErrQuotaPrefixEmpty    = Error(ErrGRPCQuotaPrefixEmpty)
+	ErrInvalidQuota        = Error(ErrGRPCInvalidQuota)
+	ErrQuotaNotFound       = Error(ErrGRPCQuotaNotFound)
+	ErrPrefixQuotaExceeded = Error(ErrGRPCPrefixQuotaExceeded)
+	ErrQuotaNotSupported   = Error(ErrGRPCQuotaNotSupported)
+
+	ErrRetentionNotSupported = Error(ErrGRPCRetentionNotSupported)
+
+	ErrLeaseNotFound       = Error(ErrGRPCLeaseNotFound)
+	ErrLeaseExist          = Error(ErrGRPCLeaseExist)
+	ErrLeaseTTLTooLarge    = Error(ErrGRPCLeaseTTLTooLarge)
+	ErrParentLeaseNotFound = Error(ErrGRPCParentLeaseNotFound)
+	ErrAttachNotSupported  = Error(ErrGRPCAttachNotSupported)
+	ErrExpiryNotSupported  = Error(ErrGRPCExpiryNotSupported)
+	ErrParentNotSupported  = Error(ErrGRPCParentNotSupported)
 
 	ErrMemberExist            = Error(ErrGRPCMemberExist)
 	ErrPeerURLExist           = Error(ErrGRPCPeerURLExist)
@@ -188,22 +238,23 @@ var (
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
 
-	ErrRootUserNotExist     = Error(ErrGRPCRootUserNotExist)
-	ErrRootRoleNotExist     = Error(ErrGRPCRootRoleNotExist)
-	ErrUserAlreadyExist     = Error(ErrGRPCUserAlreadyExist)
-	ErrUserEmpty            = Error(ErrGRPCUserEmpty)
-	ErrUserNotFound         = Error(ErrGRPCUserNotFound)
-	ErrRoleAlreadyExist     = Error(ErrGRPCRoleAlreadyExist)
-	ErrRoleNotFound         = Error(ErrGRPCRoleNotFound)
-	ErrRoleEmpty            = Error(ErrGRPCRoleEmpty)
-	ErrAuthFailed           = Error(ErrGRPCAuthFailed)
-	ErrPermissionDenied     = Error(ErrGRPCPermissionDenied)
-	ErrRoleNotGranted       = Error(ErrGRPCRoleNotGranted)
-	ErrPermissionNotGranted = Error(ErrGRPCPermissionNotGranted)
-	ErrAuthNotEnabled       = Error(ErrGRPCAuthNotEnabled)
-	ErrInvalidAuthToken     = Error(ErrGRPCInvalidAuthToken)
-	ErrAuthOldRevision      = Error(ErrGRPCAuthOldRevision)
-	ErrInvalidAuthMgmt      = Error(ErrGRPCInvalidAuthMgmt)
+	ErrRootUserNotExist       = Error(ErrGRPCRootUserNotExist)
+	ErrRootRoleNotExist       = Error(ErrGRPCRootRoleNotExist)
+	ErrUserAlreadyExist       = Error(ErrGRPCUserAlreadyExist)
+	ErrUserEmpty              = Error(ErrGRPCUserEmpty)
+	ErrUserNotFound           = Error(ErrGRPCUserNotFound)
+	ErrRoleAlreadyExist       = Error(ErrGRPCRoleAlreadyExist)
+	ErrRoleNotFound           = Error(ErrGRPCRoleNotFound)
+	ErrRoleEmpty              = Error(ErrGRPCRoleEmpty)
+	ErrAuthFailed             = Error(ErrGRPCAuthFailed)
+	ErrPermissionDenied       = Error(ErrGRPCPermissionDenied)
+	ErrRoleNotGranted         = Error(ErrGRPCRoleNotGranted)
+	ErrPermissionNotGranted   = Error(ErrGRPCPermissionNotGranted)
+	ErrPermissionNotSupported = Error(ErrGRPCPermissionNotSupported)
+	ErrAuthNotEnabled         = Error(ErrGRPCAuthNotEnabled)
+	ErrInvalidAuthToken       = Error(ErrGRPCInvalidAuthToken)
+	ErrAuthOldRevision        = Error(ErrGRPCAuthOldRevision)
+	ErrInvalidAuthMgmt        = Error(ErrGRPCInvalidAuthMgmt)
 
 	ErrNoLeader                   = Error(ErrGRPCNoLeader)
 	ErrNotLeader                  = Error(ErrGRPCNotLeader)
@@ -25,18 +25,28 @@ import (
 )
 
 func PurgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) <-chan error {
-	return purgeFile(lg, dirname, suffix, max, interval, stop, nil, nil)
+	return purgeFile(lg, dirname, suffix, max, interval, stop, nil, nil, nil)
 }
 
 func PurgeFileWithDoneNotify(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
 	doneC := make(chan struct{})
-	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC)
+	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, nil)
+	return doneC, errC
+}
+
+// PurgeFileWithArchive is like PurgeFileWithDoneNotify, but passes the path of
+// each file to archive before removing it. If archive fails, the file is kept
+// and purging is retried on the next interval.
+func PurgeFileWithArchive(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, archive func(path string) error) (<-chan struct{}, <-chan error) {
+	doneC := make(chan struct{})
+	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, archive)
 	return doneC, errC
 }
 
 // purgeFile is the internal implementation for PurgeFile which can post purged files to purgec if non-nil.
 // if donec is non-nil, the function closes it to notify its exit.
-func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}) <-chan error {
+// if archive is non-nil, it is called on each file before the file is removed.
+func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}, archive func(string) error) <-chan error {
 	if lg == nil {
 		lg = zap.NewNop()
 	}
@@ -72,6 +82,13 @@ func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval
 					lg.Warn("failed to lock file", zap.String("path", f), zap.Error(err))
 					break
 				}
+				if archive != nil {
+					if err = archive(f); err != nil {
+						lg.Warn("failed to archive file; retrying purge later", zap.String("path", f), zap.Error(err))
+						l.Close()
+						break
+					}
+				}
 				if err = os.Remove(f); err != nil {
 					lg.Error("failed to remove file", zap.String("path", f), zap.Error(err))
 					errC <- err
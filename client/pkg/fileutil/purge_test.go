@@ -15,10 +15,12 @@
 package fileutil
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -40,7 +42,7 @@ func TestPurgeFile(t *testing.T) {
 	stop, purgec := make(chan struct{}), make(chan string, 10)
 
 	// keep 3 most recent files
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, nil)
 	select {
 	case f := <-purgec:
 		t.Errorf("unexpected purge on %q", f)
@@ -107,7 +109,7 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 	}
 
 	stop, purgec := make(chan struct{}), make(chan string, 10)
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, nil)
 
 	for i := 0; i < 5; i++ {
 		select {
@@ -168,3 +170,69 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 
 	close(stop)
 }
+
+func TestPurgeFileArchive(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 5; i++ {
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%d.test", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+	}
+
+	var (
+		mu       sync.Mutex
+		fail     = true
+		archived []string
+	)
+	archive := func(path string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			return errors.New("archive unavailable")
+		}
+		archived = append(archived, filepath.Base(path))
+		return nil
+	}
+
+	stop, purgec := make(chan struct{}), make(chan string, 10)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 2, time.Millisecond, stop, purgec, nil, archive)
+
+	// failed archives keep every file
+	select {
+	case f := <-purgec:
+		t.Fatalf("unexpected purge on %q", f)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+	for i := 0; i < 3; i++ {
+		select {
+		case <-purgec:
+		case <-time.After(time.Second):
+			t.Fatalf("purge took too long")
+		}
+	}
+	close(stop)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if wa := []string{"0.test", "1.test", "2.test"}; !reflect.DeepEqual(archived, wa) {
+		t.Errorf("archived = %v, want %v", archived, wa)
+	}
+	fnames, err := ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wnames := []string{"3.test", "4.test"}; !reflect.DeepEqual(fnames, wnames) {
+		t.Errorf("filenames = %v, want %v", fnames, wnames)
+	}
+	select {
+	case err = <-errch:
+		t.Errorf("unexpected purge error %v", err)
+	default:
+	}
+}
@@ -394,6 +394,10 @@ func (mm mockMaintenance) Defragment(ctx context.Context, endpoint string) (*Def
 	return nil, nil
 }
 
+func (mm mockMaintenance) DefragmentOnline(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return nil, nil
+}
+
 func (mm mockMaintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*HashKVResponse, error) {
 	return nil, nil
 }
@@ -414,6 +418,26 @@ func (mm mockMaintenance) Downgrade(ctx context.Context, action DowngradeAction,
 	return nil, nil
 }
 
+func (mm mockMaintenance) QuotaSet(ctx context.Context, prefix string, maxKeys, maxBytes int64) (*QuotaSetResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) QuotaGet(ctx context.Context, prefix string) (*QuotaGetResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) QuotaList(ctx context.Context) (*QuotaListResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) SnapshotIncremental(ctx context.Context, baseRev int64) (*SnapshotResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) CompactEstimate(ctx context.Context, rev int64) (*CompactEstimateResponse, error) {
+	return nil, nil
+}
+
 type mockAuthServer struct {
 	*etcdserverpb.UnimplementedAuthServer
 }
@@ -84,6 +84,15 @@ func ModRevision(key string) Cmp {
 	return Cmp{Key: []byte(key), Target: pb.Compare_MOD}
 }
 
+// FencingToken returns a comparison that holds while key still exists with the
+// creation revision token. Lock and election keys are created in the order
+// they are granted ownership, so their creation revisions serve as monotonic
+// fencing tokens; guarding a transaction with FencingToken makes it fail once
+// ownership was lost, even if the caller was paused for longer than its lease.
+func FencingToken(key string, token int64) Cmp {
+	return Compare(CreateRevision(key), "=", token)
+}
+
 // LeaseValue compares a key's LeaseID to a value of your choosing. The empty
 // LeaseID is 0, otherwise known as `NoLease`.
 func LeaseValue(key string) Cmp {
@@ -13,5 +13,5 @@
 // limitations under the License.
 
 // Package concurrency implements concurrency operations on top of
-// etcd such as distributed locks, barriers, and elections.
+// etcd such as distributed locks, read-write locks, semaphores, barriers, and elections.
 package concurrency
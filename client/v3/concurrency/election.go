@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -25,8 +27,12 @@ import (
 )
 
 var (
-	ErrElectionNotLeader = errors.New("election: not leader")
-	ErrElectionNoLeader  = errors.New("election: no leader")
+	ErrElectionNotLeader       = errors.New("election: not leader")
+	ErrElectionNoLeader        = errors.New("election: no leader")
+	ErrElectionNotCandidate    = errors.New("election: not a candidate")
+	ErrElectionInvalidPriority = errors.New("election: priority must not be negative")
+
+	errCandidacyLost = errors.New("election: candidate key removed")
 )
 
 type Election struct {
@@ -56,6 +62,21 @@ func ResumeElection(s *Session, pfx string, leaderKey string, leaderRev int64) *
 	}
 }
 
+type campaignOptions struct {
+	priority int64
+}
+
+// CampaignOption configures Campaign.
+type CampaignOption func(*campaignOptions)
+
+// WithPriority sets the priority of the candidate; the default is 0. A
+// candidate is queued ahead of the waiting candidates with a lower priority,
+// so it becomes the next leader once the current one is gone. The current
+// leader is never preempted.
+func WithPriority(priority int64) CampaignOption {
+	return func(co *campaignOptions) { co.priority = priority }
+}
+
 // Campaign puts a value as eligible for the election on the prefix
 // key.
 // Multiple sessions can participate in the election for the
@@ -66,44 +87,122 @@ func ResumeElection(s *Session, pfx string, leaderKey string, leaderRev int64) *
 // returns a non-recoverable error (e.g. ErrCompacted).
 // Otherwise, until the context is not cancelled or timed-out, Campaign will
 // continue to be blocked until it becomes the leader.
-func (e *Election) Campaign(ctx context.Context, val string) error {
+//
+// A waiting candidate whose key is removed by another candidate or by
+// TransferTo rejoins the election behind it. Priorities and transfers thus
+// require all candidates of the election to use a client supporting them.
+func (e *Election) Campaign(ctx context.Context, val string, opts ...CampaignOption) error {
+	ops := &campaignOptions{}
+	for _, opt := range opts {
+		opt(ops)
+	}
+	if ops.priority < 0 {
+		return ErrElectionInvalidPriority
+	}
 	s := e.session
 	client := e.session.Client()
 
-	k := fmt.Sprintf("%s%x", e.keyPrefix, s.Lease())
-	txn := client.Txn(ctx).If(v3.Compare(v3.CreateRevision(k), "=", 0))
-	txn = txn.Then(v3.OpPut(k, val, v3.WithLease(s.Lease())))
-	txn = txn.Else(v3.OpGet(k))
-	resp, err := txn.Commit()
-	if err != nil {
-		return err
+	k := candidateKey(e.keyPrefix, s.Lease(), ops.priority)
+	if e.leaderSession != nil && e.leaderKey != k {
+		// campaigning with another priority replaces the previous candidacy
+		if err := e.Resign(ctx); err != nil {
+			return err
+		}
 	}
-	e.leaderKey, e.leaderRev, e.leaderSession = k, resp.Header.Revision, s
-	if !resp.Succeeded {
-		kv := resp.Responses[0].GetResponseRange().Kvs[0]
-		e.leaderRev = kv.CreateRevision
-		if string(kv.Value) != val {
-			if err = e.Proclaim(ctx, val); err != nil {
-				e.Resign(ctx)
-				return err
+	for {
+		resp, err := e.join(ctx, k, val, ops.priority)
+		if err != nil {
+			return err
+		}
+		e.leaderKey, e.leaderRev, e.leaderSession = k, resp.Header.Revision, s
+		if !resp.Succeeded {
+			kv := resp.Responses[0].GetResponseRange().Kvs[0]
+			e.leaderRev = kv.CreateRevision
+			if string(kv.Value) != val {
+				if err = e.Proclaim(ctx, val); err != nil {
+					e.Resign(ctx)
+					return err
+				}
+			}
+		}
+
+		err = waitCandidacy(ctx, client, e.keyPrefix, k, e.leaderRev, resp.Header.Revision+1)
+		if err == errCandidacyLost {
+			continue
+		}
+		if err != nil {
+			// clean up in case of context cancel
+			select {
+			case <-ctx.Done():
+				e.Resign(client.Ctx())
+			default:
+				e.leaderSession = nil
 			}
+			return err
 		}
+		e.hdr = resp.Header
+		return nil
 	}
+}
 
-	_, err = waitDeletes(ctx, client, e.keyPrefix, e.leaderRev-1)
-	if err != nil {
-		// clean up in case of context cancel
-		select {
-		case <-ctx.Done():
-			e.Resign(client.Ctx())
-		default:
-			e.leaderSession = nil
+// join puts the candidate key unless it already exists. Waiting candidates
+// with a lower priority are removed in the same transaction, so that they
+// rejoin behind the new candidate.
+func (e *Election) join(ctx context.Context, k, val string, priority int64) (*v3.TxnResponse, error) {
+	s := e.session
+	client := e.session.Client()
+	for {
+		cmps := []v3.Cmp{v3.Compare(v3.CreateRevision(k), "=", 0)}
+		ops := []v3.Op{v3.OpPut(k, val, v3.WithLease(s.Lease()))}
+		if priority > 0 {
+			resp, err := client.Get(ctx, e.keyPrefix, v3.WithPrefix(), v3.WithKeysOnly(),
+				v3.WithSort(v3.SortByCreateRevision, v3.SortAscend))
+			if err != nil {
+				return nil, err
+			}
+			// skip the leader
+			for i := 1; i < len(resp.Kvs); i++ {
+				if key := string(resp.Kvs[i].Key); candidatePriority(key) < priority {
+					ops = append(ops, v3.OpDelete(key))
+				}
+			}
+			// no other candidate joined in the meantime
+			cmps = append(cmps, v3.Compare(v3.CreateRevision(e.keyPrefix).WithPrefix(), "<", resp.Header.Revision+1))
+			if len(resp.Kvs) != 0 {
+				// and the leader did not resign, promoting a deleted waiter
+				cmps = append(cmps, v3.FencingToken(string(resp.Kvs[0].Key), resp.Kvs[0].CreateRevision))
+			}
+		}
+		resp, err := client.Txn(ctx).If(cmps...).Then(ops...).Else(v3.OpGet(k)).Commit()
+		if err != nil {
+			return nil, err
+		}
+		if resp.Succeeded || len(resp.Responses[0].GetResponseRange().Kvs) != 0 {
+			return resp, nil
 		}
-		return err
 	}
-	e.hdr = resp.Header
+}
 
-	return nil
+// candidateKey returns the key of a candidate with the given lease. The
+// priority is appended to the key unless it is the default.
+func candidateKey(pfx string, lease v3.LeaseID, priority int64) string {
+	if priority == 0 {
+		return fmt.Sprintf("%s%x", pfx, lease)
+	}
+	return fmt.Sprintf("%s%x@%d", pfx, lease, priority)
+}
+
+// candidatePriority returns the priority of the candidate with the given key.
+func candidatePriority(key string) int64 {
+	i := strings.LastIndexByte(key, '@')
+	if i < strings.LastIndexByte(key, '/') {
+		return 0
+	}
+	priority, err := strconv.ParseInt(key[i+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return priority
 }
 
 // Proclaim lets the leader announce a new value without another election.
@@ -112,7 +211,7 @@ func (e *Election) Proclaim(ctx context.Context, val string) error {
 		return ErrElectionNotLeader
 	}
 	client := e.session.Client()
-	cmp := v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev)
+	cmp := v3.FencingToken(e.leaderKey, e.leaderRev)
 	txn := client.Txn(ctx).If(cmp)
 	txn = txn.Then(v3.OpPut(e.leaderKey, val, v3.WithLease(e.leaderSession.Lease())))
 	tresp, terr := txn.Commit()
@@ -134,7 +233,7 @@ func (e *Election) Resign(ctx context.Context) (err error) {
 		return nil
 	}
 	client := e.session.Client()
-	cmp := v3.Compare(v3.CreateRevision(e.leaderKey), "=", e.leaderRev)
+	cmp := v3.FencingToken(e.leaderKey, e.leaderRev)
 	resp, err := client.Txn(ctx).If(cmp).Then(v3.OpDelete(e.leaderKey)).Commit()
 	if err == nil {
 		e.hdr = resp.Header
@@ -144,6 +243,62 @@ func (e *Election) Resign(ctx context.Context) (err error) {
 	return err
 }
 
+// TransferTo lets the leader hand leadership over to the waiting candidate with
+// the given key. The candidates queued before it are removed in the same
+// transaction and rejoin the election behind it. As with Resign, this election
+// is no longer the leader afterwards.
+func (e *Election) TransferTo(ctx context.Context, candidateKey string) error {
+	if e.leaderSession == nil {
+		return ErrElectionNotLeader
+	}
+	// ResumeElection may be given the prefix without the trailing slash
+	pfx := e.leaderKey[:strings.LastIndexByte(e.leaderKey, '/')+1]
+	if candidateKey == e.leaderKey || !strings.HasPrefix(candidateKey, pfx) ||
+		strings.Contains(candidateKey[len(pfx):], "/") {
+		return ErrElectionNotCandidate
+	}
+	client := e.session.Client()
+	cresp, err := client.Get(ctx, candidateKey)
+	if err != nil {
+		return err
+	}
+	if len(cresp.Kvs) == 0 {
+		return ErrElectionNotCandidate
+	}
+	candidateRev := cresp.Kvs[0].CreateRevision
+
+	// remove the leader and the candidates queued before the new leader
+	resp, err := client.Get(ctx, pfx, v3.WithPrefix(), v3.WithKeysOnly(), v3.WithMaxCreateRev(candidateRev-1))
+	if err != nil {
+		return err
+	}
+	var dels []v3.Op
+	for _, kv := range resp.Kvs {
+		dels = append(dels, v3.OpDelete(string(kv.Key)))
+	}
+	cmps := []v3.Cmp{
+		v3.FencingToken(e.leaderKey, e.leaderRev),
+		v3.FencingToken(candidateKey, candidateRev),
+	}
+	tresp, err := client.Txn(ctx).If(cmps...).Then(dels...).Else(v3.OpGet(e.leaderKey)).Commit()
+	if err != nil {
+		return err
+	}
+	if !tresp.Succeeded {
+		kvs := tresp.Responses[0].GetResponseRange().Kvs
+		if len(kvs) == 0 || kvs[0].CreateRevision != e.leaderRev {
+			e.leaderKey = ""
+			e.leaderSession = nil
+			return ErrElectionNotLeader
+		}
+		return ErrElectionNotCandidate
+	}
+	e.hdr = tresp.Header
+	e.leaderKey = ""
+	e.leaderSession = nil
+	return nil
+}
+
 // Leader returns the leader value for the current election.
 func (e *Election) Leader(ctx context.Context) (*v3.GetResponse, error) {
 	client := e.session.Client()
@@ -247,7 +402,8 @@ func (e *Election) observe(ctx context.Context, ch chan<- v3.GetResponse) {
 // Key returns the leader key if elected, empty string otherwise.
 func (e *Election) Key() string { return e.leaderKey }
 
-// Rev returns the leader key's creation revision, if elected.
+// Rev returns the leader key's creation revision, if elected. It increases with
+// every new leader, so it can be used as a fencing token with v3.FencingToken.
 func (e *Election) Rev() int64 { return e.leaderRev }
 
 // Header is the response header from the last successful election proposal.
@@ -0,0 +1,136 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+func mockRWMutex_TryLock() {
+	fmt.Println("acquired read lock for s1")
+	fmt.Println("acquired read lock for s2")
+	fmt.Println("cannot acquire write lock for s3, as read locked in other sessions")
+}
+
+func ExampleRWMutex_TryLock() {
+	forUnitTestsRunInMockedContext(
+		mockRWMutex_TryLock,
+		func() {
+			cli, err := clientv3.New(clientv3.Config{Endpoints: exampleEndpoints()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer cli.Close()
+
+			// create three separate sessions for lock competition
+			var rwms []*concurrency.RWMutex
+			for i := 0; i < 3; i++ {
+				s, err := concurrency.NewSession(cli)
+				if err != nil {
+					log.Fatal(err)
+				}
+				defer s.Close()
+				rwms = append(rwms, concurrency.NewRWMutex(s, "/my-rwlock"))
+			}
+
+			// readers share the lock
+			if err = rwms[0].RLock(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired read lock for s1")
+			if err = rwms[1].TryRLock(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired read lock for s2")
+
+			if err = rwms[2].TryLock(context.TODO()); err == nil {
+				log.Fatal("should not acquire write lock")
+			}
+			if err == concurrency.ErrLocked {
+				fmt.Println("cannot acquire write lock for s3, as read locked in other sessions")
+			}
+		})
+
+	// Output:
+	// acquired read lock for s1
+	// acquired read lock for s2
+	// cannot acquire write lock for s3, as read locked in other sessions
+}
+
+func mockRWMutex_Lock() {
+	fmt.Println("acquired write lock for s1")
+	fmt.Println("released write lock for s1")
+	fmt.Println("acquired read lock for s2")
+}
+
+func ExampleRWMutex_Lock() {
+	forUnitTestsRunInMockedContext(
+		mockRWMutex_Lock,
+		func() {
+			cli, err := clientv3.New(clientv3.Config{Endpoints: exampleEndpoints()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer cli.Close()
+
+			// create two separate sessions for lock competition
+			s1, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s1.Close()
+			rwm1 := concurrency.NewRWMutex(s1, "/my-rwlock")
+
+			s2, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s2.Close()
+			rwm2 := concurrency.NewRWMutex(s2, "/my-rwlock")
+
+			// acquire write lock for s1
+			if err = rwm1.Lock(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired write lock for s1")
+
+			rwm2Locked := make(chan struct{})
+			go func() {
+				defer close(rwm2Locked)
+				// wait until s1 unlocks /my-rwlock
+				if err := rwm2.RLock(context.TODO()); err != nil {
+					log.Fatal(err)
+				}
+			}()
+
+			if err = rwm1.Unlock(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("released write lock for s1")
+
+			<-rwm2Locked
+			fmt.Println("acquired read lock for s2")
+		})
+
+	// Output:
+	// acquired write lock for s1
+	// released write lock for s1
+	// acquired read lock for s2
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+func mockSemaphore_TryAcquire() {
+	fmt.Println("acquired 2 for s1")
+	fmt.Println("cannot acquire 2 for s2, as only 1 is free")
+	fmt.Println("acquired 1 for s2")
+}
+
+func ExampleSemaphore_TryAcquire() {
+	forUnitTestsRunInMockedContext(
+		mockSemaphore_TryAcquire,
+		func() {
+			cli, err := clientv3.New(clientv3.Config{Endpoints: exampleEndpoints()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer cli.Close()
+
+			// create two separate sessions sharing a semaphore with a limit of 3
+			s1, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s1.Close()
+			sm1 := concurrency.NewSemaphore(s1, "/my-semaphore", 3)
+
+			s2, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s2.Close()
+			sm2 := concurrency.NewSemaphore(s2, "/my-semaphore", 3)
+
+			if err = sm1.Acquire(context.TODO(), 2); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired 2 for s1")
+
+			if err = sm2.TryAcquire(context.TODO(), 2); err == nil {
+				log.Fatal("should not acquire semaphore")
+			}
+			if err == concurrency.ErrSemaphoreFull {
+				fmt.Println("cannot acquire 2 for s2, as only 1 is free")
+			}
+
+			if err = sm2.TryAcquire(context.TODO(), 1); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired 1 for s2")
+		})
+
+	// Output:
+	// acquired 2 for s1
+	// cannot acquire 2 for s2, as only 1 is free
+	// acquired 1 for s2
+}
+
+func mockSemaphore_Acquire() {
+	fmt.Println("acquired 1 for s1")
+	fmt.Println("released s1")
+	fmt.Println("acquired 1 for s2")
+}
+
+func ExampleSemaphore_Acquire() {
+	forUnitTestsRunInMockedContext(
+		mockSemaphore_Acquire,
+		func() {
+			cli, err := clientv3.New(clientv3.Config{Endpoints: exampleEndpoints()})
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer cli.Close()
+
+			// create two separate sessions sharing a semaphore with a limit of 1
+			s1, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s1.Close()
+			sm1 := concurrency.NewSemaphore(s1, "/my-semaphore/", 1)
+
+			s2, err := concurrency.NewSession(cli)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer s2.Close()
+			sm2 := concurrency.NewSemaphore(s2, "/my-semaphore/", 1)
+
+			if err := sm1.Acquire(context.TODO(), 1); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("acquired 1 for s1")
+
+			sm2Acquired := make(chan struct{})
+			go func() {
+				defer close(sm2Acquired)
+				// wait until s1 releases /my-semaphore/
+				if err := sm2.Acquire(context.TODO(), 1); err != nil {
+					log.Fatal(err)
+				}
+			}()
+
+			if err := sm1.Release(context.TODO()); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Println("released s1")
+
+			<-sm2Acquired
+			fmt.Println("acquired 1 for s2")
+		})
+
+	// Output:
+	// acquired 1 for s1
+	// released s1
+	// acquired 1 for s2
+}
@@ -48,9 +48,14 @@ func waitDelete(ctx context.Context, client *v3.Client, key string, rev int64) e
 // waitDeletes efficiently waits until all keys matching the prefix and no greater
 // than the create revision are deleted.
 func waitDeletes(ctx context.Context, client *v3.Client, pfx string, maxCreateRev int64) (*pb.ResponseHeader, error) {
-	getOpts := append(v3.WithLastCreate(), v3.WithMaxCreateRev(maxCreateRev))
+	return waitRangeDeletes(ctx, client, pfx, v3.GetPrefixRangeEnd(pfx), maxCreateRev)
+}
+
+// waitRangeDeletes is like waitDeletes for the keys in the range [key, end).
+func waitRangeDeletes(ctx context.Context, client *v3.Client, key, end string, maxCreateRev int64) (*pb.ResponseHeader, error) {
+	getOpts := append(v3.WithLastCreate(), v3.WithRange(end), v3.WithMaxCreateRev(maxCreateRev))
 	for {
-		resp, err := client.Get(ctx, pfx, getOpts...)
+		resp, err := client.Get(ctx, key, getOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -63,3 +68,69 @@ func waitDeletes(ctx context.Context, client *v3.Client, pfx string, maxCreateRe
 		}
 	}
 }
+
+// waitPrefixDelete waits until a key matching the prefix is deleted at or
+// after the given revision.
+func waitPrefixDelete(ctx context.Context, client *v3.Client, pfx string, rev int64) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wr v3.WatchResponse
+	wch := client.Watch(cctx, pfx, v3.WithPrefix(), v3.WithRev(rev), v3.WithFilterPut())
+	for wr = range wch {
+		if len(wr.Events) != 0 {
+			return nil
+		}
+	}
+	if err := wr.Err(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.New("lost watcher waiting for delete")
+}
+
+// waitCandidacy waits until all keys matching the prefix and created before
+// the given revision are deleted, watching from watchRev. It returns
+// errCandidacyLost if the candidate key is deleted first.
+func waitCandidacy(ctx context.Context, client *v3.Client, pfx, key string, rev, watchRev int64) error {
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	getOpts := append(v3.WithLastCreate(), v3.WithMaxCreateRev(rev-1), v3.WithKeysOnly())
+	wch := client.Watch(cctx, pfx, v3.WithPrefix(), v3.WithRev(watchRev), v3.WithFilterPut())
+	for {
+		resp, err := client.Get(ctx, pfx, getOpts...)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) == 0 {
+			break
+		}
+		wr, ok := <-wch
+		if !ok {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+			return errors.New("lost watcher waiting for delete")
+		}
+		if err = wr.Err(); err != nil {
+			return err
+		}
+		for _, ev := range wr.Events {
+			if string(ev.Kv.Key) == key {
+				return errCandidacyLost
+			}
+		}
+	}
+	// make sure the candidate key was not removed while no longer waiting
+	resp, err := client.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 || resp.Kvs[0].CreateRevision != rev {
+		return errCandidacyLost
+	}
+	return nil
+}
@@ -147,12 +147,18 @@ func (m *Mutex) Unlock(ctx context.Context) error {
 	return nil
 }
 
+// IsOwner returns a comparison that holds while the session holds the lock.
 func (m *Mutex) IsOwner() v3.Cmp {
-	return v3.Compare(v3.CreateRevision(m.myKey), "=", m.myRev)
+	return v3.FencingToken(m.myKey, m.myRev)
 }
 
 func (m *Mutex) Key() string { return m.myKey }
 
+// Rev returns the creation revision of the lock key. It increases with every
+// acquisition of the lock, so it can be used as a fencing token with
+// v3.FencingToken.
+func (m *Mutex) Rev() int64 { return m.myRev }
+
 // Header is the response header received from etcd on acquiring the lock.
 func (m *Mutex) Header() *pb.ResponseHeader { return m.hdr }
 
@@ -0,0 +1,199 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	v3 "go.etcd.io/etcd/client/v3"
+)
+
+// RWMutex is a reader/writer mutual exclusion lock with etcd. The lock can be
+// held by any number of reader sessions or by a single writer session, and is
+// released when the session's lease expires.
+//
+// Sessions are granted the lock in the order they asked for it. A reader that
+// asks while a writer is waiting queues behind that writer, so a steady stream
+// of readers cannot starve writers.
+//
+// The write lock shares its keys with Mutex, so a Mutex on the same prefix
+// excludes readers and writers alike. An RWMutex holds either the read or the
+// write lock at a time.
+type RWMutex struct {
+	s *Session
+
+	pfx   string
+	myKey string
+	myRev int64
+	hdr   *pb.ResponseHeader
+}
+
+func NewRWMutex(s *Session, pfx string) *RWMutex {
+	return &RWMutex{s, pfx + "/", "", -1, nil}
+}
+
+// TryRLock locks rwm for reading if no writer holds or waits for it.
+// Otherwise it returns ErrLocked after attempting necessary cleanup.
+func (rwm *RWMutex) TryRLock(ctx context.Context) error {
+	return rwm.tryLock(ctx, rwm.readPfx(), rwm.readPfx())
+}
+
+// TryLock locks rwm for writing if no other session holds or waits for it.
+// Otherwise it returns ErrLocked after attempting necessary cleanup.
+func (rwm *RWMutex) TryLock(ctx context.Context) error {
+	return rwm.tryLock(ctx, rwm.pfx, v3.GetPrefixRangeEnd(rwm.pfx))
+}
+
+// RLock locks rwm for reading, waiting for the writers that asked for it
+// before. If the context is canceled while waiting, the mutex tries to clean
+// its stale lock entry.
+func (rwm *RWMutex) RLock(ctx context.Context) error {
+	return rwm.lock(ctx, rwm.readPfx(), rwm.readPfx())
+}
+
+// Lock locks rwm for writing, waiting for the readers and writers that asked
+// for it before. If the context is canceled while waiting, the mutex tries to
+// clean its stale lock entry.
+func (rwm *RWMutex) Lock(ctx context.Context) error {
+	return rwm.lock(ctx, rwm.pfx, v3.GetPrefixRangeEnd(rwm.pfx))
+}
+
+// readPfx holds the keys of readers. Writer keys are named by lease IDs in hex,
+// which sort before it, so the writers are the keys in [pfx, readPfx).
+func (rwm *RWMutex) readPfx() string { return rwm.pfx + "read/" }
+
+func (rwm *RWMutex) tryLock(ctx context.Context, keyPfx, blockEnd string) error {
+	resp, err := rwm.tryAcquire(ctx, keyPfx, blockEnd)
+	if err != nil {
+		return err
+	}
+	if rwm.unblocked(resp) {
+		rwm.hdr = resp.Header
+		return nil
+	}
+	client := rwm.s.Client()
+	// Cannot lock, so delete the key
+	if _, err := client.Delete(ctx, rwm.myKey); err != nil {
+		return err
+	}
+	rwm.myKey = "\x00"
+	rwm.myRev = -1
+	return ErrLocked
+}
+
+func (rwm *RWMutex) lock(ctx context.Context, keyPfx, blockEnd string) error {
+	resp, err := rwm.tryAcquire(ctx, keyPfx, blockEnd)
+	if err != nil {
+		return err
+	}
+	if rwm.unblocked(resp) {
+		rwm.hdr = resp.Header
+		return nil
+	}
+	client := rwm.s.Client()
+	// wait for deletion of blocking keys prior to myKey
+	_, werr := waitRangeDeletes(ctx, client, rwm.pfx, blockEnd, rwm.myRev-1)
+	// release lock key if wait failed
+	if werr != nil {
+		rwm.unlock(client.Ctx())
+		return werr
+	}
+
+	// make sure the session is not expired, and the owner key still exists.
+	gresp, werr := client.Get(ctx, rwm.myKey)
+	if werr != nil {
+		rwm.unlock(client.Ctx())
+		return werr
+	}
+
+	if len(gresp.Kvs) == 0 { // is the session key lost?
+		return ErrSessionExpired
+	}
+	rwm.hdr = gresp.Header
+
+	return nil
+}
+
+func (rwm *RWMutex) tryAcquire(ctx context.Context, keyPfx, blockEnd string) (*v3.TxnResponse, error) {
+	s := rwm.s
+	client := rwm.s.Client()
+
+	rwm.myKey = fmt.Sprintf("%s%x", keyPfx, s.Lease())
+	cmp := v3.Compare(v3.CreateRevision(rwm.myKey), "=", 0)
+	// put self in lock waiters via myKey
+	put := v3.OpPut(rwm.myKey, "", v3.WithLease(s.Lease()))
+	// reuse key in case this session already holds the lock
+	get := v3.OpGet(rwm.myKey)
+	// fetch the oldest blocking key to complete uncontended path with only one RPC
+	getBlocker := v3.OpGet(rwm.pfx, append(v3.WithFirstCreate(), v3.WithRange(blockEnd))...)
+	resp, err := client.Txn(ctx).If(cmp).Then(put, getBlocker).Else(get, getBlocker).Commit()
+	if err != nil {
+		return nil, err
+	}
+	rwm.myRev = resp.Header.Revision
+	if !resp.Succeeded {
+		rwm.myRev = resp.Responses[0].GetResponseRange().Kvs[0].CreateRevision
+	}
+	return resp, nil
+}
+
+// unblocked reports whether no blocking key was created before myKey.
+func (rwm *RWMutex) unblocked(resp *v3.TxnResponse) bool {
+	blocker := resp.Responses[1].GetResponseRange().Kvs
+	return len(blocker) == 0 || blocker[0].CreateRevision >= rwm.myRev
+}
+
+// RUnlock releases the read lock.
+func (rwm *RWMutex) RUnlock(ctx context.Context) error { return rwm.unlock(ctx) }
+
+// Unlock releases the write lock.
+func (rwm *RWMutex) Unlock(ctx context.Context) error { return rwm.unlock(ctx) }
+
+func (rwm *RWMutex) unlock(ctx context.Context) error {
+	if rwm.myKey == "" || rwm.myRev <= 0 || rwm.myKey == "\x00" {
+		return ErrLockReleased
+	}
+
+	if !strings.HasPrefix(rwm.myKey, rwm.pfx) {
+		return fmt.Errorf("invalid key %q, it should have prefix %q", rwm.myKey, rwm.pfx)
+	}
+
+	client := rwm.s.Client()
+	if _, err := client.Delete(ctx, rwm.myKey); err != nil {
+		return err
+	}
+	rwm.myKey = "\x00"
+	rwm.myRev = -1
+	return nil
+}
+
+// IsOwner returns a comparison that holds while the session holds the lock,
+// for guarding transactions with it.
+func (rwm *RWMutex) IsOwner() v3.Cmp {
+	return v3.FencingToken(rwm.myKey, rwm.myRev)
+}
+
+func (rwm *RWMutex) Key() string { return rwm.myKey }
+
+// Rev returns the creation revision of the lock key. It increases with every
+// acquisition of the write lock, so it can be used as a fencing token with
+// v3.FencingToken.
+func (rwm *RWMutex) Rev() int64 { return rwm.myRev }
+
+// Header is the response header received from etcd on acquiring the lock.
+func (rwm *RWMutex) Header() *pb.ResponseHeader { return rwm.hdr }
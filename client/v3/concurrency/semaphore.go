@@ -0,0 +1,209 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+)
+
+// ErrSemaphoreFull is returned by TryAcquire when the semaphore does not have
+// enough free weight.
+var ErrSemaphoreFull = errors.New("semaphore: not enough free weight")
+var ErrSemaphoreReleased = errors.New("semaphore: semaphore has already been released")
+var ErrInvalidWeight = errors.New("semaphore: weight must be between 1 and the limit")
+
+// Semaphore limits the total weight of the sessions holding it at once.
+// Sessions acquire it in the order they asked for it, so a waiter is never
+// overtaken by a later one with a smaller weight. The weight is held until
+// Release is called or the session's lease expires.
+//
+// All users of a semaphore must agree on its limit.
+type Semaphore struct {
+	s *Session
+
+	pfx    string
+	limit  int64
+	myKey  string
+	myRev  int64
+	weight int64
+	hdr    *pb.ResponseHeader
+}
+
+// NewSemaphore creates a semaphore under pfx that allows a total weight of
+// limit to be held at once.
+func NewSemaphore(s *Session, pfx string, limit int64) *Semaphore {
+	return &Semaphore{s: s, pfx: pfx + "/", limit: limit, myRev: -1}
+}
+
+// TryAcquire acquires weight from the semaphore if it is immediately available,
+// and returns ErrSemaphoreFull otherwise.
+func (sm *Semaphore) TryAcquire(ctx context.Context, weight int64) error {
+	resp, err := sm.tryAcquire(ctx, weight)
+	if err != nil {
+		return err
+	}
+	held, err := sm.heldBefore(resp.Responses[1].GetResponseRange().Kvs)
+	if err != nil {
+		sm.Release(sm.s.Client().Ctx())
+		return err
+	}
+	if held+sm.weight <= sm.limit {
+		sm.hdr = resp.Header
+		return nil
+	}
+	if err := sm.Release(ctx); err != nil {
+		return err
+	}
+	return ErrSemaphoreFull
+}
+
+// Acquire acquires weight from the semaphore, waiting until the sessions that
+// asked for it before are within the limit together with this one. If the
+// context is canceled while waiting, the semaphore tries to clean its waiter
+// entry.
+func (sm *Semaphore) Acquire(ctx context.Context, weight int64) error {
+	resp, err := sm.tryAcquire(ctx, weight)
+	if err != nil {
+		return err
+	}
+	kvs := resp.Responses[1].GetResponseRange().Kvs
+	hdr := resp.Header
+	client := sm.s.Client()
+	for {
+		held, err := sm.heldBefore(kvs)
+		if err != nil {
+			sm.Release(client.Ctx())
+			return err
+		}
+		if held+sm.weight <= sm.limit {
+			break
+		}
+		// wait for an earlier holder or waiter to go away
+		if err = waitPrefixDelete(ctx, client, sm.pfx, hdr.Revision+1); err != nil {
+			sm.Release(client.Ctx())
+			return err
+		}
+		gresp, err := client.Get(ctx, sm.pfx, v3.WithPrefix(), v3.WithMaxCreateRev(sm.myRev),
+			v3.WithSort(v3.SortByCreateRevision, v3.SortAscend))
+		if err != nil {
+			sm.Release(client.Ctx())
+			return err
+		}
+		kvs, hdr = gresp.Kvs, gresp.Header
+		// is the session key lost?
+		if len(kvs) == 0 || kvs[len(kvs)-1].CreateRevision != sm.myRev {
+			return ErrSessionExpired
+		}
+	}
+	sm.hdr = hdr
+	return nil
+}
+
+func (sm *Semaphore) tryAcquire(ctx context.Context, weight int64) (*v3.TxnResponse, error) {
+	if weight < 1 || weight > sm.limit {
+		return nil, ErrInvalidWeight
+	}
+	s := sm.s
+	client := sm.s.Client()
+
+	sm.myKey = fmt.Sprintf("%s%x", sm.pfx, s.Lease())
+	cmp := v3.Compare(v3.CreateRevision(sm.myKey), "=", 0)
+	// put self in semaphore waiters via myKey; the weight is its value
+	put := v3.OpPut(sm.myKey, strconv.FormatInt(weight, 10), v3.WithLease(s.Lease()))
+	// reuse key in case this session already holds the semaphore
+	get := v3.OpGet(sm.myKey)
+	// fetch all holders and waiters to complete uncontended path with only one RPC
+	getAll := v3.OpGet(sm.pfx, v3.WithPrefix(), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend))
+	resp, err := client.Txn(ctx).If(cmp).Then(put, getAll).Else(get, getAll).Commit()
+	if err != nil {
+		return nil, err
+	}
+	sm.myRev, sm.weight = resp.Header.Revision, weight
+	if !resp.Succeeded {
+		kv := resp.Responses[0].GetResponseRange().Kvs[0]
+		sm.myRev = kv.CreateRevision
+		if sm.weight, err = parseSemaphoreWeight(kv); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// heldBefore returns the total weight of the given semaphore keys that were
+// created before this session's key.
+func (sm *Semaphore) heldBefore(kvs []*mvccpb.KeyValue) (int64, error) {
+	var held int64
+	for _, kv := range kvs {
+		if kv.CreateRevision >= sm.myRev {
+			continue
+		}
+		w, err := parseSemaphoreWeight(kv)
+		if err != nil {
+			return 0, err
+		}
+		held += w
+	}
+	return held, nil
+}
+
+func parseSemaphoreWeight(kv *mvccpb.KeyValue) (int64, error) {
+	w, err := strconv.ParseInt(string(kv.Value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("semaphore: invalid weight %q of key %q", kv.Value, kv.Key)
+	}
+	return w, nil
+}
+
+// Release releases the weight held by the session, or stops its waiting.
+func (sm *Semaphore) Release(ctx context.Context) error {
+	if sm.myKey == "" || sm.myRev <= 0 || sm.myKey == "\x00" {
+		return ErrSemaphoreReleased
+	}
+
+	if !strings.HasPrefix(sm.myKey, sm.pfx) {
+		return fmt.Errorf("invalid key %q, it should have prefix %q", sm.myKey, sm.pfx)
+	}
+
+	client := sm.s.Client()
+	if _, err := client.Delete(ctx, sm.myKey); err != nil {
+		return err
+	}
+	sm.myKey = "\x00"
+	sm.myRev = -1
+	return nil
+}
+
+// IsOwner returns a comparison that holds while the session holds its
+// acquisition of the semaphore.
+func (sm *Semaphore) IsOwner() v3.Cmp {
+	return v3.FencingToken(sm.myKey, sm.myRev)
+}
+
+// Key returns the key that exists while the session holds the semaphore.
+func (sm *Semaphore) Key() string { return sm.myKey }
+
+// Weight returns the weight held by the session.
+func (sm *Semaphore) Weight() int64 { return sm.weight }
+
+// Header is the response header received from etcd on acquiring the semaphore.
+func (sm *Semaphore) Header() *pb.ResponseHeader { return sm.hdr }
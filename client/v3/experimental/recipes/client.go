@@ -27,6 +27,7 @@ var (
 	ErrWaitMismatch   = errors.New("unexpected wait result")
 	ErrTooManyClients = errors.New("too many clients")
 	ErrNoWatcher      = errors.New("no watcher channel")
+	ErrClaimLost      = errors.New("queue item claim lost")
 )
 
 // deleteRevKey deletes a key by revision, returning false if key is missing
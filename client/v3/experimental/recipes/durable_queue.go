@@ -0,0 +1,215 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// DurableQueue implements a multi-reader, multi-writer distributed queue
+// whose items survive consumer failures. Dequeue claims an item with the
+// session lease instead of deleting it; the item stays invisible to other
+// consumers until it is acknowledged, negatively acknowledged, or the
+// claiming session's lease expires.
+//
+// Items are stored under <prefix>/items, claims under <prefix>/claims, and
+// items that exceed the retry limit are moved under <prefix>/dead.
+type DurableQueue struct {
+	s   *concurrency.Session
+	ctx context.Context
+
+	itemPfx    string
+	claimPfx   string
+	deadPfx    string
+	maxRetries int
+}
+
+// QueueItem is an item claimed from a DurableQueue.
+type QueueItem struct {
+	key      string
+	val      string
+	retries  int
+	claimKey string
+	claimRev int64
+}
+
+func (qi *QueueItem) Key() string   { return qi.key }
+func (qi *QueueItem) Value() string { return qi.val }
+
+// Retries returns the number of earlier deliveries of the item that were
+// negatively acknowledged or whose claim expired.
+func (qi *QueueItem) Retries() int { return qi.retries }
+
+// NewDurableQueue creates a durable queue consuming with the given session.
+// An item is delivered at most maxRetries+1 times before it is moved to the
+// dead-letter prefix; a negative maxRetries retries forever.
+func NewDurableQueue(s *concurrency.Session, keyPrefix string, maxRetries int) *DurableQueue {
+	return &DurableQueue{
+		s:          s,
+		ctx:        context.TODO(),
+		itemPfx:    keyPrefix + "/items",
+		claimPfx:   keyPrefix + "/claims",
+		deadPfx:    keyPrefix + "/dead",
+		maxRetries: maxRetries,
+	}
+}
+
+// DeadLetterPrefix returns the key prefix holding dead-lettered items.
+func (q *DurableQueue) DeadLetterPrefix() string { return q.deadPfx + "/" }
+
+func (q *DurableQueue) Enqueue(val string) error {
+	_, err := newUniqueKV(q.s.Client(), q.itemPfx, val)
+	return err
+}
+
+// Dequeue claims the oldest visible item in FIFO order. If no item is
+// visible, Dequeue blocks until one is enqueued or a claim is released.
+func (q *DurableQueue) Dequeue() (*QueueItem, error) {
+	client := q.s.Client()
+	for {
+		resp, err := client.Txn(q.ctx).Then(
+			v3.OpGet(q.itemPfx+"/", v3.WithPrefix(), v3.WithSort(v3.SortByCreateRevision, v3.SortAscend)),
+			v3.OpGet(q.claimPfx+"/", v3.WithPrefix(), v3.WithKeysOnly()),
+		).Commit()
+		if err != nil {
+			return nil, err
+		}
+		claimed := make(map[string]struct{})
+		for _, kv := range resp.Responses[1].GetResponseRange().Kvs {
+			claimed[string(kv.Key)] = struct{}{}
+		}
+
+		for _, kv := range resp.Responses[0].GetResponseRange().Kvs {
+			claimKey := q.claimKey(string(kv.Key))
+			if _, ok := claimed[claimKey]; ok {
+				continue
+			}
+			qi, err := q.claim(kv, claimKey)
+			if err != nil || qi != nil {
+				return qi, err
+			}
+		}
+
+		// nothing visible; wait for new items or released claims
+		if err = q.waitVisible(resp.Header.Revision + 1); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Ack acknowledges a processed item, removing it from the queue. It returns
+// ErrClaimLost if the claim expired and the item may have been redelivered.
+func (q *DurableQueue) Ack(qi *QueueItem) error {
+	return q.release(qi, v3.OpDelete(qi.key), v3.OpDelete(qi.claimKey))
+}
+
+// Nack releases the claim on an item so it is redelivered, counting the
+// delivery as a retry.
+func (q *DurableQueue) Nack(qi *QueueItem) error {
+	return q.release(qi, v3.OpDelete(qi.claimKey))
+}
+
+// claim attempts to claim an unclaimed item, returning nil if another
+// consumer got it first. Claiming rewrites the item so its version counts
+// the deliveries; items that are out of retries are dead-lettered instead.
+func (q *DurableQueue) claim(kv *mvccpb.KeyValue, claimKey string) (*QueueItem, error) {
+	key := string(kv.Key)
+	cmps := []v3.Cmp{
+		v3.Compare(v3.ModRevision(key), "=", kv.ModRevision),
+		v3.Compare(v3.CreateRevision(claimKey), "=", 0),
+	}
+	retries := int(kv.Version - 1)
+	if q.maxRetries >= 0 && retries > q.maxRetries {
+		deadKey := q.deadPfx + strings.TrimPrefix(key, q.itemPfx)
+		_, err := q.s.Client().Txn(q.ctx).If(cmps...).Then(
+			v3.OpDelete(key),
+			v3.OpPut(deadKey, string(kv.Value)),
+		).Commit()
+		return nil, err
+	}
+
+	resp, err := q.s.Client().Txn(q.ctx).If(cmps...).Then(
+		v3.OpPut(key, string(kv.Value)),
+		v3.OpPut(claimKey, "", v3.WithLease(q.s.Lease())),
+	).Commit()
+	if err != nil || !resp.Succeeded {
+		return nil, err
+	}
+	return &QueueItem{
+		key:      key,
+		val:      string(kv.Value),
+		retries:  retries,
+		claimKey: claimKey,
+		claimRev: resp.Header.Revision,
+	}, nil
+}
+
+// release applies ops if the claim on the item is still held.
+func (q *DurableQueue) release(qi *QueueItem, ops ...v3.Op) error {
+	cmp := v3.FencingToken(qi.claimKey, qi.claimRev)
+	resp, err := q.s.Client().Txn(q.ctx).If(cmp).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return ErrClaimLost
+	}
+	return nil
+}
+
+// waitVisible waits from rev until an item is enqueued or a claim is deleted.
+func (q *DurableQueue) waitVisible(rev int64) error {
+	ctx, cancel := context.WithCancel(q.ctx)
+	defer cancel()
+	wc := q.s.Client().Watch(ctx, q.itemPfx+"/", v3.WithRev(rev), v3.WithPrefix(), v3.WithFilterDelete())
+	cwc := q.s.Client().Watch(ctx, q.claimPfx+"/", v3.WithRev(rev), v3.WithPrefix(), v3.WithFilterPut())
+	for {
+		select {
+		case wresp, ok := <-wc:
+			if !ok {
+				return ErrNoWatcher
+			}
+			if err := wresp.Err(); err != nil {
+				return err
+			}
+			for _, ev := range wresp.Events {
+				if ev.IsCreate() {
+					return nil
+				}
+			}
+		case wresp, ok := <-cwc:
+			if !ok {
+				return ErrNoWatcher
+			}
+			if err := wresp.Err(); err != nil {
+				return err
+			}
+			if len(wresp.Events) != 0 {
+				return nil
+			}
+		case <-q.s.Done():
+			return concurrency.ErrSessionExpired
+		}
+	}
+}
+
+func (q *DurableQueue) claimKey(itemKey string) string {
+	return q.claimPfx + strings.TrimPrefix(itemKey, q.itemPfx)
+}
@@ -29,6 +29,7 @@ type (
 	GetResponse     pb.RangeResponse
 	DeleteResponse  pb.DeleteRangeResponse
 	TxnResponse     pb.TxnResponse
+	HistoryResponse pb.KeyHistoryResponse
 )
 
 type KV interface {
@@ -54,6 +55,17 @@ type KV interface {
 	// Compact compacts etcd KV history before the given rev.
 	Compact(ctx context.Context, rev int64, opts ...CompactOption) (*CompactResponse, error)
 
+	// History lists every change to "key", deletions included, in revision order.
+	// When passed WithRange(end), WithPrefix() or WithFromKey(), History lists the
+	// changes to all the keys in the range.
+	// When passed WithMinModRev(rev) or WithMaxModRev(rev), only the changes made
+	// at or after, respectively at or before, the given revision are listed; the
+	// history starts at the last compaction revision by default. If the required
+	// revision is compacted, the request will fail with ErrCompacted.
+	// When passed WithLimit(limit), the number of returned events is bounded by
+	// limit; the next page starts at the NextRevision of the response.
+	History(ctx context.Context, key string, opts ...OpOption) (*HistoryResponse, error)
+
 	// Do applies a single Op on KV without a transaction.
 	// Do is useful when creating arbitrary operations to be issued at a
 	// later time; the user can range over the operations, calling Do to
@@ -134,6 +146,14 @@ func (kv *kv) Compact(ctx context.Context, rev int64, opts ...CompactOption) (*C
 	return (*CompactResponse)(resp), err
 }
 
+func (kv *kv) History(ctx context.Context, key string, opts ...OpOption) (*HistoryResponse, error) {
+	resp, err := kv.remote.KeyHistory(ctx, OpGet(key, opts...).toKeyHistoryRequest(), kv.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*HistoryResponse)(resp), err
+}
+
 func (kv *kv) Txn(ctx context.Context) Txn {
 	return &txn{
 		kv:       kv,
@@ -157,7 +177,7 @@ func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
 		}
 	case tPut:
 		var resp *pb.PutResponse
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
+		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, Ttl: op.ttl}
 		resp, err = kv.remote.Put(ctx, r, kv.callOpts...)
 		if err == nil {
 			return OpResponse{put: (*PutResponse)(resp)}, nil
@@ -29,6 +29,8 @@ import (
 
 type (
 	LeaseRevokeResponse pb.LeaseRevokeResponse
+	LeaseAttachResponse pb.LeaseAttachResponse
+	LeaseDetachResponse pb.LeaseDetachResponse
 	LeaseID             int64
 )
 
@@ -60,6 +62,13 @@ type LeaseTimeToLiveResponse struct {
 
 	// Keys is the list of keys attached to this lease.
 	Keys [][]byte `json:"keys"`
+
+	// Parent is the lease this lease was granted under, or NoLease.
+	Parent LeaseID `json:"parent"`
+
+	// Children is the list of leases granted under this lease, directly or
+	// indirectly, which are all revoked along with it.
+	Children []LeaseID `json:"children"`
 }
 
 // LeaseStatus represents a lease status.
@@ -109,6 +118,10 @@ type Lease interface {
 	// Grant creates a new lease.
 	Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error)
 
+	// GrantChild creates a new lease under the given parent lease. The
+	// child lease is revoked when its parent is revoked or expires.
+	GrantChild(ctx context.Context, parent LeaseID, ttl int64) (*LeaseGrantResponse, error)
+
 	// Revoke revokes the given lease.
 	Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse, error)
 
@@ -118,6 +131,15 @@ type Lease interface {
 	// Leases retrieves all leases.
 	Leases(ctx context.Context) (*LeaseLeasesResponse, error)
 
+	// Attach attaches the given existing keys to the lease without changing
+	// their values. Watchers observe a lease event for each key that moved.
+	Attach(ctx context.Context, id LeaseID, keys ...string) (*LeaseAttachResponse, error)
+
+	// Detach detaches the given existing keys from their leases without
+	// changing their values. Watchers observe a lease event for each key
+	// that was attached to a lease.
+	Detach(ctx context.Context, keys ...string) (*LeaseDetachResponse, error)
+
 	// KeepAlive attempts to keep the given lease alive forever. If the keepalive responses posted
 	// to the channel are not consumed promptly the channel may become full. When full, the lease
 	// client will continue sending keep alive requests to the etcd server, but will drop responses
@@ -212,7 +234,14 @@ func NewLeaseFromLeaseClient(remote pb.LeaseClient, c *Client, keepAliveTimeout
 }
 
 func (l *lessor) Grant(ctx context.Context, ttl int64) (*LeaseGrantResponse, error) {
-	r := &pb.LeaseGrantRequest{TTL: ttl}
+	return l.grant(ctx, &pb.LeaseGrantRequest{TTL: ttl})
+}
+
+func (l *lessor) GrantChild(ctx context.Context, parent LeaseID, ttl int64) (*LeaseGrantResponse, error) {
+	return l.grant(ctx, &pb.LeaseGrantRequest{TTL: ttl, Parent: int64(parent)})
+}
+
+func (l *lessor) grant(ctx context.Context, r *pb.LeaseGrantRequest) (*LeaseGrantResponse, error) {
 	resp, err := l.remote.LeaseGrant(ctx, r, l.callOpts...)
 	if err == nil {
 		gresp := &LeaseGrantResponse{
@@ -235,6 +264,32 @@ func (l *lessor) Revoke(ctx context.Context, id LeaseID) (*LeaseRevokeResponse,
 	return nil, toErr(ctx, err)
 }
 
+func (l *lessor) Attach(ctx context.Context, id LeaseID, keys ...string) (*LeaseAttachResponse, error) {
+	r := &pb.LeaseAttachRequest{ID: int64(id), Keys: toKeyBytes(keys)}
+	resp, err := l.remote.LeaseAttach(ctx, r, l.callOpts...)
+	if err == nil {
+		return (*LeaseAttachResponse)(resp), nil
+	}
+	return nil, toErr(ctx, err)
+}
+
+func (l *lessor) Detach(ctx context.Context, keys ...string) (*LeaseDetachResponse, error) {
+	r := &pb.LeaseDetachRequest{Keys: toKeyBytes(keys)}
+	resp, err := l.remote.LeaseDetach(ctx, r, l.callOpts...)
+	if err == nil {
+		return (*LeaseDetachResponse)(resp), nil
+	}
+	return nil, toErr(ctx, err)
+}
+
+func toKeyBytes(keys []string) [][]byte {
+	bs := make([][]byte, len(keys))
+	for i, k := range keys {
+		bs[i] = []byte(k)
+	}
+	return bs
+}
+
 func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption) (*LeaseTimeToLiveResponse, error) {
 	r := toLeaseTimeToLiveRequest(id, opts...)
 	resp, err := l.remote.LeaseTimeToLive(ctx, r, l.callOpts...)
@@ -247,6 +302,10 @@ func (l *lessor) TimeToLive(ctx context.Context, id LeaseID, opts ...LeaseOption
 		TTL:            resp.TTL,
 		GrantedTTL:     resp.GrantedTTL,
 		Keys:           resp.Keys,
+		Parent:         LeaseID(resp.Parent),
+	}
+	for _, id := range resp.Children {
+		gresp.Children = append(gresp.Children, LeaseID(id))
 	}
 	return gresp, nil
 }
@@ -117,6 +117,10 @@ func (lkv *leasingKV) Compact(ctx context.Context, rev int64, opts ...v3.Compact
 	return lkv.kv.Compact(ctx, rev, opts...)
 }
 
+func (lkv *leasingKV) History(ctx context.Context, key string, opts ...v3.OpOption) (*v3.HistoryResponse, error) {
+	return lkv.kv.History(ctx, key, opts...)
+}
+
 func (lkv *leasingKV) Txn(ctx context.Context) v3.Txn {
 	return &txnLeasing{Txn: lkv.kv.Txn(ctx), lkv: lkv, ctx: ctx}
 }
@@ -34,6 +34,11 @@ type (
 	HashKVResponse     pb.HashKVResponse
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
+	QuotaSetResponse   pb.QuotaSetResponse
+	QuotaGetResponse   pb.QuotaGetResponse
+	QuotaListResponse  pb.QuotaListResponse
+
+	CompactEstimateResponse pb.CompactEstimateResponse
 
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
@@ -60,6 +65,11 @@ type Maintenance interface {
 	// times with different endpoints.
 	Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error)
 
+	// DefragmentOnline defragments a given etcd member like Defragment, but copies
+	// its database in the background so the member keeps serving requests.
+	// The progress is reported in the member's Status.
+	DefragmentOnline(ctx context.Context, endpoint string) (*DefragmentResponse, error)
+
 	// Status gets the status of the endpoint.
 	Status(ctx context.Context, endpoint string) (*StatusResponse, error)
 
@@ -87,6 +97,32 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// QuotaSet limits the number of keys and the total size in bytes of the keys
+	// and values under the given prefix. A zero limit means no limit; setting both
+	// limits to zero removes the quota of the prefix.
+	// Supported since etcd 3.6.
+	QuotaSet(ctx context.Context, prefix string, maxKeys, maxBytes int64) (*QuotaSetResponse, error)
+
+	// QuotaGet gets the quota of the given prefix along with its current usage.
+	// Supported since etcd 3.6.
+	QuotaGet(ctx context.Context, prefix string) (*QuotaGetResponse, error)
+
+	// QuotaList lists all quotas along with their current usage.
+	// Supported since etcd 3.6.
+	QuotaList(ctx context.Context) (*QuotaListResponse, error)
+
+	// SnapshotIncremental returns a reader for an incremental snapshot holding the
+	// key revisions after baseRev and the full contents of all other buckets.
+	// The revision of the increment is set in the response header.
+	// Supported since etcd 3.6.
+	SnapshotIncremental(ctx context.Context, baseRev int64) (*SnapshotResponse, error)
+
+	// CompactEstimate estimates how many keys, revisions and bytes compacting
+	// at rev would free, and how many watchers on the responding member it
+	// would cancel, without compacting.
+	// Supported since etcd 3.6.
+	CompactEstimate(ctx context.Context, rev int64) (*CompactEstimateResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -189,12 +225,20 @@ func (m *maintenance) AlarmDisarm(ctx context.Context, am *AlarmMember) (*AlarmR
 }
 
 func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return m.defragment(ctx, endpoint, &pb.DefragmentRequest{})
+}
+
+func (m *maintenance) DefragmentOnline(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
+	return m.defragment(ctx, endpoint, &pb.DefragmentRequest{Online: true})
+}
+
+func (m *maintenance) defragment(ctx context.Context, endpoint string, req *pb.DefragmentRequest) (*DefragmentResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
 		return nil, toErr(ctx, err)
 	}
 	defer cancel()
-	resp, err := remote.Defragment(ctx, &pb.DefragmentRequest{}, m.callOpts...)
+	resp, err := remote.Defragment(ctx, req, m.callOpts...)
 	if err != nil {
 		return nil, toErr(ctx, err)
 	}
@@ -235,6 +279,26 @@ func (m *maintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotRespons
 	}
 
 	m.lg.Info("opened snapshot stream; downloading")
+	return m.readSnapshot(ctx, ss)
+}
+
+func (m *maintenance) SnapshotIncremental(ctx context.Context, baseRev int64) (*SnapshotResponse, error) {
+	ss, err := m.remote.SnapshotIncremental(ctx, &pb.SnapshotIncrementalRequest{BaseRevision: baseRev}, append(m.callOpts, withMax(defaultStreamMaxRetries))...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+
+	m.lg.Info("opened incremental snapshot stream; downloading", zap.Int64("base-revision", baseRev))
+	resp, err := m.readSnapshot(ctx, ss)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return resp, nil
+}
+
+// readSnapshot receives the first response of the snapshot stream and
+// pipes the remaining blobs into the returned response's reader.
+func (m *maintenance) readSnapshot(ctx context.Context, ss pb.Maintenance_SnapshotClient) (*SnapshotResponse, error) {
 	pr, pw := io.Pipe()
 
 	resp, err := ss.Recv()
@@ -348,3 +412,24 @@ func (m *maintenance) Downgrade(ctx context.Context, action DowngradeAction, ver
 	resp, err := m.remote.Downgrade(ctx, &pb.DowngradeRequest{Action: actionType, Version: version}, m.callOpts...)
 	return (*DowngradeResponse)(resp), toErr(ctx, err)
 }
+
+func (m *maintenance) QuotaSet(ctx context.Context, prefix string, maxKeys, maxBytes int64) (*QuotaSetResponse, error) {
+	req := &pb.QuotaSetRequest{Quota: &pb.PrefixQuota{Prefix: []byte(prefix), MaxKeys: maxKeys, MaxBytes: maxBytes}}
+	resp, err := m.remote.QuotaSet(ctx, req, m.callOpts...)
+	return (*QuotaSetResponse)(resp), toErr(ctx, err)
+}
+
+func (m *maintenance) QuotaGet(ctx context.Context, prefix string) (*QuotaGetResponse, error) {
+	resp, err := m.remote.QuotaGet(ctx, &pb.QuotaGetRequest{Prefix: []byte(prefix)}, m.callOpts...)
+	return (*QuotaGetResponse)(resp), toErr(ctx, err)
+}
+
+func (m *maintenance) QuotaList(ctx context.Context) (*QuotaListResponse, error) {
+	resp, err := m.remote.QuotaList(ctx, &pb.QuotaListRequest{}, m.callOpts...)
+	return (*QuotaListResponse)(resp), toErr(ctx, err)
+}
+
+func (m *maintenance) CompactEstimate(ctx context.Context, rev int64) (*CompactEstimateResponse, error) {
+	resp, err := m.remote.CompactEstimate(ctx, &pb.CompactEstimateRequest{Revision: rev}, m.callOpts...)
+	return (*CompactEstimateResponse)(resp), toErr(ctx, err)
+}
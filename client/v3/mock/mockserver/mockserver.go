@@ -187,6 +187,10 @@ func (m *mockKVServer) Compact(context.Context, *pb.CompactionRequest) (*pb.Comp
 	return &pb.CompactionResponse{}, nil
 }
 
+func (m *mockKVServer) KeyHistory(context.Context, *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	return &pb.KeyHistoryResponse{}, nil
+}
+
 func (m *mockKVServer) Lease(context.Context, *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
 	return &pb.LeaseGrantResponse{}, nil
 }
@@ -209,6 +213,14 @@ func (s *mockLeaseServer) LeaseTimeToLive(context.Context, *pb.LeaseTimeToLiveRe
 	return &pb.LeaseTimeToLiveResponse{}, nil
 }
 
+func (s *mockLeaseServer) LeaseAttach(context.Context, *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	return &pb.LeaseAttachResponse{}, nil
+}
+
+func (s *mockLeaseServer) LeaseDetach(context.Context, *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	return &pb.LeaseDetachResponse{}, nil
+}
+
 func (s *mockLeaseServer) LeaseLeases(context.Context, *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error) {
 	return &pb.LeaseLeasesResponse{}, nil
 }
@@ -77,6 +77,24 @@ func (kv *kvPrefix) Delete(ctx context.Context, key string, opts ...clientv3.OpO
 	return del, nil
 }
 
+func (kv *kvPrefix) History(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.HistoryResponse, error) {
+	if len(key) == 0 && !(clientv3.IsOptsWithFromKey(opts) || clientv3.IsOptsWithPrefix(opts)) {
+		return nil, rpctypes.ErrEmptyKey
+	}
+	op := kv.prefixOp(clientv3.OpGet(key, opts...))
+	// the range options were resolved against the unprefixed key; the
+	// trailing WithRange overrides them with the prefixed range.
+	opts = append(opts, clientv3.WithRange(string(op.RangeBytes())))
+	resp, err := kv.KV.History(ctx, string(op.KeyBytes()), opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, ev := range resp.Events {
+		ev.Kv.Key = ev.Kv.Key[len(kv.pfx):]
+	}
+	return resp, nil
+}
+
 func (kv *kvPrefix) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
 	if len(op.KeyBytes()) == 0 && !op.IsTxn() {
 		return clientv3.OpResponse{}, rpctypes.ErrEmptyKey
@@ -55,3 +55,19 @@ func (l *leasePrefix) TimeToLive(ctx context.Context, id clientv3.LeaseID, opts
 	}
 	return resp, nil
 }
+
+func (l *leasePrefix) Attach(ctx context.Context, id clientv3.LeaseID, keys ...string) (*clientv3.LeaseAttachResponse, error) {
+	return l.Lease.Attach(ctx, id, l.prefixKeys(keys)...)
+}
+
+func (l *leasePrefix) Detach(ctx context.Context, keys ...string) (*clientv3.LeaseDetachResponse, error) {
+	return l.Lease.Detach(ctx, l.prefixKeys(keys)...)
+}
+
+func (l *leasePrefix) prefixKeys(keys []string) []string {
+	pfxKeys := make([]string, len(keys))
+	for i, k := range keys {
+		pfxKeys[i] = string(l.pfx) + k
+	}
+	return pfxKeys
+}
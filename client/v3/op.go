@@ -14,7 +14,11 @@
 
 package clientv3
 
-import pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+import (
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
 
 type opType int
 
@@ -35,15 +39,16 @@ type Op struct {
 	end []byte
 
 	// for range
-	limit        int64
-	sort         *SortOption
-	serializable bool
-	keysOnly     bool
-	countOnly    bool
-	minModRev    int64
-	maxModRev    int64
-	minCreateRev int64
-	maxCreateRev int64
+	limit         int64
+	sort          *SortOption
+	serializable  bool
+	keysOnly      bool
+	countOnly     bool
+	minModRev     int64
+	maxModRev     int64
+	minCreateRev  int64
+	maxCreateRev  int64
+	continueToken []byte
 
 	// for range, watch
 	rev int64
@@ -68,10 +73,14 @@ type Op struct {
 	// filters for watchers
 	filterPut    bool
 	filterDelete bool
+	leaseEvents  bool
+	eventFilter  *pb.WatchEventFilter
 
 	// for put
 	val     []byte
 	leaseID LeaseID
+	// ttl is the key expiry in seconds.
+	ttl int64
 
 	// txn
 	cmps    []Cmp
@@ -145,6 +154,20 @@ func (op Op) ValueBytes() []byte { return op.val }
 // WithValueBytes sets the byte slice for the Op's value.
 func (op *Op) WithValueBytes(v []byte) { op.val = v }
 
+func (op Op) toKeyHistoryRequest() *pb.KeyHistoryRequest {
+	if op.t != tRange {
+		panic("op.t != tRange")
+	}
+	return &pb.KeyHistoryRequest{
+		Key:           op.key,
+		RangeEnd:      op.end,
+		StartRevision: op.minModRev,
+		EndRevision:   op.maxModRev,
+		Limit:         op.limit,
+		Serializable:  op.serializable,
+	}
+}
+
 func (op Op) toRangeRequest() *pb.RangeRequest {
 	if op.t != tRange {
 		panic("op.t != tRange")
@@ -161,6 +184,7 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		ContinueToken:     op.continueToken,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -190,7 +214,7 @@ func (op Op) toRequestOp() *pb.RequestOp {
 	case tRange:
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestRange{RequestRange: op.toRangeRequest()}}
 	case tPut:
-		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease}
+		r := &pb.PutRequest{Key: op.key, Value: op.val, Lease: int64(op.leaseID), PrevKv: op.prevKV, IgnoreValue: op.ignoreValue, IgnoreLease: op.ignoreLease, Ttl: op.ttl}
 		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: r}}
 	case tDeleteRange:
 		r := &pb.DeleteRangeRequest{Key: op.key, RangeEnd: op.end, PrevKv: op.prevKV}
@@ -245,6 +269,8 @@ func OpDelete(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in delete")
+	case ret.ttl != 0:
+		panic("unexpected ttl in delete")
 	case ret.limit != 0:
 		panic("unexpected limit in delete")
 	case ret.rev != 0:
@@ -259,7 +285,9 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.continueToken != nil:
+		panic("unexpected continue token in delete")
+	case ret.filterDelete, ret.filterPut, ret.leaseEvents, ret.eventFilter != nil:
 		panic("unexpected filter in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
@@ -288,7 +316,9 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.continueToken != nil:
+		panic("unexpected continue token in put")
+	case ret.filterDelete, ret.filterPut, ret.leaseEvents, ret.eventFilter != nil:
 		panic("unexpected filter in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
@@ -307,6 +337,8 @@ func opWatch(key string, opts ...OpOption) Op {
 	switch {
 	case ret.leaseID != 0:
 		panic("unexpected lease in watch")
+	case ret.ttl != 0:
+		panic("unexpected ttl in watch")
 	case ret.limit != 0:
 		panic("unexpected limit in watch")
 	case ret.sort != nil:
@@ -319,6 +351,8 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in watch")
+	case ret.continueToken != nil:
+		panic("unexpected continue token in watch")
 	}
 	return ret
 }
@@ -448,6 +482,16 @@ func WithMinCreateRev(rev int64) OpOption { return func(op *Op) { op.minCreateRe
 // WithMaxCreateRev filters out keys for Get with creation revisions greater than the given revision.
 func WithMaxCreateRev(rev int64) OpOption { return func(op *Op) { op.maxCreateRev = rev } }
 
+// WithContinue resumes a paginated 'Get' from the ContinueToken of the
+// previous page's response. The 'Get' must use the same key, range and sort
+// options as the original request; it is served at the revision of the first
+// page, so all pages form a consistent view of the range. A response carries
+// a ContinueToken only if the range is ordered by key and has more results
+// than the limit.
+func WithContinue(token []byte) OpOption {
+	return func(op *Op) { op.continueToken = token }
+}
+
 // WithFirstCreate gets the key with the oldest creation revision in the request range.
 func WithFirstCreate() []OpOption { return withTop(SortByCreateRevision, SortAscend) }
 
@@ -497,6 +541,60 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithLeaseEvents makes the watcher also receive LEASE events, which only
+// change the lease of a key. Watchers do not receive them by default.
+func WithLeaseEvents() OpOption {
+	return func(op *Op) { op.leaseEvents = true }
+}
+
+// WithFilterKeySuffix discards events on keys not ending with the given suffix
+// from the watcher.
+func WithFilterKeySuffix(suffix string) OpOption {
+	return func(op *Op) { op.watchEventFilter().KeySuffix = []byte(suffix) }
+}
+
+// WithFilterKeyGlob discards events on keys not matching the given shell
+// pattern from the watcher. The pattern syntax is the one of path.Match, so
+// '*' does not match '/'. The watch is canceled if the pattern is malformed.
+func WithFilterKeyGlob(pattern string) OpOption {
+	return func(op *Op) { op.watchEventFilter().KeyGlob = pattern }
+}
+
+// WithFilterValuePrefix discards PUT events whose value does not begin with
+// the given prefix from the watcher. It overrides WithFilterValue.
+func WithFilterValuePrefix(prefix string) OpOption {
+	return func(op *Op) {
+		op.watchEventFilter().ValueMatch = &pb.WatchEventFilter_ValuePrefix{ValuePrefix: []byte(prefix)}
+	}
+}
+
+// WithFilterValue discards PUT events whose value is not equal to the given
+// value from the watcher. It overrides WithFilterValuePrefix.
+func WithFilterValue(value string) OpOption {
+	return func(op *Op) {
+		op.watchEventFilter().ValueMatch = &pb.WatchEventFilter_ValueEqual{ValueEqual: []byte(value)}
+	}
+}
+
+// WithFilterLeaseAttached discards PUT events on keys not attached to a lease
+// from the watcher.
+func WithFilterLeaseAttached() OpOption {
+	return func(op *Op) { op.watchEventFilter().Lease = pb.WatchEventFilter_ATTACHED }
+}
+
+// WithFilterLeaseDetached discards PUT events on keys attached to a lease
+// from the watcher.
+func WithFilterLeaseDetached() OpOption {
+	return func(op *Op) { op.watchEventFilter().Lease = pb.WatchEventFilter_DETACHED }
+}
+
+func (op *Op) watchEventFilter() *pb.WatchEventFilter {
+	if op.eventFilter == nil {
+		op.eventFilter = &pb.WatchEventFilter{}
+	}
+	return op.eventFilter
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
@@ -534,6 +632,16 @@ func WithIgnoreLease() OpOption {
 	}
 }
 
+// WithTTL expires the key in 'Put' request after the given duration
+// without attaching it to a lease. The ttl is rounded up to whole seconds.
+// Expired keys are deleted by the leader, so a key may outlive its ttl by
+// a short while.
+func WithTTL(ttl time.Duration) OpOption {
+	return func(op *Op) {
+		op.ttl = int64((ttl + time.Second - 1) / time.Second)
+	}
+}
+
 // LeaseOp represents an Operation that lease can execute.
 type LeaseOp struct {
 	id LeaseID
@@ -37,6 +37,20 @@ func TestOpWithSort(t *testing.T) {
 	}
 }
 
+func TestOpWithContinue(t *testing.T) {
+	token := []byte("token")
+	opReq := OpGet("foo", WithPrefix(), WithLimit(10), WithContinue(token)).toRequestOp().Request
+	q, ok := opReq.(*pb.RequestOp_RequestRange)
+	if !ok {
+		t.Fatalf("expected range request, got %v", reflect.TypeOf(opReq))
+	}
+	req := q.RequestRange
+	wreq := &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop"), Limit: 10, ContinueToken: token}
+	if !reflect.DeepEqual(req, wreq) {
+		t.Fatalf("expected %+v, got %+v", wreq, req)
+	}
+}
+
 func TestIsSortOptionValid(t *testing.T) {
 	rangeReqs := []struct {
 		sortOrder     pb.RangeRequest_SortOrder
@@ -121,6 +121,10 @@ func (rkv *retryKVClient) Compact(ctx context.Context, in *pb.CompactionRequest,
 	return rkv.kc.Compact(ctx, in, opts...)
 }
 
+func (rkv *retryKVClient) KeyHistory(ctx context.Context, in *pb.KeyHistoryRequest, opts ...grpc.CallOption) (resp *pb.KeyHistoryResponse, err error) {
+	return rkv.kc.KeyHistory(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
 type retryLeaseClient struct {
 	lc pb.LeaseClient
 }
@@ -148,6 +152,14 @@ func (rlc *retryLeaseClient) LeaseRevoke(ctx context.Context, in *pb.LeaseRevoke
 	return rlc.lc.LeaseRevoke(ctx, in, append(opts, withRetryPolicy(repeatable))...)
 }
 
+func (rlc *retryLeaseClient) LeaseAttach(ctx context.Context, in *pb.LeaseAttachRequest, opts ...grpc.CallOption) (resp *pb.LeaseAttachResponse, err error) {
+	return rlc.lc.LeaseAttach(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rlc *retryLeaseClient) LeaseDetach(ctx context.Context, in *pb.LeaseDetachRequest, opts ...grpc.CallOption) (resp *pb.LeaseDetachResponse, err error) {
+	return rlc.lc.LeaseDetach(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
 func (rlc *retryLeaseClient) LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (stream pb.Lease_LeaseKeepAliveClient, err error) {
 	return rlc.lc.LeaseKeepAlive(ctx, append(opts, withRetryPolicy(repeatable))...)
 }
@@ -214,6 +226,10 @@ func (rmc *retryMaintenanceClient) Snapshot(ctx context.Context, in *pb.Snapshot
 	return rmc.mc.Snapshot(ctx, in, append(opts, withRetryPolicy(repeatable))...)
 }
 
+func (rmc *retryMaintenanceClient) SnapshotIncremental(ctx context.Context, in *pb.SnapshotIncrementalRequest, opts ...grpc.CallOption) (stream pb.Maintenance_SnapshotIncrementalClient, err error) {
+	return rmc.mc.SnapshotIncremental(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
 func (rmc *retryMaintenanceClient) MoveLeader(ctx context.Context, in *pb.MoveLeaderRequest, opts ...grpc.CallOption) (resp *pb.MoveLeaderResponse, err error) {
 	return rmc.mc.MoveLeader(ctx, in, append(opts, withRetryPolicy(repeatable))...)
 }
@@ -226,6 +242,22 @@ func (rmc *retryMaintenanceClient) Downgrade(ctx context.Context, in *pb.Downgra
 	return rmc.mc.Downgrade(ctx, in, opts...)
 }
 
+func (rmc *retryMaintenanceClient) QuotaSet(ctx context.Context, in *pb.QuotaSetRequest, opts ...grpc.CallOption) (resp *pb.QuotaSetResponse, err error) {
+	return rmc.mc.QuotaSet(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rmc *retryMaintenanceClient) QuotaGet(ctx context.Context, in *pb.QuotaGetRequest, opts ...grpc.CallOption) (resp *pb.QuotaGetResponse, err error) {
+	return rmc.mc.QuotaGet(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rmc *retryMaintenanceClient) QuotaList(ctx context.Context, in *pb.QuotaListRequest, opts ...grpc.CallOption) (resp *pb.QuotaListResponse, err error) {
+	return rmc.mc.QuotaList(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
+func (rmc *retryMaintenanceClient) CompactEstimate(ctx context.Context, in *pb.CompactEstimateRequest, opts ...grpc.CallOption) (resp *pb.CompactEstimateResponse, err error) {
+	return rmc.mc.CompactEstimate(ctx, in, append(opts, withRetryPolicy(repeatable))...)
+}
+
 type retryAuthClient struct {
 	ac pb.AuthClient
 }
@@ -46,13 +46,39 @@ func hasChecksum(n int64) bool {
 // the selected node.
 // Etcd <v3.6 will return "" as version.
 func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string) (version string, err error) {
+	resp, err := save(ctx, lg, cfg, dbPath, func(cli *clientv3.Client) (*clientv3.SnapshotResponse, error) {
+		return cli.SnapshotWithVersion(ctx)
+	})
+	if resp == nil {
+		return "", err
+	}
+	return resp.Version, err
+}
+
+// SaveIncremental fetches an incremental snapshot holding the changes made
+// after baseRev from remote etcd server and saves data to target path.
+// It returns the revision of the increment and the server version.
+// The increment can be applied to a snapshot taken at baseRev with
+// "etcdutl snapshot merge". Make sure to specify only one endpoint
+// in client configuration.
+func SaveIncremental(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, baseRev int64, dbPath string) (rev int64, version string, err error) {
+	resp, err := save(ctx, lg, cfg, dbPath, func(cli *clientv3.Client) (*clientv3.SnapshotResponse, error) {
+		return cli.SnapshotIncremental(ctx, baseRev)
+	})
+	if resp == nil {
+		return 0, "", err
+	}
+	return resp.Header.GetRevision(), resp.Version, err
+}
+
+func save(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string, fetch func(cli *clientv3.Client) (*clientv3.SnapshotResponse, error)) (*clientv3.SnapshotResponse, error) {
 	cfg.Logger = lg.Named("client")
 	if len(cfg.Endpoints) != 1 {
-		return "", fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
+		return nil, fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
 	}
 	cli, err := clientv3.New(cfg)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer cli.Close()
 
@@ -62,41 +88,41 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 	var f *os.File
 	f, err = os.OpenFile(partpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
 	if err != nil {
-		return "", fmt.Errorf("could not open %s (%v)", partpath, err)
+		return nil, fmt.Errorf("could not open %s (%v)", partpath, err)
 	}
 	lg.Info("created temporary db file", zap.String("path", partpath))
 
 	start := time.Now()
-	resp, err := cli.SnapshotWithVersion(ctx)
+	resp, err := fetch(cli)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Snapshot.Close()
 	lg.Info("fetching snapshot", zap.String("endpoint", cfg.Endpoints[0]))
 	var size int64
 	size, err = io.Copy(f, resp.Snapshot)
 	if err != nil {
-		return resp.Version, err
+		return resp, err
 	}
 	if !hasChecksum(size) {
-		return resp.Version, fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
+		return resp, fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
 	}
 	if err = fileutil.Fsync(f); err != nil {
-		return resp.Version, err
+		return resp, err
 	}
 	if err = f.Close(); err != nil {
-		return resp.Version, err
+		return resp, err
 	}
 	lg.Info("fetched snapshot",
 		zap.String("endpoint", cfg.Endpoints[0]),
 		zap.String("size", humanize.Bytes(uint64(size))),
 		zap.Duration("took", time.Since(start)),
-		zap.String("etcd-version", version),
+		zap.String("etcd-version", resp.Version),
 	)
 
 	if err = os.Rename(partpath, dbPath); err != nil {
-		return resp.Version, fmt.Errorf("could not rename %s to %s (%v)", partpath, dbPath, err)
+		return resp, fmt.Errorf("could not rename %s to %s (%v)", partpath, dbPath, err)
 	}
 	lg.Info("saved", zap.String("path", dbPath))
-	return resp.Version, nil
+	return resp, nil
 }
@@ -35,6 +35,7 @@ import (
 const (
 	EventTypeDelete = mvccpb.DELETE
 	EventTypePut    = mvccpb.PUT
+	EventTypeLease  = mvccpb.LEASE
 
 	closeSendErrTimeout = 250 * time.Millisecond
 
@@ -219,6 +220,10 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// eventFilter filters out events on their key, value and lease
+	eventFilter *pb.WatchEventFilter
+	// leaseEvents is true if LEASE events are sent to the watcher
+	leaseEvents bool
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -319,6 +324,8 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		progressNotify: ow.progressNotify,
 		fragment:       ow.fragment,
 		filters:        filters,
+		eventFilter:    ow.eventFilter,
+		leaseEvents:    ow.leaseEvents,
 		prevKV:         ow.prevKV,
 		retc:           make(chan chan WatchResponse, 1),
 	}
@@ -1052,6 +1059,8 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		RangeEnd:       []byte(wr.end),
 		ProgressNotify: wr.progressNotify,
 		Filters:        wr.filters,
+		EventFilter:    wr.eventFilter,
+		LeaseEvents:    wr.leaseEvents,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
 	}
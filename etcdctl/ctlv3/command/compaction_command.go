@@ -24,7 +24,10 @@ import (
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
 
-var compactPhysical bool
+var (
+	compactPhysical bool
+	compactDryRun   bool
+)
 
 // NewCompactionCommand returns the cobra command for "compaction".
 func NewCompactionCommand() *cobra.Command {
@@ -34,6 +37,7 @@ func NewCompactionCommand() *cobra.Command {
 		Run:   compactionCommandFunc,
 	}
 	cmd.Flags().BoolVar(&compactPhysical, "physical", false, "'true' to wait for compaction to physically remove all old revisions")
+	cmd.Flags().BoolVar(&compactDryRun, "dry-run", false, "'true' to estimate the revisions, bytes and watchers the compaction would remove without compacting")
 	return cmd
 }
 
@@ -48,6 +52,18 @@ func compactionCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
 
+	if compactDryRun {
+		c := mustClientFromCmd(cmd)
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.CompactEstimate(ctx, rev)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.CompactEstimate(rev, *resp)
+		return
+	}
+
 	var opts []clientv3.CompactOption
 	if compactPhysical {
 		opts = append(opts, clientv3.WithCompactPhysical())
@@ -24,6 +24,8 @@ import (
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 )
 
+var defragOnline bool
+
 // NewDefragCommand returns the cobra command for "Defrag".
 func NewDefragCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +34,7 @@ func NewDefragCommand() *cobra.Command {
 		Run:   defragCommandFunc,
 	}
 	cmd.PersistentFlags().BoolVar(&epClusterEndpoints, "cluster", false, "use all endpoints from the cluster member list")
+	cmd.Flags().BoolVar(&defragOnline, "online", false, "defragment in the background without blocking the member's requests")
 	return cmd
 }
 
@@ -43,7 +46,12 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 		c := mustClient(cfg)
 		ctx, cancel := commandCtx(cmd)
 		start := time.Now()
-		_, err := c.Defragment(ctx, ep)
+		var err error
+		if defragOnline {
+			_, err = c.DefragmentOnline(ctx, ep)
+		} else {
+			_, err = c.Defragment(ctx, ep)
+		}
 		d := time.Now().Sub(start)
 		cancel()
 		if err != nil {
@@ -17,6 +17,7 @@ package command
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -29,7 +30,9 @@ import (
 )
 
 var (
-	electListen bool
+	electListen     bool
+	electPriority   int64
+	electTransferTo string
 )
 
 // NewElectCommand returns the cobra command for "elect".
@@ -40,6 +43,8 @@ func NewElectCommand() *cobra.Command {
 		Run:   electCommandFunc,
 	}
 	cmd.Flags().BoolVarP(&electListen, "listen", "l", false, "observation mode")
+	cmd.Flags().Int64Var(&electPriority, "priority", 0, "campaign ahead of the waiting candidates with a lower priority")
+	cmd.Flags().StringVar(&electTransferTo, "transfer-to", "", "hand the current leadership over to the waiting candidate with the given key")
 	return cmd
 }
 
@@ -50,7 +55,12 @@ func electCommandFunc(cmd *cobra.Command, args []string) {
 	c := mustClientFromCmd(cmd)
 
 	var err error
-	if len(args) == 1 {
+	if electTransferTo != "" {
+		if len(args) != 1 || electListen {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("--transfer-to takes only an election name argument"))
+		}
+		err = transfer(c, args[0], electTransferTo)
+	} else if len(args) == 1 {
 		if !electListen {
 			cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("no proposal argument but -l not set"))
 		}
@@ -117,7 +127,7 @@ func campaign(c *clientv3.Client, election string, prop string) error {
 		close(donec)
 	}()
 
-	if err = e.Campaign(ctx, prop); err != nil {
+	if err = e.Campaign(ctx, prop, concurrency.WithPriority(electPriority)); err != nil {
 		return err
 	}
 
@@ -128,11 +138,43 @@ func campaign(c *clientv3.Client, election string, prop string) error {
 	}
 	display.Get(*resp)
 
+	// the leadership may be transferred to another candidate
+	wctx, wcancel := context.WithCancel(context.TODO())
+	defer wcancel()
+	wch := c.Watch(wctx, e.Key(), clientv3.WithRev(resp.Header.Revision+1), clientv3.WithFilterPut())
+
 	select {
 	case <-donec:
 	case <-s.Done():
 		return errors.New("elect: session expired")
+	case <-wch:
+		return errors.New("elect: leadership lost")
 	}
 
 	return e.Resign(context.TODO())
 }
+
+func transfer(c *clientv3.Client, election string, candidate string) error {
+	ctx := context.TODO()
+	resp, err := c.Get(ctx, election+"/", clientv3.WithFirstCreate()...)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		return concurrency.ErrElectionNoLeader
+	}
+	leader := resp.Kvs[0]
+
+	// act on behalf of the leader without keeping its lease alive or revoking it
+	s, err := concurrency.NewSession(c, concurrency.WithLease(clientv3.LeaseID(leader.Lease)))
+	if err != nil {
+		return err
+	}
+	s.Orphan()
+	e := concurrency.ResumeElection(s, election, string(leader.Key), leader.CreateRevision)
+	if err = e.TransferTo(ctx, candidate); err != nil {
+		return err
+	}
+	fmt.Printf("Transferred leadership of %s from %s to %s\n", election, leader.Key, candidate)
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/mirror"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+const (
+	// exportFormat identifies an etcdctl key-range export file.
+	exportFormat = "etcd-kv-export"
+	// exportVersion is the version of the export file layout.
+	exportVersion = 1
+)
+
+var (
+	exportPrefix string
+	exportRev    int64
+)
+
+// exportHeader is the first line of an export file.
+type exportHeader struct {
+	Format    string `json:"format"`
+	Version   int    `json:"version"`
+	Prefix    []byte `json:"prefix,omitempty"`
+	Revision  int64  `json:"revision"`
+	ClusterID uint64 `json:"cluster_id"`
+}
+
+// exportKV is a single exported key. Revisions and version are kept as they
+// were at the export revision; TTL is the remaining lease TTL in seconds at
+// export time.
+type exportKV struct {
+	Key            []byte `json:"key"`
+	Value          []byte `json:"value,omitempty"`
+	CreateRevision int64  `json:"create_revision"`
+	ModRevision    int64  `json:"mod_revision"`
+	Version        int64  `json:"version"`
+	Lease          int64  `json:"lease,omitempty"`
+	TTL            int64  `json:"ttl,omitempty"`
+}
+
+// exportTrailer is the last line of an export file. SHA256 covers every byte
+// preceding the trailer line.
+type exportTrailer struct {
+	Count  int64  `json:"count"`
+	SHA256 string `json:"sha256"`
+}
+
+// exportLine is a single newline-delimited JSON record; exactly one field is set.
+type exportLine struct {
+	Header  *exportHeader  `json:"header,omitempty"`
+	KV      *exportKV      `json:"kv,omitempty"`
+	Trailer *exportTrailer `json:"trailer,omitempty"`
+}
+
+// exportWriter streams export records to w while checksumming them.
+type exportWriter struct {
+	w     io.Writer
+	enc   *json.Encoder
+	h     hash.Hash
+	count int64
+}
+
+func newExportWriter(w io.Writer, hdr exportHeader) (*exportWriter, error) {
+	h := sha256.New()
+	ew := &exportWriter{w: w, enc: json.NewEncoder(io.MultiWriter(w, h)), h: h}
+	hdr.Format, hdr.Version = exportFormat, exportVersion
+	if err := ew.enc.Encode(exportLine{Header: &hdr}); err != nil {
+		return nil, err
+	}
+	return ew, nil
+}
+
+func (ew *exportWriter) writeKV(kv *exportKV) error {
+	if err := ew.enc.Encode(exportLine{KV: kv}); err != nil {
+		return err
+	}
+	ew.count++
+	return nil
+}
+
+// close writes the trailer; the writer must not be used afterwards.
+func (ew *exportWriter) close() error {
+	tr := &exportTrailer{Count: ew.count, SHA256: hex.EncodeToString(ew.h.Sum(nil))}
+	return json.NewEncoder(ew.w).Encode(exportLine{Trailer: tr})
+}
+
+// NewExportCommand returns the cobra command for "export".
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [options] <filename>",
+		Short: "Exports a key range with its revisions and leases to a file",
+		Run:   exportCommandFunc,
+	}
+	cmd.Flags().StringVar(&exportPrefix, "prefix", "", "Key prefix to export (all keys if empty)")
+	cmd.Flags().Int64Var(&exportRev, "rev", 0, "Revision to export at (latest if 0)")
+	return cmd
+}
+
+func exportCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("export expects one argument"))
+	}
+
+	// like snapshot save, there is no timeout unless "--command-timeout" is set
+	ctx, cancel := context.WithCancel(context.Background())
+	if isCommandTimeoutFlagSet(cmd) {
+		ctx, cancel = commandCtx(cmd)
+	}
+	defer cancel()
+
+	c := mustClientFromCmd(cmd)
+	defer c.Close()
+
+	path := args[0]
+	partpath := path + ".part"
+	f, err := os.OpenFile(partpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	hdr, count, err := exportKVs(ctx, c, f, exportPrefix, exportRev)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(partpath, path)
+	}
+	if err != nil {
+		os.Remove(partpath)
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	fmt.Printf("Exported %d keys at revision %d to %s\n", count, hdr.Revision, path)
+}
+
+// exportKVs streams every key under prefix at revision rev (latest if 0) to w.
+func exportKVs(ctx context.Context, c *clientv3.Client, w io.Writer, prefix string, rev int64) (*exportHeader, int64, error) {
+	// resolve the revision up front so it can be recorded in the header,
+	// reading only the exported range so that prefix permissions suffice
+	opts := []clientv3.OpOption{clientv3.WithCountOnly(), clientv3.WithPrefix()}
+	if prefix == "" {
+		opts = []clientv3.OpOption{clientv3.WithCountOnly(), clientv3.WithFromKey()}
+	}
+	resp, err := c.Get(ctx, prefix, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rev == 0 {
+		rev = resp.Header.Revision
+	}
+	hdr := exportHeader{
+		Prefix:    []byte(prefix),
+		Revision:  rev,
+		ClusterID: resp.Header.ClusterId,
+	}
+	ew, err := newExportWriter(w, hdr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ttls := make(map[clientv3.LeaseID]int64)
+	rc, errc := mirror.NewSyncer(c, prefix, rev).SyncBase(ctx)
+	for r := range rc {
+		for _, kv := range r.Kvs {
+			ekv := &exportKV{
+				Key:            kv.Key,
+				Value:          kv.Value,
+				CreateRevision: kv.CreateRevision,
+				ModRevision:    kv.ModRevision,
+				Version:        kv.Version,
+				Lease:          kv.Lease,
+			}
+			if kv.Lease != 0 {
+				id := clientv3.LeaseID(kv.Lease)
+				ttl, ok := ttls[id]
+				if !ok {
+					lresp, err := c.TimeToLive(ctx, id)
+					if err != nil {
+						return nil, 0, err
+					}
+					ttl = lresp.TTL
+					ttls[id] = ttl
+				}
+				ekv.TTL = ttl
+			}
+			if err = ew.writeKV(ekv); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	if err = <-errc; err != nil {
+		return nil, 0, err
+	}
+	if err = ew.close(); err != nil {
+		return nil, 0, err
+	}
+	return &hdr, ew.count, nil
+}
@@ -27,6 +27,7 @@ import (
 var (
 	getConsistency string
 	getLimit       int64
+	getPageSize    int64
 	getSortOrder   string
 	getSortTarget  string
 	getPrefix      bool
@@ -49,6 +50,7 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().StringVar(&getSortOrder, "order", "", "Order of results; ASCEND or DESCEND (ASCEND by default)")
 	cmd.Flags().StringVar(&getSortTarget, "sort-by", "", "Sort target; CREATE, KEY, MODIFY, VALUE, or VERSION")
 	cmd.Flags().Int64Var(&getLimit, "limit", 0, "Maximum number of results")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 0, "Fetch the results in pages of the given size at a single revision")
 	cmd.Flags().BoolVar(&getPrefix, "prefix", false, "Get keys with matching prefix")
 	cmd.Flags().BoolVar(&getFromKey, "from-key", false, "Get keys that are greater than or equal to the given key using byte compare")
 	cmd.Flags().Int64Var(&getRev, "rev", 0, "Specify the kv revision")
@@ -72,8 +74,9 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
+	c := mustClientFromCmd(cmd)
 	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
+	resp, err := c.Get(ctx, key, opts...)
 	cancel()
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
@@ -93,6 +96,17 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 		dp.valueOnly = true
 	}
 	display.Get(*resp)
+
+	// fetch the remaining pages at the revision of the first one
+	for getPageSize > 0 && len(resp.ContinueToken) != 0 {
+		ctx, cancel = commandCtx(cmd)
+		resp, err = c.Get(ctx, key, append(opts, clientv3.WithContinue(resp.ContinueToken))...)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.Get(*resp)
+	}
 }
 
 func getGetOp(args []string) (string, []clientv3.OpOption) {
@@ -108,6 +122,18 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--keys-only` and `--count-only` cannot be set at the same time, choose one"))
 	}
 
+	if getPageSize < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` must not be negative"))
+	}
+
+	if getPageSize > 0 && getLimit != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` and `--limit` cannot be set at the same time, choose one"))
+	}
+
+	if getPageSize > 0 && getCountOnly {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` and `--count-only` cannot be set at the same time, choose one"))
+	}
+
 	var opts []clientv3.OpOption
 	switch getConsistency {
 	case "s":
@@ -125,7 +151,11 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithRange(args[1]))
 	}
 
-	opts = append(opts, clientv3.WithLimit(getLimit))
+	if getPageSize > 0 {
+		opts = append(opts, clientv3.WithLimit(getPageSize))
+	} else {
+		opts = append(opts, clientv3.WithLimit(getLimit))
+	}
 	if getRev > 0 {
 		opts = append(opts, clientv3.WithRev(getRev))
 	}
@@ -162,6 +192,10 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadFeature, fmt.Errorf("bad sort target %v", getSortTarget))
 	}
 
+	if getPageSize > 0 && (sortByTarget != clientv3.SortByKey || sortByOrder == clientv3.SortDescend) {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--page-size` requires results sorted by key in ascending order"))
+	}
+
 	opts = append(opts, clientv3.WithSort(sortByTarget, sortByOrder))
 
 	if getPrefix {
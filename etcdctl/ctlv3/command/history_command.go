@@ -0,0 +1,142 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+// historyPageSize is the number of events fetched per request when
+// listing the whole history.
+const historyPageSize = 1000
+
+var (
+	historyConsistency string
+	historyLimit       int64
+	historyPrefix      bool
+	historyFromKey     bool
+	historyStartRev    int64
+	historyEndRev      int64
+)
+
+// NewHistoryCommand returns the cobra command for "history".
+func NewHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [options] <key> [range_end]",
+		Short: "Lists every revision of the key or a range of keys",
+		Run:   historyCommandFunc,
+	}
+
+	cmd.Flags().StringVar(&historyConsistency, "consistency", "l", "Linearizable(l) or Serializable(s)")
+	cmd.Flags().Int64Var(&historyLimit, "limit", 0, "Maximum number of events; lists the whole history if not set")
+	cmd.Flags().BoolVar(&historyPrefix, "prefix", false, "List the history of keys with matching prefix")
+	cmd.Flags().BoolVar(&historyFromKey, "from-key", false, "List the history of keys that are greater than or equal to the given key using byte compare")
+	cmd.Flags().Int64Var(&historyStartRev, "start-rev", 0, "First revision to list; defaults to the compaction revision")
+	cmd.Flags().Int64Var(&historyEndRev, "end-rev", 0, "Last revision to list; defaults to the current revision")
+
+	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
+	})
+
+	return cmd
+}
+
+// historyCommandFunc executes the "history" command.
+func historyCommandFunc(cmd *cobra.Command, args []string) {
+	key, opts := getHistoryOp(args)
+	c := mustClientFromCmd(cmd)
+
+	limit := historyLimit
+	if limit == 0 {
+		limit = historyPageSize
+	}
+	startRev, endRev := historyStartRev, historyEndRev
+	for {
+		ctx, cancel := commandCtx(cmd)
+		resp, err := c.History(ctx, key, append(opts,
+			clientv3.WithLimit(limit),
+			clientv3.WithMinModRev(startRev),
+			clientv3.WithMaxModRev(endRev),
+		)...)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		display.History(*resp)
+
+		if historyLimit != 0 || !resp.More {
+			return
+		}
+		// keep the following pages at the revision of the first one
+		if endRev == 0 {
+			endRev = resp.Header.Revision
+		}
+		startRev = resp.NextRevision
+	}
+}
+
+func getHistoryOp(args []string) (string, []clientv3.OpOption) {
+	if len(args) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("history command needs one argument as key and an optional argument as range_end"))
+	}
+
+	if historyPrefix && historyFromKey {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--prefix` and `--from-key` cannot be set at the same time, choose one"))
+	}
+
+	if historyLimit < 0 || historyStartRev < 0 || historyEndRev < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--limit`, `--start-rev` and `--end-rev` must not be negative"))
+	}
+
+	var opts []clientv3.OpOption
+	switch historyConsistency {
+	case "s":
+		opts = append(opts, clientv3.WithSerializable())
+	case "l":
+	default:
+		cobrautl.ExitWithError(cobrautl.ExitBadFeature, fmt.Errorf("unknown consistency flag %q", historyConsistency))
+	}
+
+	key := args[0]
+	if len(args) > 1 {
+		if historyPrefix || historyFromKey {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("too many arguments, only accept one argument when `--prefix` or `--from-key` is set"))
+		}
+		opts = append(opts, clientv3.WithRange(args[1]))
+	}
+
+	if historyPrefix {
+		if len(key) == 0 {
+			key = "\x00"
+			opts = append(opts, clientv3.WithFromKey())
+		} else {
+			opts = append(opts, clientv3.WithPrefix())
+		}
+	}
+
+	if historyFromKey {
+		if len(key) == 0 {
+			key = "\x00"
+		}
+		opts = append(opts, clientv3.WithFromKey())
+	}
+
+	return key, opts
+}
@@ -0,0 +1,255 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+var (
+	importDestPrefix   string
+	importMaxTxnOps    uint
+	importIgnoreLeases bool
+)
+
+var (
+	errExportNoHeader     = errors.New("export file does not start with a header")
+	errExportNoTrailer    = errors.New("export file is truncated: missing trailer")
+	errExportTrailingData = errors.New("export file has data after its trailer")
+)
+
+// NewImportCommand returns the cobra command for "import".
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [options] <filename>",
+		Short: "Imports a key range written by export",
+		Run:   importCommandFunc,
+	}
+	cmd.Flags().StringVar(&importDestPrefix, "dest-prefix", "", "Replace the exported prefix with this prefix when importing")
+	cmd.Flags().UintVar(&importMaxTxnOps, "max-txn-ops", defaultMaxTxnOps, "Maximum number of operations permitted in a transaction during import")
+	cmd.Flags().BoolVar(&importIgnoreLeases, "ignore-leases", false, "Import leased keys without attaching them to a lease")
+	return cmd
+}
+
+func importCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("import expects one argument"))
+	}
+	if importMaxTxnOps == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--max-txn-ops must be greater than 0"))
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	defer f.Close()
+
+	// verify the whole file before writing anything to the cluster
+	hdr, tr, err := verifyExport(f)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitInvalidInput, err)
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if isCommandTimeoutFlagSet(cmd) {
+		ctx, cancel = commandCtx(cmd)
+	}
+	defer cancel()
+
+	c := mustClientFromCmd(cmd)
+	defer c.Close()
+
+	destPrefix := string(hdr.Prefix)
+	if cmd.Flags().Changed("dest-prefix") {
+		destPrefix = importDestPrefix
+	}
+	imported, skipped, err := importKVs(ctx, c, f, destPrefix, int(importMaxTxnOps), importIgnoreLeases)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	fmt.Printf("Imported %d of %d keys exported at revision %d\n", imported, tr.Count, hdr.Revision)
+	if skipped > 0 {
+		fmt.Printf("Skipped %d keys whose lease expired before import\n", skipped)
+	}
+}
+
+// readExportLine decodes the next line of an export file. It returns the raw
+// line so callers can checksum it, and io.EOF once the input is exhausted.
+func readExportLine(br *bufio.Reader) (exportLine, []byte, error) {
+	var l exportLine
+	raw, err := br.ReadBytes('\n')
+	if err == io.EOF && len(raw) != 0 {
+		return l, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return l, nil, err
+	}
+	if err = json.Unmarshal(raw, &l); err != nil {
+		return l, nil, fmt.Errorf("malformed export record: %v", err)
+	}
+	return l, raw, nil
+}
+
+func checkExportHeader(l exportLine) (*exportHeader, error) {
+	hdr := l.Header
+	if hdr == nil {
+		return nil, errExportNoHeader
+	}
+	if hdr.Format != exportFormat {
+		return nil, fmt.Errorf("unknown export format %q", hdr.Format)
+	}
+	if hdr.Version != exportVersion {
+		return nil, fmt.Errorf("unsupported export version %d", hdr.Version)
+	}
+	return hdr, nil
+}
+
+// verifyExport reads r to the end and checks the header, the record count
+// and the checksum recorded in the trailer.
+func verifyExport(r io.Reader) (*exportHeader, *exportTrailer, error) {
+	br := bufio.NewReader(r)
+	h := sha256.New()
+
+	l, raw, err := readExportLine(br)
+	if err == io.EOF {
+		return nil, nil, errExportNoHeader
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	hdr, err := checkExportHeader(l)
+	if err != nil {
+		return nil, nil, err
+	}
+	h.Write(raw)
+
+	var count int64
+	for {
+		l, raw, err = readExportLine(br)
+		if err == io.EOF {
+			return nil, nil, errExportNoTrailer
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case l.KV != nil:
+			h.Write(raw)
+			count++
+		case l.Trailer != nil:
+			tr := l.Trailer
+			if _, err = br.ReadByte(); err != io.EOF {
+				return nil, nil, errExportTrailingData
+			}
+			if tr.Count != count {
+				return nil, nil, fmt.Errorf("export file has %d keys, trailer expects %d", count, tr.Count)
+			}
+			if sum := hex.EncodeToString(h.Sum(nil)); sum != tr.SHA256 {
+				return nil, nil, fmt.Errorf("export file checksum mismatch: got %s, trailer expects %s", sum, tr.SHA256)
+			}
+			return hdr, tr, nil
+		default:
+			return nil, nil, fmt.Errorf("unexpected record in export file: %s", bytes.TrimSpace(raw))
+		}
+	}
+}
+
+// importKVs replays a verified export file from r into c in transactions of
+// at most maxTxnOps puts. Leases are granted anew with the TTL remaining at
+// export time; keys whose lease had already expired are skipped.
+func importKVs(ctx context.Context, c *clientv3.Client, r io.Reader, destPrefix string, maxTxnOps int, ignoreLeases bool) (imported, skipped int64, err error) {
+	br := bufio.NewReader(r)
+	l, _, err := readExportLine(br)
+	if err != nil {
+		return 0, 0, err
+	}
+	hdr, err := checkExportHeader(l)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	leases := make(map[int64]clientv3.LeaseID)
+	ops := make([]clientv3.Op, 0, maxTxnOps)
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		if _, err := c.Txn(ctx).Then(ops...).Commit(); err != nil {
+			return err
+		}
+		imported += int64(len(ops))
+		ops = ops[:0]
+		return nil
+	}
+
+	for {
+		l, _, err = readExportLine(br)
+		if err != nil {
+			return imported, skipped, err
+		}
+		if l.KV == nil {
+			break
+		}
+		kv := l.KV
+		if !bytes.HasPrefix(kv.Key, hdr.Prefix) {
+			return imported, skipped, fmt.Errorf("exported key %q is outside prefix %q", kv.Key, hdr.Prefix)
+		}
+
+		var opts []clientv3.OpOption
+		if kv.Lease != 0 && !ignoreLeases {
+			if kv.TTL <= 0 {
+				skipped++
+				continue
+			}
+			id, ok := leases[kv.Lease]
+			if !ok {
+				resp, err := c.Grant(ctx, kv.TTL)
+				if err != nil {
+					return imported, skipped, err
+				}
+				id = resp.ID
+				leases[kv.Lease] = id
+			}
+			opts = append(opts, clientv3.WithLease(id))
+		}
+
+		key := destPrefix + string(kv.Key[len(hdr.Prefix):])
+		ops = append(ops, clientv3.OpPut(key, string(kv.Value), opts...))
+		if len(ops) == maxTxnOps {
+			if err = flush(); err != nil {
+				return imported, skipped, err
+			}
+		}
+	}
+	return imported, skipped, flush()
+}
@@ -37,10 +37,14 @@ func NewLeaseCommand() *cobra.Command {
 	lc.AddCommand(NewLeaseTimeToLiveCommand())
 	lc.AddCommand(NewLeaseListCommand())
 	lc.AddCommand(NewLeaseKeepAliveCommand())
+	lc.AddCommand(NewLeaseAttachCommand())
+	lc.AddCommand(NewLeaseDetachCommand())
 
 	return lc
 }
 
+var leaseGrantParent string
+
 // NewLeaseGrantCommand returns the cobra command for "lease grant".
 func NewLeaseGrantCommand() *cobra.Command {
 	lc := &cobra.Command{
@@ -50,6 +54,8 @@ func NewLeaseGrantCommand() *cobra.Command {
 		Run: leaseGrantCommandFunc,
 	}
 
+	lc.Flags().StringVar(&leaseGrantParent, "parent", "", "Grant the lease under the given parent lease ID (in hex)")
+
 	return lc
 }
 
@@ -64,8 +70,13 @@ func leaseGrantCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad TTL (%v)", err))
 	}
 
+	var resp *v3.LeaseGrantResponse
 	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Grant(ctx, ttl)
+	if leaseGrantParent != "" {
+		resp, err = mustClientFromCmd(cmd).GrantChild(ctx, leaseFromArgs(leaseGrantParent), ttl)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Grant(ctx, ttl)
+	}
 	cancel()
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to grant lease (%v)", err))
@@ -206,3 +217,58 @@ func leaseFromArgs(arg string) v3.LeaseID {
 	}
 	return v3.LeaseID(id)
 }
+
+// NewLeaseAttachCommand returns the cobra command for "lease attach".
+func NewLeaseAttachCommand() *cobra.Command {
+	lc := &cobra.Command{
+		Use:   "attach <leaseID> <key> [<key>...]",
+		Short: "Attaches existing keys to a lease without changing their values",
+
+		Run: leaseAttachCommandFunc,
+	}
+
+	return lc
+}
+
+// leaseAttachCommandFunc executes the "lease attach" command.
+func leaseAttachCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease attach command needs lease ID and at least one key as arguments"))
+	}
+
+	id := leaseFromArgs(args[0])
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Attach(ctx, id, args[1:]...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to attach keys to lease (%v)", err))
+	}
+	display.LeaseAttach(id, *resp)
+}
+
+// NewLeaseDetachCommand returns the cobra command for "lease detach".
+func NewLeaseDetachCommand() *cobra.Command {
+	lc := &cobra.Command{
+		Use:   "detach <key> [<key>...]",
+		Short: "Detaches existing keys from their leases without changing their values",
+
+		Run: leaseDetachCommandFunc,
+	}
+
+	return lc
+}
+
+// leaseDetachCommandFunc executes the "lease detach" command.
+func leaseDetachCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("lease detach command needs at least one key as argument"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Detach(ctx, args...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("failed to detach keys from lease (%v)", err))
+	}
+	display.LeaseDetach(*resp)
+}
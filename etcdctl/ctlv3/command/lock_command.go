@@ -23,6 +23,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
@@ -30,7 +31,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var lockTTL = 10
+var (
+	lockTTL  = 10
+	lockRead bool
+)
 
 // NewLockCommand returns the cobra command for "lock".
 func NewLockCommand() *cobra.Command {
@@ -40,6 +44,7 @@ func NewLockCommand() *cobra.Command {
 		Run:   lockCommandFunc,
 	}
 	c.Flags().IntVarP(&lockTTL, "ttl", "", lockTTL, "timeout for session")
+	c.Flags().BoolVarP(&lockRead, "read", "", false, "acquire a shared read lock instead of an exclusive one")
 	return c
 }
 
@@ -74,7 +79,10 @@ func lockUntilSignal(c *clientv3.Client, lockname string, cmdArgs []string) erro
 		return err
 	}
 
-	m := concurrency.NewMutex(s, lockname)
+	var m locker = concurrency.NewMutex(s, lockname)
+	if lockRead {
+		m = &readLocker{concurrency.NewRWMutex(s, lockname)}
+	}
 	ctx, cancel := context.WithCancel(context.TODO())
 
 	// unlock in case of ordinary shutdown
@@ -121,7 +129,20 @@ func lockUntilSignal(c *clientv3.Client, lockname string, cmdArgs []string) erro
 	return errors.New("session expired")
 }
 
-func environLockResponse(m *concurrency.Mutex) []string {
+type locker interface {
+	Lock(ctx context.Context) error
+	Unlock(ctx context.Context) error
+	Key() string
+	Header() *pb.ResponseHeader
+}
+
+// readLocker holds the read side of a read-write lock as a locker.
+type readLocker struct{ *concurrency.RWMutex }
+
+func (rl *readLocker) Lock(ctx context.Context) error   { return rl.RLock(ctx) }
+func (rl *readLocker) Unlock(ctx context.Context) error { return rl.RUnlock(ctx) }
+
+func environLockResponse(m locker) []string {
 	return []string{
 		"ETCD_LOCK_KEY=" + m.Key(),
 		fmt.Sprintf("ETCD_LOCK_REV=%d", m.Header().Revision),
@@ -32,12 +32,15 @@ type printer interface {
 	Put(v3.PutResponse)
 	Txn(v3.TxnResponse)
 	Watch(v3.WatchResponse)
+	History(v3.HistoryResponse)
 
 	Grant(r v3.LeaseGrantResponse)
 	Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse)
 	KeepAlive(r v3.LeaseKeepAliveResponse)
 	TimeToLive(r v3.LeaseTimeToLiveResponse, keys bool)
 	Leases(r v3.LeaseLeasesResponse)
+	LeaseAttach(id v3.LeaseID, r v3.LeaseAttachResponse)
+	LeaseDetach(r v3.LeaseDetachResponse)
 
 	MemberAdd(v3.MemberAddResponse)
 	MemberRemove(id uint64, r v3.MemberRemoveResponse)
@@ -56,6 +59,12 @@ type printer interface {
 
 	Alarm(v3.AlarmResponse)
 
+	QuotaSet(prefix string, maxKeys, maxBytes int64, r v3.QuotaSetResponse)
+	QuotaGet(r v3.QuotaGetResponse)
+	QuotaList(r v3.QuotaListResponse)
+
+	CompactEstimate(rev int64, r v3.CompactEstimateResponse)
+
 	RoleAdd(role string, r v3.AuthRoleAddResponse)
 	RoleGet(role string, r v3.AuthRoleGetResponse)
 	RoleDelete(role string, r v3.AuthRoleDeleteResponse)
@@ -95,17 +104,22 @@ type printerRPC struct {
 	p func(interface{})
 }
 
-func (p *printerRPC) Del(r v3.DeleteResponse)  { p.p((*pb.DeleteRangeResponse)(&r)) }
-func (p *printerRPC) Get(r v3.GetResponse)     { p.p((*pb.RangeResponse)(&r)) }
-func (p *printerRPC) Put(r v3.PutResponse)     { p.p((*pb.PutResponse)(&r)) }
-func (p *printerRPC) Txn(r v3.TxnResponse)     { p.p((*pb.TxnResponse)(&r)) }
-func (p *printerRPC) Watch(r v3.WatchResponse) { p.p(&r) }
+func (p *printerRPC) Del(r v3.DeleteResponse)      { p.p((*pb.DeleteRangeResponse)(&r)) }
+func (p *printerRPC) Get(r v3.GetResponse)         { p.p((*pb.RangeResponse)(&r)) }
+func (p *printerRPC) Put(r v3.PutResponse)         { p.p((*pb.PutResponse)(&r)) }
+func (p *printerRPC) Txn(r v3.TxnResponse)         { p.p((*pb.TxnResponse)(&r)) }
+func (p *printerRPC) Watch(r v3.WatchResponse)     { p.p(&r) }
+func (p *printerRPC) History(r v3.HistoryResponse) { p.p((*pb.KeyHistoryResponse)(&r)) }
 
 func (p *printerRPC) Grant(r v3.LeaseGrantResponse)                      { p.p(r) }
 func (p *printerRPC) Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse)     { p.p(r) }
 func (p *printerRPC) KeepAlive(r v3.LeaseKeepAliveResponse)              { p.p(r) }
 func (p *printerRPC) TimeToLive(r v3.LeaseTimeToLiveResponse, keys bool) { p.p(&r) }
 func (p *printerRPC) Leases(r v3.LeaseLeasesResponse)                    { p.p(&r) }
+func (p *printerRPC) LeaseAttach(id v3.LeaseID, r v3.LeaseAttachResponse) {
+	p.p((*pb.LeaseAttachResponse)(&r))
+}
+func (p *printerRPC) LeaseDetach(r v3.LeaseDetachResponse) { p.p((*pb.LeaseDetachResponse)(&r)) }
 
 func (p *printerRPC) MemberAdd(r v3.MemberAddResponse) { p.p((*pb.MemberAddResponse)(&r)) }
 func (p *printerRPC) MemberRemove(id uint64, r v3.MemberRemoveResponse) {
@@ -119,6 +133,14 @@ func (p *printerRPC) MemberPromote(id uint64, r v3.MemberPromoteResponse) {
 }
 func (p *printerRPC) MemberList(r v3.MemberListResponse) { p.p((*pb.MemberListResponse)(&r)) }
 func (p *printerRPC) Alarm(r v3.AlarmResponse)           { p.p((*pb.AlarmResponse)(&r)) }
+func (p *printerRPC) QuotaSet(_ string, _, _ int64, r v3.QuotaSetResponse) {
+	p.p((*pb.QuotaSetResponse)(&r))
+}
+func (p *printerRPC) QuotaGet(r v3.QuotaGetResponse)   { p.p((*pb.QuotaGetResponse)(&r)) }
+func (p *printerRPC) QuotaList(r v3.QuotaListResponse) { p.p((*pb.QuotaListResponse)(&r)) }
+func (p *printerRPC) CompactEstimate(_ int64, r v3.CompactEstimateResponse) {
+	p.p((*pb.CompactEstimateResponse)(&r))
+}
 func (p *printerRPC) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
 	p.p((*pb.MoveLeaderResponse)(&r))
 }
@@ -232,6 +254,26 @@ func makeEndpointStatusTable(statusList []epStatus) (hdr []string, rows [][]stri
 	return hdr, rows
 }
 
+func makeQuotaStatusTable(statuses []*pb.PrefixQuotaStatus) (hdr []string, rows [][]string) {
+	limit := func(v int64) string {
+		if v == 0 {
+			return "unlimited"
+		}
+		return fmt.Sprint(v)
+	}
+	hdr = []string{"prefix", "key count", "max keys", "byte count", "max bytes"}
+	for _, s := range statuses {
+		rows = append(rows, []string{
+			string(s.Quota.Prefix),
+			fmt.Sprint(s.KeyCount),
+			limit(s.Quota.MaxKeys),
+			fmt.Sprint(s.ByteCount),
+			limit(s.Quota.MaxBytes),
+		})
+	}
+	return hdr, rows
+}
+
 func makeEndpointHashKVTable(hashList []epHashKV) (hdr []string, rows [][]string) {
 	hdr = []string{"endpoint", "hash", "hash_revision"}
 	for _, h := range hashList {
@@ -39,6 +39,9 @@ func (p *fieldsPrinter) kv(pfx string, kv *spb.KeyValue) {
 	} else {
 		fmt.Printf("\"%sLease\" : %d\n", pfx, kv.Lease)
 	}
+	if kv.ExpireTime != 0 {
+		fmt.Printf("\"%sExpireTime\" : %d\n", pfx, kv.ExpireTime)
+	}
 }
 
 func (p *fieldsPrinter) hdr(h *pb.ResponseHeader) {
@@ -110,6 +113,16 @@ func (p *fieldsPrinter) Watch(resp v3.WatchResponse) {
 	}
 }
 
+func (p *fieldsPrinter) History(r v3.HistoryResponse) {
+	p.hdr(r.Header)
+	for _, e := range r.Events {
+		fmt.Println(`"Type" :`, e.Type)
+		p.kv("", e.Kv)
+	}
+	fmt.Println(`"More" :`, r.More)
+	fmt.Println(`"NextRevision" :`, r.NextRevision)
+}
+
 func (p *fieldsPrinter) Grant(r v3.LeaseGrantResponse) {
 	p.hdr(r.ResponseHeader)
 	if p.isHex {
@@ -124,6 +137,16 @@ func (p *fieldsPrinter) Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse) {
 	p.hdr(r.Header)
 }
 
+func (p *fieldsPrinter) LeaseAttach(id v3.LeaseID, r v3.LeaseAttachResponse) {
+	p.hdr(r.Header)
+	fmt.Println(`"Attached" :`, r.Attached)
+}
+
+func (p *fieldsPrinter) LeaseDetach(r v3.LeaseDetachResponse) {
+	p.hdr(r.Header)
+	fmt.Println(`"Detached" :`, r.Detached)
+}
+
 func (p *fieldsPrinter) KeepAlive(r v3.LeaseKeepAliveResponse) {
 	p.hdr(r.ResponseHeader)
 	if p.isHex {
@@ -146,6 +169,17 @@ func (p *fieldsPrinter) TimeToLive(r v3.LeaseTimeToLiveResponse, keys bool) {
 	for _, k := range r.Keys {
 		fmt.Printf("\"Key\" : %q\n", string(k))
 	}
+	if p.isHex {
+		fmt.Printf("\"Parent\" : %016x\n", r.Parent)
+		for _, id := range r.Children {
+			fmt.Printf("\"Child\" : %016x\n", id)
+		}
+	} else {
+		fmt.Println(`"Parent" :`, r.Parent)
+		for _, id := range r.Children {
+			fmt.Println(`"Child" :`, id)
+		}
+	}
 }
 
 func (p *fieldsPrinter) Leases(r v3.LeaseLeasesResponse) {
@@ -202,6 +236,12 @@ func (p *fieldsPrinter) EndpointStatus(eps []epStatus) {
 		fmt.Println(`"RaftTerm" :`, ep.Resp.RaftTerm)
 		fmt.Println(`"RaftAppliedIndex" :`, ep.Resp.RaftAppliedIndex)
 		fmt.Println(`"Errors" :`, ep.Resp.Errors)
+		if d := ep.Resp.Defrag; d != nil {
+			fmt.Printf("\"DefragPhase\" : %q\n", d.Phase)
+			fmt.Println(`"DefragCopiedKeys" :`, d.CopiedKeys)
+			fmt.Println(`"DefragTotalKeys" :`, d.TotalKeys)
+			fmt.Println(`"DefragPendingWrites" :`, d.PendingWrites)
+		}
 		fmt.Printf("\"Endpoint\" : %q\n", ep.Ep)
 		fmt.Println()
 	}
@@ -230,6 +270,40 @@ func (p *fieldsPrinter) Alarm(r v3.AlarmResponse) {
 	}
 }
 
+func (p *fieldsPrinter) QuotaSet(prefix string, maxKeys, maxBytes int64, r v3.QuotaSetResponse) {
+	p.hdr(r.Header)
+}
+
+func (p *fieldsPrinter) QuotaGet(r v3.QuotaGetResponse) {
+	p.hdr(r.Header)
+	p.quotaStatus(r.Status)
+}
+
+func (p *fieldsPrinter) QuotaList(r v3.QuotaListResponse) {
+	p.hdr(r.Header)
+	for _, s := range r.Statuses {
+		p.quotaStatus(s)
+		fmt.Println()
+	}
+}
+
+func (p *fieldsPrinter) quotaStatus(s *pb.PrefixQuotaStatus) {
+	fmt.Printf("\"Prefix\" : %q\n", string(s.Quota.Prefix))
+	fmt.Println(`"KeyCount" :`, s.KeyCount)
+	fmt.Println(`"MaxKeys" :`, s.Quota.MaxKeys)
+	fmt.Println(`"ByteCount" :`, s.ByteCount)
+	fmt.Println(`"MaxBytes" :`, s.Quota.MaxBytes)
+}
+
+func (p *fieldsPrinter) CompactEstimate(rev int64, r v3.CompactEstimateResponse) {
+	p.hdr(r.Header)
+	fmt.Println(`"CompactRevision" :`, rev)
+	fmt.Println(`"Keys" :`, r.Keys)
+	fmt.Println(`"Revisions" :`, r.Revisions)
+	fmt.Println(`"Bytes" :`, r.Bytes)
+	fmt.Println(`"Watchers" :`, r.Watchers)
+}
+
 func (p *fieldsPrinter) RoleAdd(role string, r v3.AuthRoleAddResponse) { p.hdr(r.Header) }
 func (p *fieldsPrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 	p.hdr(r.Header)
@@ -19,6 +19,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/dustin/go-humanize"
+
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	v3 "go.etcd.io/etcd/client/v3"
@@ -83,6 +86,13 @@ func (s *simplePrinter) Watch(resp v3.WatchResponse) {
 	}
 }
 
+func (s *simplePrinter) History(resp v3.HistoryResponse) {
+	for _, e := range resp.Events {
+		fmt.Println(e.Type, e.Kv.ModRevision)
+		printKV(s.isHex, s.valueOnly, e.Kv)
+	}
+}
+
 func (s *simplePrinter) Grant(resp v3.LeaseGrantResponse) {
 	fmt.Printf("lease %016x granted with TTL(%ds)\n", resp.ID, resp.TTL)
 }
@@ -91,6 +101,14 @@ func (s *simplePrinter) Revoke(id v3.LeaseID, r v3.LeaseRevokeResponse) {
 	fmt.Printf("lease %016x revoked\n", id)
 }
 
+func (s *simplePrinter) LeaseAttach(id v3.LeaseID, r v3.LeaseAttachResponse) {
+	fmt.Printf("%d key(s) attached to lease %016x\n", r.Attached, id)
+}
+
+func (s *simplePrinter) LeaseDetach(r v3.LeaseDetachResponse) {
+	fmt.Printf("%d key(s) detached\n", r.Detached)
+}
+
 func (s *simplePrinter) KeepAlive(resp v3.LeaseKeepAliveResponse) {
 	fmt.Printf("lease %016x keepalived with TTL(%d)\n", resp.ID, resp.TTL)
 }
@@ -102,6 +120,16 @@ func (s *simplePrinter) TimeToLive(resp v3.LeaseTimeToLiveResponse, keys bool) {
 	}
 
 	txt := fmt.Sprintf("lease %016x granted with TTL(%ds), remaining(%ds)", resp.ID, resp.GrantedTTL, resp.TTL)
+	if resp.Parent != v3.NoLease {
+		txt += fmt.Sprintf(", parent(%016x)", resp.Parent)
+	}
+	if len(resp.Children) > 0 {
+		cs := make([]string, len(resp.Children))
+		for i := range resp.Children {
+			cs[i] = fmt.Sprintf("%016x", resp.Children[i])
+		}
+		txt += fmt.Sprintf(", children(%v)", cs)
+	}
 	if keys {
 		ks := make([]string, len(resp.Keys))
 		for i := range resp.Keys {
@@ -125,6 +153,33 @@ func (s *simplePrinter) Alarm(resp v3.AlarmResponse) {
 	}
 }
 
+func (s *simplePrinter) QuotaSet(prefix string, maxKeys, maxBytes int64, r v3.QuotaSetResponse) {
+	if maxKeys == 0 && maxBytes == 0 {
+		fmt.Printf("Quota of prefix %q removed\n", prefix)
+		return
+	}
+	fmt.Printf("Quota of prefix %q set\n", prefix)
+}
+
+func (s *simplePrinter) QuotaGet(r v3.QuotaGetResponse) {
+	_, rows := makeQuotaStatusTable([]*pb.PrefixQuotaStatus{r.Status})
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) QuotaList(r v3.QuotaListResponse) {
+	_, rows := makeQuotaStatusTable(r.Statuses)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) CompactEstimate(rev int64, r v3.CompactEstimateResponse) {
+	fmt.Printf("compacting revision %d would remove %d revisions (%s) and %d keys, and cancel %d watchers\n",
+		rev, r.Revisions, humanize.Bytes(uint64(r.Bytes)), r.Keys, r.Watchers)
+}
+
 func (s *simplePrinter) MemberAdd(r v3.MemberAddResponse) {
 	asLearner := " "
 	if r.Member.IsLearner {
@@ -220,8 +275,11 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 		fmt.Print("\n")
 	}
 
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermRead || perm.PermType == v3.PermReadWrite {
+	printPerms := func(deny bool, permTypes ...authpb.Permission_Type) {
+		for _, perm := range r.Perm {
+			if perm.Deny != deny || !hasPermType(perm, permTypes) {
+				continue
+			}
 			if len(perm.RangeEnd) == 0 {
 				fmt.Printf("\t%s\n", string(perm.Key))
 			} else {
@@ -229,16 +287,62 @@ func (s *simplePrinter) RoleGet(role string, r v3.AuthRoleGetResponse) {
 			}
 		}
 	}
+
+	printPerms(false, v3.PermRead, v3.PermReadWrite)
 	fmt.Println("KV Write:")
-	for _, perm := range r.Perm {
-		if perm.PermType == v3.PermWrite || perm.PermType == v3.PermReadWrite {
-			if len(perm.RangeEnd) == 0 {
-				fmt.Printf("\t%s\n", string(perm.Key))
-			} else {
-				printRange((*v3.Permission)(perm))
-			}
+	printPerms(false, v3.PermWrite, v3.PermReadWrite)
+
+	// the finer-grained kinds and denials are only listed when the role has any,
+	// keeping the output of roles that only use read/write permissions unchanged
+	kinds := []struct {
+		title    string
+		permType authpb.Permission_Type
+	}{
+		{"KV Watch:", v3.PermWatch},
+		{"KV Delete:", v3.PermDelete},
+		{"KV Lease Attach:", v3.PermLeaseAttach},
+	}
+	for _, k := range kinds {
+		if hasPerm(r.Perm, false, k.permType) {
+			fmt.Println(k.title)
+			printPerms(false, k.permType)
+		}
+	}
+	denyKinds := []struct {
+		title    string
+		permType authpb.Permission_Type
+	}{
+		{"KV Read (denied):", v3.PermRead},
+		{"KV Write (denied):", v3.PermWrite},
+		{"KV ReadWrite (denied):", v3.PermReadWrite},
+		{"KV Watch (denied):", v3.PermWatch},
+		{"KV Delete (denied):", v3.PermDelete},
+		{"KV Lease Attach (denied):", v3.PermLeaseAttach},
+	}
+	for _, k := range denyKinds {
+		if hasPerm(r.Perm, true, k.permType) {
+			fmt.Println(k.title)
+			printPerms(true, k.permType)
+		}
+	}
+}
+
+func hasPermType(perm *authpb.Permission, permTypes []authpb.Permission_Type) bool {
+	for _, t := range permTypes {
+		if perm.PermType == t {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPerm(perms []*authpb.Permission, deny bool, permType authpb.Permission_Type) bool {
+	for _, perm := range perms {
+		if perm.Deny == deny && perm.PermType == permType {
+			return true
 		}
 	}
+	return false
 }
 
 func (s *simplePrinter) RoleList(r v3.AuthRoleListResponse) {
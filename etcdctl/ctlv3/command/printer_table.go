@@ -17,6 +17,7 @@ package command
 import (
 	"os"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	v3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/olekukonko/tablewriter"
@@ -64,3 +65,19 @@ func (tp *tablePrinter) EndpointHashKV(r []epHashKV) {
 	table.SetAlignment(tablewriter.ALIGN_RIGHT)
 	table.Render()
 }
+func (tp *tablePrinter) QuotaGet(r v3.QuotaGetResponse) {
+	tp.quotaStatuses([]*pb.PrefixQuotaStatus{r.Status})
+}
+func (tp *tablePrinter) QuotaList(r v3.QuotaListResponse) {
+	tp.quotaStatuses(r.Statuses)
+}
+func (tp *tablePrinter) quotaStatuses(statuses []*pb.PrefixQuotaStatus) {
+	hdr, rows := makeQuotaStatusTable(statuses)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.SetAlignment(tablewriter.ALIGN_RIGHT)
+	table.Render()
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+var (
+	quotaMaxKeys  int64
+	quotaMaxBytes int64
+)
+
+// NewQuotaCommand returns the cobra command for "quota".
+func NewQuotaCommand() *cobra.Command {
+	qc := &cobra.Command{
+		Use:   "quota <subcommand>",
+		Short: "Per-prefix storage quota related commands",
+	}
+
+	qc.AddCommand(NewQuotaSetCommand())
+	qc.AddCommand(NewQuotaGetCommand())
+	qc.AddCommand(NewQuotaListCommand())
+
+	return qc
+}
+
+func NewQuotaSetCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "set <prefix> [options]",
+		Short: "Sets, updates or removes the storage quota of a key prefix",
+		Long: `Sets, updates or removes the storage quota of a key prefix.
+
+A limit of 0 means no limit. Setting both limits to 0 removes the quota of the prefix.
+`,
+		Run: quotaSetCommandFunc,
+	}
+	cmd.Flags().Int64Var(&quotaMaxKeys, "max-keys", 0, "Maximum number of keys under the prefix")
+	cmd.Flags().Int64Var(&quotaMaxBytes, "max-bytes", 0, "Maximum total size of the keys and values under the prefix, in bytes")
+	return &cmd
+}
+
+// quotaSetCommandFunc executes the "quota set" command.
+func quotaSetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota set command needs 1 argument"))
+	}
+	if !cmd.Flags().Changed("max-keys") && !cmd.Flags().Changed("max-bytes") {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota set command needs --max-keys or --max-bytes"))
+	}
+	if quotaMaxKeys < 0 || quotaMaxBytes < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota limits must not be negative"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaSet(ctx, args[0], quotaMaxKeys, quotaMaxBytes)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.QuotaSet(args[0], quotaMaxKeys, quotaMaxBytes, *resp)
+}
+
+func NewQuotaGetCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "get <prefix>",
+		Short: "Gets the storage quota of a key prefix and its current usage",
+		Run:   quotaGetCommandFunc,
+	}
+	return &cmd
+}
+
+// quotaGetCommandFunc executes the "quota get" command.
+func quotaGetCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota get command needs 1 argument"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaGet(ctx, args[0])
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.QuotaGet(*resp)
+}
+
+func NewQuotaListCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "list",
+		Short: "Lists all storage quotas and their current usage",
+		Run:   quotaListCommandFunc,
+	}
+	return &cmd
+}
+
+// quotaListCommandFunc executes the "quota list" command.
+func quotaListCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("quota list command accepts no arguments"))
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).QuotaList(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	display.QuotaList(*resp)
+}
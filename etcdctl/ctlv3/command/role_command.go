@@ -27,6 +27,7 @@ import (
 var (
 	rolePermPrefix  bool
 	rolePermFromKey bool
+	rolePermDeny    bool
 )
 
 // NewRoleCommand returns the cobra command for "role".
@@ -87,6 +88,7 @@ func newRoleGrantPermissionCommand() *cobra.Command {
 
 	cmd.Flags().BoolVar(&rolePermPrefix, "prefix", false, "grant a prefix permission")
 	cmd.Flags().BoolVar(&rolePermFromKey, "from-key", false, "grant a permission of keys that are greater than or equal to the given key using byte compare")
+	cmd.Flags().BoolVar(&rolePermDeny, "deny", false, "explicitly deny the permission instead of granting it; a deny overrides any grant")
 
 	return cmd
 }
@@ -100,6 +102,7 @@ func newRoleRevokePermissionCommand() *cobra.Command {
 
 	cmd.Flags().BoolVar(&rolePermPrefix, "prefix", false, "revoke a prefix permission")
 	cmd.Flags().BoolVar(&rolePermFromKey, "from-key", false, "revoke a permission of keys that are greater than or equal to the given key using byte compare")
+	cmd.Flags().BoolVar(&rolePermDeny, "deny", false, "revoke the explicit deny on the range instead of the grant")
 
 	return cmd
 }
@@ -173,7 +176,12 @@ func roleGrantPermissionCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	key, rangeEnd := permRange(args[2:])
-	resp, err := mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	var resp *clientv3.AuthRoleGrantPermissionResponse
+	if rolePermDeny {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleDenyPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleGrantPermission(context.TODO(), args[0], key, rangeEnd, perm)
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
@@ -188,7 +196,13 @@ func roleRevokePermissionCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	key, rangeEnd := permRange(args[1:])
-	resp, err := mustClientFromCmd(cmd).Auth.RoleRevokePermission(context.TODO(), args[0], key, rangeEnd)
+	var resp *clientv3.AuthRoleRevokePermissionResponse
+	var err error
+	if rolePermDeny {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleRevokeDenyPermission(context.TODO(), args[0], key, rangeEnd)
+	} else {
+		resp, err = mustClientFromCmd(cmd).Auth.RoleRevokePermission(context.TODO(), args[0], key, rangeEnd)
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
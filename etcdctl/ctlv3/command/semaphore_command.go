@@ -0,0 +1,124 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	semaphoreTTL    = 10
+	semaphoreWeight int64
+)
+
+// NewSemaphoreCommand returns the cobra command for "semaphore".
+func NewSemaphoreCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "semaphore <name> <limit> [exec-command arg1 arg2 ...]",
+		Short: "Acquires weight from a named semaphore",
+		Run:   semaphoreCommandFunc,
+	}
+	c.Flags().IntVarP(&semaphoreTTL, "ttl", "", semaphoreTTL, "timeout for session")
+	c.Flags().Int64VarP(&semaphoreWeight, "weight", "", 1, "weight to acquire")
+	return c
+}
+
+func semaphoreCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("semaphore takes a semaphore name and limit arguments and an optional command to execute"))
+	}
+	limit, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || limit < 1 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad semaphore limit %q", args[1]))
+	}
+	c := mustClientFromCmd(cmd)
+	if err := acquireUntilSignal(c, args[0], limit, args[2:]); err != nil {
+		code := getExitCodeFromError(err)
+		cobrautl.ExitWithError(code, err)
+	}
+}
+
+func acquireUntilSignal(c *clientv3.Client, name string, limit int64, cmdArgs []string) error {
+	s, err := concurrency.NewSession(c, concurrency.WithTTL(semaphoreTTL))
+	if err != nil {
+		return err
+	}
+
+	sm := concurrency.NewSemaphore(s, name, limit)
+	ctx, cancel := context.WithCancel(context.TODO())
+
+	// release in case of ordinary shutdown
+	donec := make(chan struct{})
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigc
+		cancel()
+		close(donec)
+	}()
+
+	if err := sm.Acquire(ctx, semaphoreWeight); err != nil {
+		return err
+	}
+
+	if len(cmdArgs) > 0 {
+		cmd := exec.Command(cmdArgs[0], cmdArgs[1:]...)
+		cmd.Env = append(environSemaphoreResponse(sm), os.Environ()...)
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		err := cmd.Run()
+		releaseErr := sm.Release(context.TODO())
+		if err != nil {
+			return err
+		}
+		return releaseErr
+	}
+
+	k, kerr := c.Get(ctx, sm.Key())
+	if kerr != nil {
+		return kerr
+	}
+	if len(k.Kvs) == 0 {
+		return errors.New("semaphore lost on init")
+	}
+	display.Get(*k)
+
+	select {
+	case <-donec:
+		return sm.Release(context.TODO())
+	case <-s.Done():
+	}
+
+	return errors.New("session expired")
+}
+
+func environSemaphoreResponse(sm *concurrency.Semaphore) []string {
+	return []string{
+		"ETCD_SEMAPHORE_KEY=" + sm.Key(),
+		fmt.Sprintf("ETCD_SEMAPHORE_REV=%d", sm.Header().Revision),
+	}
+}
@@ -36,12 +36,16 @@ func NewSnapshotCommand() *cobra.Command {
 	return cmd
 }
 
+var snapshotIncrementalFrom int64
+
 func NewSnapshotSaveCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "save <filename>",
 		Short: "Stores an etcd node backend snapshot to a given file",
 		Run:   snapshotSaveCommandFunc,
 	}
+	cmd.Flags().Int64Var(&snapshotIncrementalFrom, "incremental-from", 0, "Only store the changes made after the given revision of a prior snapshot")
+	return cmd
 }
 
 func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
@@ -64,6 +68,17 @@ func snapshotSaveCommandFunc(cmd *cobra.Command, args []string) {
 	defer cancel()
 
 	path := args[0]
+	if snapshotIncrementalFrom != 0 {
+		rev, version, err := snapshot.SaveIncremental(ctx, lg, *cfg, snapshotIncrementalFrom, path)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
+		}
+		fmt.Printf("Incremental snapshot of revisions %d to %d saved at %s\n", snapshotIncrementalFrom+1, rev, path)
+		if version != "" {
+			fmt.Printf("Server version %s\n", version)
+		}
+		return
+	}
 	version, err := snapshot.SaveWithVersion(ctx, lg, *cfg, path)
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitInterrupted, err)
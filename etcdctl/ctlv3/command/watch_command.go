@@ -42,6 +42,7 @@ var (
 	watchInteractive bool
 	watchPrevKey     bool
 	progressNotify   bool
+	watchFilters     []string
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -57,6 +58,7 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
 	cmd.Flags().BoolVar(&progressNotify, "progress-notify", false, "get periodic watch progress notification from server")
+	cmd.Flags().StringArrayVar(&watchFilters, "filter", nil, "Filter events at server side; one of noput, nodelete, key-suffix=<suffix>, key-glob=<pattern>, value-prefix=<prefix>, value=<value>, lease=attached or lease=detached (can be repeated)")
 
 	return cmd
 }
@@ -160,6 +162,11 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	if progressNotify {
 		opts = append(opts, clientv3.WithProgressNotify())
 	}
+	filterOpts, err := parseWatchFilters(watchFilters)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, filterOpts...)
 	return c.Watch(clientv3.WithRequireLeader(context.Background()), key, opts...), nil
 }
 
@@ -334,6 +341,10 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		if err != nil {
 			return nil, nil, err
 		}
+		watchFilters, err = flagset.GetStringArray("filter")
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// "ETCDCTL_WATCH_KEY=foo watch -- echo hello"
@@ -364,3 +375,32 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 
 	return watchArgs, execArgs, nil
 }
+
+// parseWatchFilters converts the "--filter" flag values to watch options.
+func parseWatchFilters(filters []string) ([]clientv3.OpOption, error) {
+	var opts []clientv3.OpOption
+	for _, f := range filters {
+		name, arg, hasArg := strings.Cut(f, "=")
+		switch {
+		case name == "noput" && !hasArg:
+			opts = append(opts, clientv3.WithFilterPut())
+		case name == "nodelete" && !hasArg:
+			opts = append(opts, clientv3.WithFilterDelete())
+		case name == "key-suffix" && hasArg:
+			opts = append(opts, clientv3.WithFilterKeySuffix(arg))
+		case name == "key-glob" && hasArg:
+			opts = append(opts, clientv3.WithFilterKeyGlob(arg))
+		case name == "value-prefix" && hasArg:
+			opts = append(opts, clientv3.WithFilterValuePrefix(arg))
+		case name == "value" && hasArg:
+			opts = append(opts, clientv3.WithFilterValue(arg))
+		case name == "lease" && arg == "attached":
+			opts = append(opts, clientv3.WithFilterLeaseAttached())
+		case name == "lease" && arg == "detached":
+			opts = append(opts, clientv3.WithFilterLeaseDetached())
+		default:
+			return nil, fmt.Errorf("invalid watch filter %q", f)
+		}
+	}
+	return opts, nil
+}
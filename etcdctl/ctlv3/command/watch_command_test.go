@@ -556,3 +556,29 @@ func Test_parseWatchArgs(t *testing.T) {
 		}
 	}
 }
+
+func Test_parseWatchFilters(t *testing.T) {
+	tt := []struct {
+		filters []string
+		wOpts   int
+		wErr    bool
+	}{
+		{filters: nil, wOpts: 0},
+		{filters: []string{"noput", "nodelete"}, wOpts: 2},
+		{filters: []string{"key-suffix=/status", "key-glob=/pods/*/status"}, wOpts: 2},
+		{filters: []string{"value-prefix={", "value=", "lease=attached", "lease=detached"}, wOpts: 4},
+		{filters: []string{"noput=true"}, wErr: true},
+		{filters: []string{"key-suffix"}, wErr: true},
+		{filters: []string{"lease=maybe"}, wErr: true},
+		{filters: []string{"unknown"}, wErr: true},
+	}
+	for i, ts := range tt {
+		opts, err := parseWatchFilters(ts.filters)
+		if (err != nil) != ts.wErr {
+			t.Fatalf("#%d: expected error %v, got %v", i, ts.wErr, err)
+		}
+		if len(opts) != ts.wOpts {
+			t.Fatalf("#%d: expected %d options, got %d", i, ts.wOpts, len(opts))
+		}
+	}
+}
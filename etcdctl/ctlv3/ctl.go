@@ -86,19 +86,24 @@ func init() {
 		command.NewEndpointCommand(),
 		command.NewMoveLeaderCommand(),
 		command.NewWatchCommand(),
+		command.NewHistoryCommand(),
 		command.NewVersionCommand(),
 		command.NewLeaseCommand(),
 		command.NewMemberCommand(),
 		command.NewSnapshotCommand(),
 		command.NewMakeMirrorCommand(),
+		command.NewExportCommand(),
+		command.NewImportCommand(),
 		command.NewLockCommand(),
 		command.NewElectCommand(),
+		command.NewSemaphoreCommand(),
 		command.NewAuthCommand(),
 		command.NewUserCommand(),
 		command.NewRoleCommand(),
 		command.NewCheckCommand(),
 		command.NewCompletionCommand(),
 		command.NewDowngradeCommand(),
+		command.NewQuotaCommand(),
 	)
 }
 
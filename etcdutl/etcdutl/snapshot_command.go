@@ -17,6 +17,7 @@ package etcdutl
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
@@ -38,6 +39,10 @@ var (
 	restorePeerURLs     string
 	restoreName         string
 	skipHashCheck       bool
+	replayWalDir        string
+	toRevision          int64
+	toTime              string
+	mergeOutput         string
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -48,6 +53,7 @@ func NewSnapshotCommand() *cobra.Command {
 	}
 	cmd.AddCommand(NewSnapshotRestoreCommand())
 	cmd.AddCommand(newSnapshotStatusCommand())
+	cmd.AddCommand(NewSnapshotMergeCommand())
 	return cmd
 }
 
@@ -75,13 +81,29 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().StringVar(&restorePeerURLs, "initial-advertise-peer-urls", defaultInitialAdvertisePeerURLs, "List of this member's peer URLs to advertise to the rest of the cluster")
 	cmd.Flags().StringVar(&restoreName, "name", defaultName, "Human-readable name for this member")
 	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.Flags().StringVar(&replayWalDir, "replay-wal-dir", "", "Path to an archived WAL directory whose entries are replayed on top of the snapshot")
+	cmd.Flags().Int64Var(&toRevision, "to-revision", 0, "Stop WAL replay once this revision is reached (replay all committed entries if 0)")
+	cmd.Flags().StringVar(&toTime, "to-time", "", "Stop WAL replay at the first request proposed after this RFC 3339 time (e.g. 2023-01-02T15:04:05Z)")
 
 	cmd.MarkFlagDirname("data-dir")
 	cmd.MarkFlagDirname("wal-dir")
+	cmd.MarkFlagDirname("replay-wal-dir")
 
 	return cmd
 }
 
+func NewSnapshotMergeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge <base> <increment>... --output {output file} [options]",
+		Short: "Applies incremental snapshots to a full snapshot",
+		Run:   snapshotMergeCommandFunc,
+	}
+	cmd.Flags().StringVar(&mergeOutput, "output", "", "Path to the merged snapshot file")
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash values")
+	cmd.MarkFlagRequired("output")
+	return cmd
+}
+
 func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot status requires exactly one argument")
@@ -98,9 +120,36 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 	printer.DBStatus(ds)
 }
 
+func snapshotMergeCommandFunc(_ *cobra.Command, args []string) {
+	SnapshotMergeCommandFunc(mergeOutput, skipHashCheck, args)
+}
+
+func SnapshotMergeCommandFunc(output string, skipHashCheck bool, args []string) {
+	if len(args) < 2 {
+		err := fmt.Errorf("snapshot merge requires a base snapshot and at least one incremental snapshot")
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	if err := sp.Merge(snapshot.MergeConfig{
+		BasePath:       args[0],
+		IncrementPaths: args[1:],
+		OutputPath:     output,
+		SkipHashCheck:  skipHashCheck,
+	}); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	ds, err := sp.Status(output)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	fmt.Printf("Merged %d incremental snapshot(s) into %s at revision %d\n", len(args)-1, output, ds.Revision)
+}
+
 func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
 	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWalDir,
-		restorePeerURLs, restoreName, skipHashCheck, args)
+		restorePeerURLs, restoreName, skipHashCheck, replayWalDir, toRevision, toTime, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -110,12 +159,23 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	restorePeerURLs string,
 	restoreName string,
 	skipHashCheck bool,
+	replayWalDir string,
+	toRevision int64,
+	toTime string,
 	args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot restore requires exactly one argument")
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
+	var targetTime time.Time
+	if toTime != "" {
+		var err error
+		if targetTime, err = time.Parse(time.RFC3339Nano, toTime); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --to-time: %w", err))
+		}
+	}
+
 	dataDir := restoreDataDir
 	if dataDir == "" {
 		dataDir = restoreName + ".etcd"
@@ -138,6 +198,9 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		InitialCluster:      restoreCluster,
 		InitialClusterToken: restoreClusterToken,
 		SkipHashCheck:       skipHashCheck,
+		ReplayWALDir:        replayWalDir,
+		ToRevision:          toRevision,
+		ToTime:              targetTime,
 	}); err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
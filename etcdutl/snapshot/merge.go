@@ -0,0 +1,238 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// MergeConfig configures snapshot merge operation.
+type MergeConfig struct {
+	// BasePath is the path of the full snapshot file the increments are applied to.
+	BasePath string
+	// IncrementPaths are the paths of the incremental snapshot files,
+	// ordered from the oldest to the newest.
+	IncrementPaths []string
+	// OutputPath is the path of the merged snapshot file.
+	// It returns an error if the file already exists.
+	OutputPath string
+
+	// SkipHashCheck is "true" to ignore snapshot integrity hash values.
+	SkipHashCheck bool
+}
+
+// Merge applies a chain of incremental snapshots to a full snapshot. Each
+// increment must start at or before the revision reached by the snapshots
+// preceding it. The output carries an integrity hash, so that it can be
+// restored like any snapshot fetched from a server.
+func (s *v3Manager) Merge(cfg MergeConfig) error {
+	if len(cfg.IncrementPaths) == 0 {
+		return fmt.Errorf("no incremental snapshot given")
+	}
+	if fileutil.Exist(cfg.OutputPath) {
+		return fmt.Errorf("output file %q exists", cfg.OutputPath)
+	}
+
+	partpath := cfg.OutputPath + ".part"
+	defer os.RemoveAll(partpath)
+	if err := copyAndVerifyDB(cfg.BasePath, partpath, cfg.SkipHashCheck); err != nil {
+		return err
+	}
+	if err := checkNotIncremental(partpath); err != nil {
+		return err
+	}
+
+	db, err := bolt.Open(partpath, 0600, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	incpath := cfg.OutputPath + ".incremental.part"
+	defer os.RemoveAll(incpath)
+	for _, p := range cfg.IncrementPaths {
+		if err = copyAndVerifyDB(p, incpath, cfg.SkipHashCheck); err != nil {
+			return fmt.Errorf("%s: %v", p, err)
+		}
+		rev, err := mergeIncrement(db, incpath)
+		if err != nil {
+			return fmt.Errorf("%s: %v", p, err)
+		}
+		s.lg.Info("merged incremental snapshot", zap.String("path", p), zap.Int64("revision", rev))
+	}
+	if err = db.Close(); err != nil {
+		return err
+	}
+
+	if err = appendHash(partpath); err != nil {
+		return err
+	}
+	if err = os.Rename(partpath, cfg.OutputPath); err != nil {
+		return fmt.Errorf("could not rename %s to %s (%v)", partpath, cfg.OutputPath, err)
+	}
+	s.lg.Info("saved merged snapshot", zap.String("path", cfg.OutputPath))
+	return nil
+}
+
+// mergeIncrement applies the incremental snapshot at incpath to db and
+// returns the revision db is at afterwards.
+func mergeIncrement(db *bolt.DB, incpath string) (int64, error) {
+	inc, err := bolt.Open(incpath, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer inc.Close()
+
+	var rev int64
+	err = inc.View(func(itx *bolt.Tx) error {
+		baseRev, ok := schema.ReadIncrementalBaseRevFromSnapshot(itx)
+		if !ok {
+			return fmt.Errorf("not an incremental snapshot")
+		}
+		rev = lastRevision(itx, baseRev)
+		return db.Update(func(tx *bolt.Tx) error {
+			cur := lastRevision(tx, 0)
+			if baseRev > cur {
+				return fmt.Errorf("increment starts after revision %d but the snapshot is at revision %d", baseRev, cur)
+			}
+			if rev < cur {
+				return fmt.Errorf("increment ends at revision %d but the snapshot is already at revision %d", rev, cur)
+			}
+			finished := copyMetaValue(tx, schema.FinishedCompactKeyName)
+
+			return itx.ForEach(func(name []byte, ib *bolt.Bucket) error {
+				if bytes.Equal(name, schema.Key.Name()) {
+					b, err := tx.CreateBucketIfNotExists(name)
+					if err != nil {
+						return err
+					}
+					return ib.ForEach(b.Put)
+				}
+				// every other bucket is carried in full by the increment
+				if tx.Bucket(name) != nil {
+					if err := tx.DeleteBucket(name); err != nil {
+						return err
+					}
+				}
+				b, err := tx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				if err = ib.ForEach(b.Put); err != nil {
+					return err
+				}
+				if bytes.Equal(name, schema.Meta.Name()) {
+					return mergeMeta(b, finished)
+				}
+				return nil
+			})
+		})
+	})
+	return rev, err
+}
+
+// mergeMeta drops the incremental marker from the merged meta bucket. If the
+// increment was compacted past the snapshot it is applied to, the compaction is
+// rescheduled so that etcd removes the superseded revisions on startup.
+func mergeMeta(b *bolt.Bucket, finished []byte) error {
+	if err := b.Delete(schema.MetaIncrementalBaseRevName); err != nil {
+		return err
+	}
+	incFinished := b.Get(schema.FinishedCompactKeyName)
+	if incFinished == nil || (finished != nil && bytesToRev(incFinished).main <= bytesToRev(finished).main) {
+		return nil
+	}
+	scheduled := b.Get(schema.ScheduledCompactKeyName)
+	if scheduled == nil || bytesToRev(scheduled).main < bytesToRev(incFinished).main {
+		if err := b.Put(schema.ScheduledCompactKeyName, append([]byte(nil), incFinished...)); err != nil {
+			return err
+		}
+	}
+	if finished == nil {
+		return b.Delete(schema.FinishedCompactKeyName)
+	}
+	return b.Put(schema.FinishedCompactKeyName, finished)
+}
+
+// lastRevision returns the highest main revision in the key bucket, or
+// min if the bucket holds no higher revision.
+func lastRevision(tx *bolt.Tx, min int64) int64 {
+	b := tx.Bucket(schema.Key.Name())
+	if b == nil {
+		return min
+	}
+	k, _ := b.Cursor().Last()
+	if k == nil {
+		return min
+	}
+	if rev := bytesToRev(k).main; rev > min {
+		return rev
+	}
+	return min
+}
+
+func copyMetaValue(tx *bolt.Tx, key []byte) []byte {
+	b := tx.Bucket(schema.Meta.Name())
+	if b == nil {
+		return nil
+	}
+	if v := b.Get(key); v != nil {
+		return append([]byte(nil), v...)
+	}
+	return nil
+}
+
+// checkNotIncremental returns an error if the database file at dbPath
+// is an incremental snapshot.
+func checkNotIncremental(dbPath string) error {
+	db, err := bolt.Open(dbPath, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.View(func(tx *bolt.Tx) error {
+		if baseRev, ok := schema.ReadIncrementalBaseRevFromSnapshot(tx); ok {
+			return fmt.Errorf("snapshot is an incremental snapshot from revision %d; merge it into a full snapshot with 'etcdutl snapshot merge' first", baseRev)
+		}
+		return nil
+	})
+}
+
+// appendHash appends the sha256 digest of the file at path to it.
+func appendHash(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+	if _, err = f.Write(h.Sum(nil)); err != nil {
+		return err
+	}
+	return fileutil.Fsync(f)
+}
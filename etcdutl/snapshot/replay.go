@@ -0,0 +1,178 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3quota"
+	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// replayWarningApplyDuration matches the server default for logging slow applies.
+const replayWarningApplyDuration = 100 * time.Millisecond
+
+// replayWAL applies the committed entries found in walDir on top of the
+// restored backend, starting right after the backend's consistent index.
+// Replay stops as soon as the store reaches toRevision, or at the first
+// request proposed after toTime; zero values replay every committed entry.
+// Requests proposed by members older than 3.6 carry no proposal time and are
+// always replayed.
+func (s *v3Manager) replayWAL(walDir string, toRevision int64, toTime time.Time) error {
+	be := backend.NewDefaultBackend(s.lg, s.outDbPath())
+	defer be.Close()
+
+	index, term := schema.ReadConsistentIndex(be.ReadTx())
+	w, err := wal.OpenForRead(s.lg, walDir, walpb.Snapshot{Index: index, Term: term})
+	if err != nil {
+		return fmt.Errorf("cannot open WAL %q after snapshot index %d: %w", walDir, index, err)
+	}
+	_, hardstate, ents, err := w.ReadAll()
+	w.Close()
+	// the snapshot was not taken through raft, so the WAL need not contain a
+	// snapshot record at its index
+	if err != nil && !errors.Is(err, wal.ErrSnapshotNotFound) {
+		return fmt.Errorf("cannot read WAL %q after snapshot index %d: %w", walDir, index, err)
+	}
+
+	cl := membership.NewCluster(s.lg)
+	lessor := lease.NewLessor(s.lg, be, cl, lease.LessorConfig{CheckpointPersist: true})
+	defer lessor.Stop()
+	kv := mvcc.NewStore(s.lg, be, lessor, mvcc.StoreConfig{})
+	defer kv.Close()
+
+	startRev := kv.Rev()
+	if toRevision > 0 && startRev > toRevision {
+		return fmt.Errorf("snapshot revision %d is already past target revision %d", startRev, toRevision)
+	}
+
+	alarmStore, err := v3alarm.NewAlarmStore(s.lg, schema.NewAlarmBackend(s.lg, be))
+	if err != nil {
+		return err
+	}
+	quotaStore, err := v3quota.NewQuotaStore(s.lg, schema.NewQuotaBackend(s.lg, be))
+	if err != nil {
+		return err
+	}
+	kv.SetUsageTracker(quotaStore)
+	tp, err := auth.NewTokenProvider(s.lg, "", nil, 0)
+	if err != nil {
+		return err
+	}
+	authStore := auth.NewAuthStore(s.lg, schema.NewAuthBackend(s.lg, be), tp, bcrypt.DefaultCost)
+	defer authStore.Close()
+
+	rs := &replayRaftStatus{}
+	// backend quota is disabled: replayed entries were already admitted once
+	applier := apply.NewUberApplier(s.lg, be, kv, alarmStore, quotaStore, authStore, lessor, cl, rs, rs,
+		cindex.NewConsistentIndex(be), replayWarningApplyDuration, false, -1)
+
+	lastIndex, pastTime := index, false
+	for _, e := range ents {
+		if e.Index > hardstate.Commit {
+			break
+		}
+		if toRevision > 0 && kv.Rev() >= toRevision {
+			break
+		}
+		var r pb.InternalRaftRequest
+		isRequest := e.Type == raftpb.EntryNormal && len(e.Data) != 0 && pbutil.MaybeUnmarshal(&r, e.Data)
+		if isRequest && !toTime.IsZero() && r.Header != nil && r.Header.Time > toTime.UnixNano() {
+			pastTime = true
+			break
+		}
+		rs.index, rs.term = e.Index, e.Term
+		lastIndex = e.Index
+
+		if !isRequest || r.V2 != nil {
+			// v2 requests never touch the v3 backend
+			continue
+		}
+		if !shouldReplay(&r) {
+			continue
+		}
+		// each request commits at most one revision, so replay cannot overshoot toRevision
+		if ar := applier.Apply(&r, membership.ApplyBoth); ar != nil && ar.Physc != nil {
+			<-ar.Physc
+		}
+	}
+
+	s.lg.Info(
+		"replayed WAL entries",
+		zap.String("wal-dir", walDir),
+		zap.Uint64("first-index", index+1),
+		zap.Uint64("last-index", lastIndex),
+		zap.Int64("start-revision", startRev),
+		zap.Int64("end-revision", kv.Rev()),
+	)
+
+	if toRevision > 0 && kv.Rev() < toRevision {
+		return fmt.Errorf("WAL %q ends at revision %d, before target revision %d", walDir, kv.Rev(), toRevision)
+	}
+	if !toTime.IsZero() && !pastTime {
+		// the cluster may simply have been idle since, so this is not an error
+		s.lg.Warn(
+			"WAL has no request proposed after the target time; all entries were replayed",
+			zap.String("wal-dir", walDir),
+			zap.Time("to-time", toTime),
+		)
+	}
+	return nil
+}
+
+// shouldReplay reports whether r changes the keyspace, leases or auth state.
+// Membership, version and alarm updates are specific to the source cluster and
+// are rebuilt by restore instead.
+func shouldReplay(r *pb.InternalRaftRequest) bool {
+	switch {
+	case r.Range != nil, r.Authenticate != nil, r.AuthStatus != nil,
+		r.AuthUserGet != nil, r.AuthRoleGet != nil, r.AuthUserList != nil, r.AuthRoleList != nil:
+		return false
+	case r.ClusterVersionSet != nil, r.ClusterMemberAttrSet != nil, r.DowngradeInfoSet != nil, r.Alarm != nil:
+		return false
+	}
+	return true
+}
+
+// replayRaftStatus reports the entry being replayed to the applier.
+type replayRaftStatus struct {
+	index, term uint64
+}
+
+func (rs *replayRaftStatus) MemberId() types.ID     { return 0 }
+func (rs *replayRaftStatus) Leader() types.ID       { return 0 }
+func (rs *replayRaftStatus) CommittedIndex() uint64 { return rs.index }
+func (rs *replayRaftStatus) AppliedIndex() uint64   { return rs.index }
+func (rs *replayRaftStatus) Term() uint64           { return rs.term }
+func (rs *replayRaftStatus) ForceSnapshot()         {}
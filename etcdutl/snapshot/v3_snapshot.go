@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -67,6 +68,10 @@ type Manager interface {
 	// file. It returns an error if specified data directory already
 	// exists, to prevent unintended data directory overwrites.
 	Restore(cfg RestoreConfig) error
+
+	// Merge applies a chain of incremental snapshots to a full snapshot
+	// and writes the result as a new full snapshot file.
+	Merge(cfg MergeConfig) error
 }
 
 // NewV3 returns a new snapshot Manager for v3.x snapshot.
@@ -203,10 +208,31 @@ type RestoreConfig struct {
 	// SkipHashCheck is "true" to ignore snapshot integrity hash value
 	// (required if copied from data directory).
 	SkipHashCheck bool
+
+	// ReplayWALDir is a WAL directory, typically archived from a member of
+	// the cluster the snapshot was taken from. If set, its committed entries
+	// that follow the snapshot are applied on top of the restored data.
+	ReplayWALDir string
+	// ToRevision stops WAL replay once the restored keyspace reaches this
+	// revision. If zero, every committed entry in ReplayWALDir is replayed.
+	ToRevision int64
+	// ToTime stops WAL replay at the first request proposed after this time.
+	// If zero, replay is not limited by time.
+	ToTime time.Time
 }
 
 // Restore restores a new etcd data directory from given snapshot file.
 func (s *v3Manager) Restore(cfg RestoreConfig) error {
+	if cfg.ToRevision < 0 {
+		return fmt.Errorf("invalid target revision %d", cfg.ToRevision)
+	}
+	if cfg.ToRevision > 0 && cfg.ReplayWALDir == "" {
+		return fmt.Errorf("target revision requires a WAL directory to replay")
+	}
+	if !cfg.ToTime.IsZero() && cfg.ReplayWALDir == "" {
+		return fmt.Errorf("target time requires a WAL directory to replay")
+	}
+
 	pURLs, err := types.NewURLs(cfg.PeerURLs)
 	if err != nil {
 		return err
@@ -265,6 +291,11 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	if err = s.saveDB(); err != nil {
 		return err
 	}
+	if cfg.ReplayWALDir != "" {
+		if err = s.replayWAL(cfg.ReplayWALDir, cfg.ToRevision, cfg.ToTime); err != nil {
+			return err
+		}
+	}
 	hardstate, err := s.saveWALAndSnap()
 	if err != nil {
 		return err
@@ -295,10 +326,16 @@ func (s *v3Manager) outDbPath() string {
 
 // saveDB copies the database snapshot to the snapshot directory
 func (s *v3Manager) saveDB() error {
-	err := s.copyAndVerifyDB()
+	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
+		return err
+	}
+	err := copyAndVerifyDB(s.srcDbPath, s.outDbPath(), s.skipHashCheck)
 	if err != nil {
 		return err
 	}
+	if err = checkNotIncremental(s.outDbPath()); err != nil {
+		return err
+	}
 
 	be := backend.NewDefaultBackend(s.lg, s.outDbPath())
 	defer be.Close()
@@ -311,8 +348,10 @@ func (s *v3Manager) saveDB() error {
 	return nil
 }
 
-func (s *v3Manager) copyAndVerifyDB() error {
-	srcf, ferr := os.Open(s.srcDbPath)
+// copyAndVerifyDB copies the snapshot file at srcDbPath to outDbPath,
+// stripping and verifying its integrity hash.
+func copyAndVerifyDB(srcDbPath, outDbPath string, skipHashCheck bool) error {
+	srcf, ferr := os.Open(srcDbPath)
 	if ferr != nil {
 		return ferr
 	}
@@ -330,12 +369,6 @@ func (s *v3Manager) copyAndVerifyDB() error {
 		return err
 	}
 
-	if err := fileutil.CreateDirAll(s.lg, s.snapDir); err != nil {
-		return err
-	}
-
-	outDbPath := s.outDbPath()
-
 	db, dberr := os.OpenFile(outDbPath, os.O_RDWR|os.O_CREATE, 0600)
 	if dberr != nil {
 		return dberr
@@ -363,11 +396,11 @@ func (s *v3Manager) copyAndVerifyDB() error {
 		}
 	}
 
-	if !hasHash && !s.skipHashCheck {
+	if !hasHash && !skipHashCheck {
 		return fmt.Errorf("snapshot missing hash but --skip-hash-check=false")
 	}
 
-	if hasHash && !s.skipHashCheck {
+	if hasHash && !skipHashCheck {
 		// check for match
 		if _, err := db.Seek(0, io.SeekStart); err != nil {
 			return err
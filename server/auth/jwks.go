@@ -0,0 +1,197 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// jwksSigningMethods are the asymmetric algorithms accepted for tokens
+// verified against a JWKS document.
+var jwksSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// jwk is a single JSON Web Key as defined in RFC 7517. Only the fields of
+// RSA and EC public keys are decoded.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwksKey struct {
+	alg string
+	key interface{}
+}
+
+// jwks is a parsed JSON Web Key Set holding the public keys usable for
+// verifying signatures.
+type jwks struct {
+	keys []jwksKey
+	kids map[string]jwksKey
+}
+
+func loadJWKS(path string) (*jwks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
+
+func parseJWKS(data []byte) (*jwks, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	set := &jwks{kids: make(map[string]jwksKey)}
+	for i, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var (
+			key interface{}
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsaPublicKey()
+		case "EC":
+			key, err = k.ecPublicKey()
+		default:
+			// other key types can't verify the accepted algorithms
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key #%d (kid %q): %w", i, k.Kid, err)
+		}
+		entry := jwksKey{alg: k.Alg, key: key}
+		set.keys = append(set.keys, entry)
+		if k.Kid != "" {
+			set.kids[k.Kid] = entry
+		}
+	}
+	if len(set.keys) == 0 {
+		return nil, ErrNoJWKSKey
+	}
+	return set, nil
+}
+
+func (set *jwks) len() int { return len(set.keys) }
+
+// lookup returns the key for verifying a token signed with the given method.
+// A token without a key ID can only be verified by a set with a single key.
+func (set *jwks) lookup(kid string, method jwt.SigningMethod) (interface{}, error) {
+	var entry jwksKey
+	switch {
+	case kid != "":
+		var ok bool
+		if entry, ok = set.kids[kid]; !ok {
+			return nil, ErrNoJWKSKey
+		}
+	case len(set.keys) == 1:
+		entry = set.keys[0]
+	default:
+		return nil, ErrNoJWKSKey
+	}
+
+	if entry.alg != "" && entry.alg != method.Alg() {
+		return nil, ErrInvalidAuthMethod
+	}
+	switch method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		if _, ok := entry.key.(*rsa.PublicKey); ok {
+			return entry.key, nil
+		}
+	case *jwt.SigningMethodECDSA:
+		if _, ok := entry.key.(*ecdsa.PublicKey); ok {
+			return entry.key, nil
+		}
+	}
+	return nil, ErrInvalidAuthMethod
+}
+
+func (k *jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeBase64URLInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBase64URLInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 || e.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k *jwk) ecPublicKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	x, err := decodeBase64URLInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBase64URLInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("point is not on curve %s", k.Crv)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBase64URLInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, fmt.Errorf("missing key parameter")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -5,11 +5,15 @@
 package auth
 
 import (
+	"os"
 	"testing"
 
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 )
 
 func TestMain(m *testing.M) {
+	// never start the process-wide os/signal loop; see TestOIDCReloadOnSIGHUP
+	signalNotify = func(chan<- os.Signal, ...os.Signal) {}
+	signalStop = func(chan<- os.Signal) {}
 	testutil.MustTestMainWithLeakDetection(m)
 }
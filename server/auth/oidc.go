@@ -0,0 +1,292 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	optJWKSFile  = "jwks-file"
+	optIssuer    = "issuer"
+	optAudience  = "audience"
+	optClaim     = "claim"
+	optClaimType = "claim-type"
+
+	oidcClaimTypeUser = "user"
+	oidcClaimTypeRole = "role"
+
+	defaultOIDCClaim = "sub"
+
+	// oidcInternalKeyID marks tokens signed by the member itself for
+	// internal requests, see assignInternal.
+	oidcInternalKeyID = "etcd-internal"
+)
+
+// signalNotify and signalStop subscribe to SIGHUP. Tests replace them, since
+// the first signal.Notify starts an os/signal goroutine that never exits.
+var (
+	signalNotify = signal.Notify
+	signalStop   = signal.Stop
+)
+
+var knownOIDCOptions = map[string]bool{
+	optJWKSFile:  true,
+	optIssuer:    true,
+	optAudience:  true,
+	optClaim:     true,
+	optClaimType: true,
+}
+
+type oidcOptions struct {
+	JWKSFile  string
+	Issuer    string
+	Audience  string
+	Claim     string
+	ClaimType string
+}
+
+// Parse will load options from the specified map
+func (opts *oidcOptions) Parse(optMap map[string]string) error {
+	opts.JWKSFile = optMap[optJWKSFile]
+	if opts.JWKSFile == "" {
+		return ErrMissingJWKS
+	}
+	opts.Issuer = optMap[optIssuer]
+	opts.Audience = optMap[optAudience]
+
+	opts.Claim = optMap[optClaim]
+	if opts.Claim == "" {
+		opts.Claim = defaultOIDCClaim
+	}
+
+	opts.ClaimType = optMap[optClaimType]
+	switch opts.ClaimType {
+	case "":
+		opts.ClaimType = oidcClaimTypeUser
+	case oidcClaimTypeUser, oidcClaimTypeRole:
+	default:
+		return fmt.Errorf("auth: unknown OIDC claim type %q", opts.ClaimType)
+	}
+	return nil
+}
+
+// tokenOIDC verifies JWTs issued by an external identity provider against the
+// public keys of a JWKS document. It cannot issue tokens itself; clients obtain
+// them from the provider and send them as-is. The configured claim names
+// either an etcd user or an etcd role the token acts as.
+type tokenOIDC struct {
+	lg   *zap.Logger
+	opts oidcOptions
+
+	keysMu sync.RWMutex
+	keys   *jwks
+
+	// internalKey signs tokens that never leave this member, such as the
+	// root token used for revoking expired leases.
+	internalKey []byte
+
+	// reloadMu protects stopc, which is non-nil while reloading on SIGHUP.
+	reloadMu sync.Mutex
+	stopc    chan struct{}
+	donec    chan struct{}
+}
+
+// enable starts reloading the JWKS document whenever the process receives SIGHUP.
+func (t *tokenOIDC) enable() {
+	t.reloadMu.Lock()
+	defer t.reloadMu.Unlock()
+	if t.stopc != nil { // already enabled
+		return
+	}
+	t.stopc = make(chan struct{})
+	t.donec = make(chan struct{})
+
+	sigc := make(chan os.Signal, 1)
+	signalNotify(sigc, syscall.SIGHUP)
+	go func(stopc, donec chan struct{}) {
+		defer close(donec)
+		defer signalStop(sigc)
+		for {
+			select {
+			case <-sigc:
+				if err := t.reload(); err != nil {
+					t.lg.Warn("failed to reload JWKS, keeping the previous keys", zap.String("path", t.opts.JWKSFile), zap.Error(err))
+				}
+			case <-stopc:
+				return
+			}
+		}
+	}(t.stopc, t.donec)
+}
+
+func (t *tokenOIDC) disable() {
+	t.reloadMu.Lock()
+	stopc, donec := t.stopc, t.donec
+	t.stopc, t.donec = nil, nil
+	t.reloadMu.Unlock()
+	if stopc != nil {
+		close(stopc)
+		<-donec
+	}
+}
+
+func (t *tokenOIDC) invalidateUser(string)           {}
+func (t *tokenOIDC) genTokenPrefix() (string, error) { return "", nil }
+
+// reload reads the JWKS document again and swaps in its keys.
+func (t *tokenOIDC) reload() error {
+	keys, err := loadJWKS(t.opts.JWKSFile)
+	if err != nil {
+		return err
+	}
+	t.keysMu.Lock()
+	t.keys = keys
+	t.keysMu.Unlock()
+	t.lg.Info("loaded JWKS", zap.String("path", t.opts.JWKSFile), zap.Int("keys", keys.len()))
+	return nil
+}
+
+func (t *tokenOIDC) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
+	t.keysMu.RLock()
+	keys := t.keys
+	t.keysMu.RUnlock()
+
+	var internal bool
+	parsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == oidcInternalKeyID {
+			if token.Method != jwt.SigningMethodHS256 {
+				return nil, ErrInvalidAuthMethod
+			}
+			internal = true
+			return t.internalKey, nil
+		}
+		return keys.lookup(kid, token.Method)
+	}, jwt.WithValidMethods(append([]string{jwt.SigningMethodHS256.Alg()}, jwksSigningMethods...)))
+	if err != nil {
+		t.lg.Warn("failed to parse an OIDC token", zap.Error(err))
+		return nil, false
+	}
+
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !parsed.Valid || !ok {
+		t.lg.Warn("failed to obtain claims from an OIDC token")
+		return nil, false
+	}
+
+	if internal {
+		username, _ := claims["username"].(string)
+		revision, _ := claims["revision"].(float64)
+		return &AuthInfo{Username: username, Revision: uint64(revision)}, username != ""
+	}
+
+	// externally issued tokens must expire; the JWT library only checks
+	// exp when it is present
+	if !claims.VerifyExpiresAt(0, true) {
+		t.lg.Warn("OIDC token has no expiration")
+		return nil, false
+	}
+	if t.opts.Issuer != "" && !claims.VerifyIssuer(t.opts.Issuer, true) {
+		t.lg.Warn("OIDC token has an unexpected issuer", zap.Any("issuer", claims["iss"]))
+		return nil, false
+	}
+	if t.opts.Audience != "" && !claims.VerifyAudience(t.opts.Audience, true) {
+		t.lg.Warn("OIDC token has an unexpected audience", zap.Any("audience", claims["aud"]))
+		return nil, false
+	}
+
+	name, ok := claims[t.opts.Claim].(string)
+	if !ok || name == "" {
+		t.lg.Warn("OIDC token lacks the identity claim", zap.String("claim", t.opts.Claim))
+		return nil, false
+	}
+	switch t.opts.ClaimType {
+	case oidcClaimTypeRole:
+		name = rolePrincipalPrefix + name
+	case oidcClaimTypeUser:
+		// a user claim must not name a role principal, or an identity
+		// provider user called "role:root" would act as the root role
+		if strings.HasPrefix(name, rolePrincipalPrefix) {
+			t.lg.Warn("OIDC token user claim names a role principal", zap.String("claim", t.opts.Claim), zap.String("name", name))
+			return nil, false
+		}
+	}
+
+	// the token carries no auth revision, so it is always checked against
+	// the current one
+	return &AuthInfo{Username: name, Revision: rev}, true
+}
+
+func (t *tokenOIDC) assign(ctx context.Context, username string, revision uint64) (string, error) {
+	return "", ErrVerifyOnly
+}
+
+// assignInternal signs a short-lived token for requests the member issues on
+// its own behalf. Only this member can verify it.
+func (t *tokenOIDC) assignInternal(username string, revision uint64) (string, error) {
+	tk := jwt.NewWithClaims(jwt.SigningMethodHS256,
+		jwt.MapClaims{
+			"username": username,
+			"revision": revision,
+			"exp":      time.Now().Add(DefaultTTL).Unix(),
+		})
+	tk.Header["kid"] = oidcInternalKeyID
+	return tk.SignedString(t.internalKey)
+}
+
+func newTokenProviderOIDC(lg *zap.Logger, optMap map[string]string) (*tokenOIDC, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	var opts oidcOptions
+	if err := opts.Parse(optMap); err != nil {
+		lg.Error("problem loading OIDC options", zap.Error(err))
+		return nil, ErrInvalidAuthOpts
+	}
+
+	var keys = make([]string, 0, len(optMap))
+	for k := range optMap {
+		if !knownOIDCOptions[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		lg.Warn("unknown OIDC options", zap.Strings("keys", keys))
+	}
+
+	internalKey := make([]byte, 32)
+	if _, err := rand.Read(internalKey); err != nil {
+		return nil, err
+	}
+
+	t := &tokenOIDC{lg: lg, opts: opts, internalKey: internalKey}
+	if err := t.reload(); err != nil {
+		lg.Error("problem loading JWKS", zap.String("path", opts.JWKSFile), zap.Error(err))
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,312 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func b64Int(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func writeTestJWKS(t *testing.T, path string, keys ...jwk) {
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func rsaJWK(kid string, k *rsa.PrivateKey) jwk {
+	return jwk{Kty: "RSA", Kid: kid, Use: "sig", N: b64Int(k.N), E: b64Int(big.NewInt(int64(k.E)))}
+}
+
+func ecJWK(kid string, k *ecdsa.PrivateKey) jwk {
+	return jwk{Kty: "EC", Kid: kid, Crv: "P-256", X: b64Int(k.X), Y: b64Int(k.Y)}
+}
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	tk := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		tk.Header["kid"] = kid
+	}
+	s, err := tk.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestOIDCInfo(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, jwksFile, rsaJWK("rsa", rsaKey), ecJWK("ec", ecKey))
+
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{
+		optJWKSFile: jwksFile,
+		optIssuer:   "https://sso.example.com",
+		optAudience: "etcd",
+		optClaim:    "email",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	claims := func(mod func(jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{"iss": "https://sso.example.com", "aud": "etcd", "email": "alice@example.com", "exp": exp}
+		if mod != nil {
+			mod(c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{"rsa", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(nil)), true},
+		{"rsa-pss", signTestToken(t, jwt.SigningMethodPS256, "rsa", rsaKey, claims(nil)), true},
+		{"ecdsa", signTestToken(t, jwt.SigningMethodES256, "ec", ecKey, claims(nil)), true},
+		{"unknown-kid", signTestToken(t, jwt.SigningMethodRS256, "other", rsaKey, claims(nil)), false},
+		{"no-kid", signTestToken(t, jwt.SigningMethodRS256, "", rsaKey, claims(nil)), false},
+		{"wrong-key", signTestToken(t, jwt.SigningMethodRS256, "rsa", otherKey, claims(nil)), false},
+		{"key-type-mismatch", signTestToken(t, jwt.SigningMethodES256, "rsa", ecKey, claims(nil)), false},
+		{"hmac", signTestToken(t, jwt.SigningMethodHS256, "rsa", []byte("secret"), claims(nil)), false},
+		{"expired", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() })), false},
+		{"no-exp", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { delete(c, "exp") })), false},
+		{"wrong-issuer", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" })), false},
+		{"wrong-audience", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["aud"] = "other" })), false},
+		{"no-claim", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { delete(c, "email") })), false},
+		{"role-principal", signTestToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["email"] = rolePrincipalPrefix + rootRole })), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ai, ok := tp.info(context.TODO(), tt.token, 7)
+			if ok != tt.want {
+				t.Fatalf("expected ok=%t, got %t", tt.want, ok)
+			}
+			if !ok {
+				return
+			}
+			if ai.Username != "alice@example.com" || ai.Revision != 7 {
+				t.Errorf("unexpected auth info %+v", ai)
+			}
+		})
+	}
+
+	if _, err = tp.assign(context.TODO(), "alice", 1); err != ErrVerifyOnly {
+		t.Errorf("expected %v, got %v", ErrVerifyOnly, err)
+	}
+}
+
+func TestOIDCReload(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, jwksFile, rsaJWK("old", oldKey))
+
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{
+		optJWKSFile:  jwksFile,
+		optClaimType: oidcClaimTypeRole,
+		optClaim:     "group",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.MapClaims{"group": "ops", "exp": time.Now().Add(time.Hour).Unix()}
+	oldToken := signTestToken(t, jwt.SigningMethodRS256, "old", oldKey, claims)
+	newToken := signTestToken(t, jwt.SigningMethodRS256, "new", newKey, claims)
+
+	ai, ok := tp.info(context.TODO(), oldToken, 1)
+	if !ok {
+		t.Fatal("expected token signed by the old key to be valid")
+	}
+	if ai.Username != rolePrincipalPrefix+"ops" {
+		t.Errorf("expected principal %q, got %q", rolePrincipalPrefix+"ops", ai.Username)
+	}
+	if _, ok = tp.info(context.TODO(), newToken, 1); ok {
+		t.Fatal("expected token signed by the new key to be invalid before reload")
+	}
+
+	// a broken document keeps the previously loaded keys
+	if err = os.WriteFile(jwksFile, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.reload(); err == nil {
+		t.Fatal("expected reloading a broken JWKS to fail")
+	}
+	if _, ok = tp.info(context.TODO(), oldToken, 1); !ok {
+		t.Fatal("expected the old key to be kept after a failed reload")
+	}
+
+	writeTestJWKS(t, jwksFile, rsaJWK("new", newKey))
+	if err = tp.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok = tp.info(context.TODO(), oldToken, 1); ok {
+		t.Fatal("expected token signed by the old key to be invalid after rotation")
+	}
+	if _, ok = tp.info(context.TODO(), newToken, 1); !ok {
+		t.Fatal("expected token signed by the new key to be valid after rotation")
+	}
+}
+
+func TestOIDCReloadOnSIGHUP(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, jwksFile, rsaJWK("old", oldKey))
+
+	tp, err := newTokenProviderOIDC(zaptest.NewLogger(t), map[string]string{optJWKSFile: jwksFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notify, stop := signalNotify, signalStop
+	defer func() { signalNotify, signalStop = notify, stop }()
+	var sigc, stoppedc chan<- os.Signal
+	signalNotify = func(c chan<- os.Signal, sig ...os.Signal) {
+		if len(sig) != 1 || sig[0] != syscall.SIGHUP {
+			t.Errorf("expected subscription to SIGHUP, got %v", sig)
+		}
+		sigc = c
+	}
+	signalStop = func(c chan<- os.Signal) { stoppedc = c }
+
+	tp.enable()
+	if sigc == nil {
+		t.Fatal("expected enable to subscribe to SIGHUP")
+	}
+
+	writeTestJWKS(t, jwksFile, rsaJWK("new", newKey))
+	sigc <- syscall.SIGHUP
+
+	token := signTestToken(t, jwt.SigningMethodRS256, "new", newKey, jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()})
+	for i := 0; ; i++ {
+		if _, ok := tp.info(context.TODO(), token, 1); ok {
+			break
+		}
+		if i == 100 {
+			t.Fatal("expected SIGHUP to reload the JWKS")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	tp.disable()
+	if stoppedc != sigc {
+		t.Fatal("expected disable to stop the SIGHUP subscription")
+	}
+}
+
+func TestOIDCBadOptions(t *testing.T) {
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, jwksFile)
+
+	opts := []map[string]string{
+		{},
+		{optJWKSFile: filepath.Join(t.TempDir(), "missing.json")},
+		{optJWKSFile: jwksFile}, // no usable keys
+		{optJWKSFile: jwksFile, optClaimType: "group"},
+	}
+	for i, o := range opts {
+		if _, err := newTokenProviderOIDC(zaptest.NewLogger(t), o); err == nil {
+			t.Errorf("#%d: expected error for options %v", i, o)
+		}
+	}
+}
+
+func TestRolePrincipalPermitted(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+		Name: "ops",
+		Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("a"), RangeEnd: []byte("c")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	principal := rolePrincipalPrefix + "ops"
+	if err = as.isOpPermitted(principal, as.Revision(), []byte("b"), nil, authpb.READ); err != nil {
+		t.Errorf("expected read to be permitted, got %v", err)
+	}
+	if err = as.isOpPermitted(principal, as.Revision(), []byte("b"), nil, authpb.WRITE); err != ErrPermissionDenied {
+		t.Errorf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = as.isOpPermitted(rolePrincipalPrefix+"missing", as.Revision(), []byte("b"), nil, authpb.READ); err != ErrPermissionDenied {
+		t.Errorf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = as.IsAdminPermitted(&AuthInfo{Username: principal, Revision: as.Revision()}); err != ErrPermissionDenied {
+		t.Errorf("expected %v, got %v", ErrPermissionDenied, err)
+	}
+	if err = as.IsAdminPermitted(&AuthInfo{Username: rolePrincipalPrefix + rootRole, Revision: as.Revision()}); err != nil {
+		t.Errorf("expected root role principal to be admin, got %v", err)
+	}
+}
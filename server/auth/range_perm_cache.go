@@ -15,6 +15,8 @@
 package auth
 
 import (
+	"strings"
+
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/api/v3/authpb"
@@ -22,15 +24,15 @@ import (
 )
 
 func getMergedPerms(tx AuthReadTx, userName string) *unifiedRangePermissions {
-	user := tx.UnsafeGetUser(userName)
-	if user == nil {
+	roles, ok := principalRoles(tx, userName)
+	if !ok {
 		return nil
 	}
 
-	readPerms := adt.NewIntervalTree()
-	writePerms := adt.NewIntervalTree()
+	perms := newRangePermissions()
+	denied := newRangePermissions()
 
-	for _, roleName := range user.Roles {
+	for _, roleName := range roles {
 		role := tx.UnsafeGetRole(roleName)
 		if role == nil {
 			continue
@@ -50,24 +52,87 @@ func getMergedPerms(tx AuthReadTx, userName string) *unifiedRangePermissions {
 				ivl = adt.NewBytesAffinePoint(perm.Key)
 			}
 
-			switch perm.PermType {
-			case authpb.READWRITE:
-				readPerms.Insert(ivl, struct{}{})
-				writePerms.Insert(ivl, struct{}{})
-
-			case authpb.READ:
-				readPerms.Insert(ivl, struct{}{})
-
-			case authpb.WRITE:
-				writePerms.Insert(ivl, struct{}{})
+			if perm.Deny {
+				denied.insert(ivl, perm.PermType)
+			} else {
+				perms.insert(ivl, perm.PermType)
 			}
 		}
 	}
 
+	perms.denied = denied
+	return perms
+}
+
+func newRangePermissions() *unifiedRangePermissions {
 	return &unifiedRangePermissions{
-		readPerms:  readPerms,
-		writePerms: writePerms,
+		readPerms:        adt.NewIntervalTree(),
+		writePerms:       adt.NewIntervalTree(),
+		watchPerms:       adt.NewIntervalTree(),
+		deletePerms:      adt.NewIntervalTree(),
+		leaseAttachPerms: adt.NewIntervalTree(),
+	}
+}
+
+// insert adds ivl to every operation kind covered by permtyp. READ implies
+// WATCH, and WRITE implies DELETE and LEASE_ATTACH, so that roles created
+// before the finer-grained kinds existed keep their behavior.
+func (perms *unifiedRangePermissions) insert(ivl adt.Interval, permtyp authpb.Permission_Type) {
+	switch permtyp {
+	case authpb.READWRITE:
+		perms.readPerms.Insert(ivl, struct{}{})
+		perms.watchPerms.Insert(ivl, struct{}{})
+		perms.writePerms.Insert(ivl, struct{}{})
+		perms.deletePerms.Insert(ivl, struct{}{})
+		perms.leaseAttachPerms.Insert(ivl, struct{}{})
+
+	case authpb.READ:
+		perms.readPerms.Insert(ivl, struct{}{})
+		perms.watchPerms.Insert(ivl, struct{}{})
+
+	case authpb.WRITE:
+		perms.writePerms.Insert(ivl, struct{}{})
+		perms.deletePerms.Insert(ivl, struct{}{})
+		perms.leaseAttachPerms.Insert(ivl, struct{}{})
+
+	case authpb.WATCH:
+		perms.watchPerms.Insert(ivl, struct{}{})
+
+	case authpb.DELETE:
+		perms.deletePerms.Insert(ivl, struct{}{})
+
+	case authpb.LEASE_ATTACH:
+		perms.leaseAttachPerms.Insert(ivl, struct{}{})
+	}
+}
+
+// tree returns the interval tree holding permissions of the given operation
+// kind, or nil if there is none.
+func (perms *unifiedRangePermissions) tree(lg *zap.Logger, permtyp authpb.Permission_Type) adt.IntervalTree {
+	switch permtyp {
+	case authpb.READ:
+		return perms.readPerms
+	case authpb.WRITE:
+		return perms.writePerms
+	case authpb.WATCH:
+		return perms.watchPerms
+	case authpb.DELETE:
+		return perms.deletePerms
+	case authpb.LEASE_ATTACH:
+		return perms.leaseAttachPerms
+	default:
+		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
+	}
+	return nil
+}
+
+// isDenied returns true if any part of ivl is covered by an explicit deny.
+func (perms *unifiedRangePermissions) isDenied(lg *zap.Logger, ivl adt.Interval, permtyp authpb.Permission_Type) bool {
+	if perms.denied == nil {
+		return false
 	}
+	denied := perms.denied.tree(lg, permtyp)
+	return denied != nil && denied.Intersects(ivl)
 }
 
 func checkKeyInterval(
@@ -80,28 +145,20 @@ func checkKeyInterval(
 	}
 
 	ivl := adt.NewBytesAffineInterval(key, rangeEnd)
-	switch permtyp {
-	case authpb.READ:
-		return cachedPerms.readPerms.Contains(ivl)
-	case authpb.WRITE:
-		return cachedPerms.writePerms.Contains(ivl)
-	default:
-		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
+	allowed := cachedPerms.tree(lg, permtyp)
+	if allowed == nil || !allowed.Contains(ivl) {
+		return false
 	}
-	return false
+	return !cachedPerms.isDenied(lg, ivl, permtyp)
 }
 
 func checkKeyPoint(lg *zap.Logger, cachedPerms *unifiedRangePermissions, key []byte, permtyp authpb.Permission_Type) bool {
 	pt := adt.NewBytesAffinePoint(key)
-	switch permtyp {
-	case authpb.READ:
-		return cachedPerms.readPerms.Intersects(pt)
-	case authpb.WRITE:
-		return cachedPerms.writePerms.Intersects(pt)
-	default:
-		lg.Panic("unknown auth type", zap.String("auth-type", permtyp.String()))
+	allowed := cachedPerms.tree(lg, permtyp)
+	if allowed == nil || !allowed.Intersects(pt) {
+		return false
 	}
-	return false
+	return !cachedPerms.isDenied(lg, pt, permtyp)
 }
 
 func (as *authStore) isRangeOpPermitted(userName string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
@@ -110,6 +167,9 @@ func (as *authStore) isRangeOpPermitted(userName string, key, rangeEnd []byte, p
 	defer as.rangePermCacheMu.RUnlock()
 
 	rangePerm, ok := as.rangePermCache[userName]
+	if !ok && strings.HasPrefix(userName, rolePrincipalPrefix) {
+		rangePerm, ok = as.rolePermCache[strings.TrimPrefix(userName, rolePrincipalPrefix)]
+	}
 	if !ok {
 		as.lg.Error(
 			"user doesn't exist",
@@ -149,9 +209,25 @@ func (as *authStore) refreshRangePermCache(tx AuthReadTx) {
 		}
 		as.rangePermCache[userName] = perms
 	}
+
+	// roles can also act as principals for tokens mapped onto a role
+	as.rolePermCache = make(map[string]*unifiedRangePermissions)
+	roles := tx.UnsafeGetAllRoles()
+	for _, role := range roles {
+		roleName := string(role.Name)
+		if perms := getMergedPerms(tx, rolePrincipalPrefix+roleName); perms != nil {
+			as.rolePermCache[roleName] = perms
+		}
+	}
 }
 
 type unifiedRangePermissions struct {
-	readPerms  adt.IntervalTree
-	writePerms adt.IntervalTree
+	readPerms        adt.IntervalTree
+	writePerms       adt.IntervalTree
+	watchPerms       adt.IntervalTree
+	deletePerms      adt.IntervalTree
+	leaseAttachPerms adt.IntervalTree
+
+	// denied holds explicit DENY permissions, which override any allow.
+	denied *unifiedRangePermissions
 }
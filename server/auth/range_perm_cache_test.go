@@ -100,3 +100,38 @@ func TestKeyPermission(t *testing.T) {
 		}
 	}
 }
+
+func TestRangePermissionDeny(t *testing.T) {
+	perms := newRangePermissions()
+	perms.insert(adt.NewBytesAffineInterval([]byte("a"), []byte("z")), authpb.READWRITE)
+	perms.insert(adt.NewBytesAffineInterval([]byte("x"), []byte("y")), authpb.WATCH)
+	perms.denied = newRangePermissions()
+	perms.denied.insert(adt.NewBytesAffineInterval([]byte("c"), []byte("e")), authpb.WRITE)
+
+	tests := []struct {
+		begin, end []byte
+		permType   authpb.Permission_Type
+		want       bool
+	}{
+		{[]byte("a"), []byte("c"), authpb.WRITE, true},
+		{[]byte("a"), []byte("d"), authpb.WRITE, false},
+		{[]byte("a"), []byte("d"), authpb.DELETE, false},
+		{[]byte("a"), []byte("d"), authpb.LEASE_ATTACH, false},
+		{[]byte("a"), []byte("d"), authpb.READ, true},
+		{[]byte("a"), []byte("d"), authpb.WATCH, true},
+		{[]byte("e"), []byte("z"), authpb.DELETE, true},
+	}
+	for i, tt := range tests {
+		result := checkKeyInterval(zaptest.NewLogger(t), perms, tt.begin, tt.end, tt.permType)
+		if result != tt.want {
+			t.Errorf("#%d: result=%t, want=%t", i, result, tt.want)
+		}
+	}
+
+	if !checkKeyPoint(zaptest.NewLogger(t), perms, []byte("b"), authpb.DELETE) {
+		t.Errorf("expected delete on b to be permitted")
+	}
+	if checkKeyPoint(zaptest.NewLogger(t), perms, []byte("d"), authpb.DELETE) {
+		t.Errorf("expected delete on d to be denied")
+	}
+}
@@ -28,7 +28,9 @@ import (
 	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/api/v3/version"
 
+	"github.com/coreos/go-semver/semver"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/credentials"
@@ -42,29 +44,32 @@ var (
 
 	rootPerm = authpb.Permission{PermType: authpb.READWRITE, Key: []byte{}, RangeEnd: []byte{0}}
 
-	ErrRootUserNotExist     = errors.New("auth: root user does not exist")
-	ErrRootRoleNotExist     = errors.New("auth: root user does not have root role")
-	ErrUserAlreadyExist     = errors.New("auth: user already exists")
-	ErrUserEmpty            = errors.New("auth: user name is empty")
-	ErrUserNotFound         = errors.New("auth: user not found")
-	ErrRoleAlreadyExist     = errors.New("auth: role already exists")
-	ErrRoleNotFound         = errors.New("auth: role not found")
-	ErrRoleEmpty            = errors.New("auth: role name is empty")
-	ErrPermissionNotGiven   = errors.New("auth: permission not given")
-	ErrAuthFailed           = errors.New("auth: authentication failed, invalid user ID or password")
-	ErrNoPasswordUser       = errors.New("auth: authentication failed, password was given for no password user")
-	ErrPermissionDenied     = errors.New("auth: permission denied")
-	ErrRoleNotGranted       = errors.New("auth: role is not granted to the user")
-	ErrPermissionNotGranted = errors.New("auth: permission is not granted to the role")
-	ErrAuthNotEnabled       = errors.New("auth: authentication is not enabled")
-	ErrAuthOldRevision      = errors.New("auth: revision in header is old")
-	ErrInvalidAuthToken     = errors.New("auth: invalid auth token")
-	ErrInvalidAuthOpts      = errors.New("auth: invalid auth options")
-	ErrInvalidAuthMgmt      = errors.New("auth: invalid auth management")
-	ErrInvalidAuthMethod    = errors.New("auth: invalid auth signature method")
-	ErrMissingKey           = errors.New("auth: missing key data")
-	ErrKeyMismatch          = errors.New("auth: public and private keys don't match")
-	ErrVerifyOnly           = errors.New("auth: token signing attempted with verify-only key")
+	ErrRootUserNotExist       = errors.New("auth: root user does not exist")
+	ErrRootRoleNotExist       = errors.New("auth: root user does not have root role")
+	ErrUserAlreadyExist       = errors.New("auth: user already exists")
+	ErrUserEmpty              = errors.New("auth: user name is empty")
+	ErrUserNotFound           = errors.New("auth: user not found")
+	ErrRoleAlreadyExist       = errors.New("auth: role already exists")
+	ErrRoleNotFound           = errors.New("auth: role not found")
+	ErrRoleEmpty              = errors.New("auth: role name is empty")
+	ErrPermissionNotGiven     = errors.New("auth: permission not given")
+	ErrAuthFailed             = errors.New("auth: authentication failed, invalid user ID or password")
+	ErrNoPasswordUser         = errors.New("auth: authentication failed, password was given for no password user")
+	ErrPermissionDenied       = errors.New("auth: permission denied")
+	ErrRoleNotGranted         = errors.New("auth: role is not granted to the user")
+	ErrPermissionNotGranted   = errors.New("auth: permission is not granted to the role")
+	ErrPermissionNotSupported = errors.New("auth: permission is not supported by the cluster version")
+	ErrAuthNotEnabled         = errors.New("auth: authentication is not enabled")
+	ErrAuthOldRevision        = errors.New("auth: revision in header is old")
+	ErrInvalidAuthToken       = errors.New("auth: invalid auth token")
+	ErrInvalidAuthOpts        = errors.New("auth: invalid auth options")
+	ErrInvalidAuthMgmt        = errors.New("auth: invalid auth management")
+	ErrInvalidAuthMethod      = errors.New("auth: invalid auth signature method")
+	ErrMissingKey             = errors.New("auth: missing key data")
+	ErrKeyMismatch            = errors.New("auth: public and private keys don't match")
+	ErrVerifyOnly             = errors.New("auth: token signing attempted with verify-only key")
+	ErrMissingJWKS            = errors.New("auth: missing JWKS file")
+	ErrNoJWKSKey              = errors.New("auth: no matching key in JWKS")
 )
 
 const (
@@ -73,6 +78,11 @@ const (
 
 	tokenTypeSimple = "simple"
 	tokenTypeJWT    = "jwt"
+	tokenTypeOIDC   = "oidc"
+
+	// rolePrincipalPrefix prefixes the name of a principal that acts as a
+	// role instead of a user, see principalRoles.
+	rolePrincipalPrefix = "role:"
 )
 
 type AuthInfo struct {
@@ -151,6 +161,12 @@ type AuthStore interface {
 	// IsDeleteRangePermitted checks delete-range permission of the user
 	IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error
 
+	// IsWatchPermitted checks watch permission of the user
+	IsWatchPermitted(authInfo *AuthInfo, key, rangeEnd []byte) error
+
+	// IsLeaseAttachPermitted checks permission of the user to attach a lease to the key
+	IsLeaseAttachPermitted(authInfo *AuthInfo, key []byte) error
+
 	// IsAdminPermitted checks admin permission of the user
 	IsAdminPermitted(authInfo *AuthInfo) error
 
@@ -242,6 +258,7 @@ type authStore struct {
 	// Note that BatchTx and ReadTx cannot be a mutex for rangePermCache because they are independent resources
 	// see also: https://github.com/etcd-io/etcd/pull/13920#discussion_r849114855
 	rangePermCache   map[string]*unifiedRangePermissions // username -> unifiedRangePermissions
+	rolePermCache    map[string]*unifiedRangePermissions // role name -> unifiedRangePermissions, see principalRoles
 	rangePermCacheMu sync.RWMutex
 
 	tokenProvider TokenProvider
@@ -678,7 +695,7 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 	}
 
 	for _, perm := range role.KeyPermission {
-		if !bytes.Equal(perm.Key, r.Key) || !bytes.Equal(perm.RangeEnd, r.RangeEnd) {
+		if !bytes.Equal(perm.Key, r.Key) || !bytes.Equal(perm.RangeEnd, r.RangeEnd) || perm.Deny != r.Deny {
 			updatedRole.KeyPermission = append(updatedRole.KeyPermission, perm)
 		}
 	}
@@ -697,6 +714,7 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 		zap.String("role-name", r.Role),
 		zap.String("key", string(r.Key)),
 		zap.String("range-end", string(r.RangeEnd)),
+		zap.Bool("deny", r.Deny),
 	)
 	return &pb.AuthRoleRevokePermissionResponse{}, nil
 }
@@ -809,7 +827,14 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 		return bytes.Compare(role.KeyPermission[i].Key, r.Perm.Key) >= 0
 	})
 
-	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) && bytes.Equal(role.KeyPermission[idx].RangeEnd, r.Perm.RangeEnd) {
+	// an allow and a deny on the same range are kept as separate permissions
+	for ; idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key); idx++ {
+		if bytes.Equal(role.KeyPermission[idx].RangeEnd, r.Perm.RangeEnd) && role.KeyPermission[idx].Deny == r.Perm.Deny {
+			break
+		}
+	}
+
+	if idx < len(role.KeyPermission) && bytes.Equal(role.KeyPermission[idx].Key, r.Perm.Key) {
 		// update existing permission
 		role.KeyPermission[idx].PermType = r.Perm.PermType
 	} else {
@@ -818,6 +843,7 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 			Key:      r.Perm.Key,
 			RangeEnd: r.Perm.RangeEnd,
 			PermType: r.Perm.PermType,
+			Deny:     r.Perm.Deny,
 		}
 
 		role.KeyPermission = append(role.KeyPermission, newPerm)
@@ -833,6 +859,7 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 		"granted/updated a permission to a user",
 		zap.String("user-name", r.Name),
 		zap.String("permission-name", authpb.Permission_Type_name[int32(r.Perm.PermType)]),
+		zap.Bool("deny", r.Perm.Deny),
 		zap.ByteString("key", r.Perm.Key),
 		zap.ByteString("range-end", r.Perm.RangeEnd),
 	)
@@ -863,14 +890,14 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 	tx.Lock()
 	defer tx.Unlock()
 
-	user := tx.UnsafeGetUser(userName)
-	if user == nil {
+	roles, ok := principalRoles(tx, userName)
+	if !ok {
 		as.lg.Error("cannot find a user for permission check", zap.String("user-name", userName))
 		return ErrPermissionDenied
 	}
 
 	// root role should have permission on all ranges
-	if hasRootRole(user) {
+	if containsRootRole(roles) {
 		return nil
 	}
 
@@ -890,7 +917,15 @@ func (as *authStore) IsRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte)
 }
 
 func (as *authStore) IsDeleteRangePermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
-	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.WRITE)
+	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.DELETE)
+}
+
+func (as *authStore) IsWatchPermitted(authInfo *AuthInfo, key, rangeEnd []byte) error {
+	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, rangeEnd, authpb.WATCH)
+}
+
+func (as *authStore) IsLeaseAttachPermitted(authInfo *AuthInfo, key []byte) error {
+	return as.isOpPermitted(authInfo.Username, authInfo.Revision, key, nil, authpb.LEASE_ATTACH)
 }
 
 func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
@@ -904,13 +939,13 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 	tx := as.be.ReadTx()
 	tx.Lock()
 	defer tx.Unlock()
-	u := tx.UnsafeGetUser(authInfo.Username)
+	roles, ok := principalRoles(tx, authInfo.Username)
 
-	if u == nil {
+	if !ok {
 		return ErrUserNotFound
 	}
 
-	if !hasRootRole(u) {
+	if !containsRootRole(roles) {
 		return ErrPermissionDenied
 	}
 
@@ -952,6 +987,7 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 		be:             be,
 		enabled:        enabled,
 		rangePermCache: make(map[string]*unifiedRangePermissions),
+		rolePermCache:  make(map[string]*unifiedRangePermissions),
 		tokenProvider:  tp,
 		bcryptCost:     bcryptCost,
 	}
@@ -975,9 +1011,42 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 }
 
 func hasRootRole(u *authpb.User) bool {
-	// u.Roles is sorted in UserGrantRole(), so we can use binary search.
-	idx := sort.SearchStrings(u.Roles, rootRole)
-	return idx != len(u.Roles) && u.Roles[idx] == rootRole
+	return containsRootRole(u.Roles)
+}
+
+func containsRootRole(roles []string) bool {
+	// user roles are sorted in UserGrantRole(), so we can use binary search.
+	idx := sort.SearchStrings(roles, rootRole)
+	return idx != len(roles) && roles[idx] == rootRole
+}
+
+// IsPermissionSupported reports whether every member of a cluster at the
+// given version understands the permission. Members older than 3.6 drop the
+// deny field, applying a denial as a grant, and do not know the permission
+// types added with it.
+func IsPermissionSupported(cv *semver.Version, perm *authpb.Permission) bool {
+	if perm == nil || (!perm.Deny && perm.PermType <= authpb.READWRITE) {
+		return true
+	}
+	return cv != nil && !version.LessThan(*cv, version.V3_6)
+}
+
+// principalRoles returns the roles held by the named principal. A principal
+// is usually a user. A name with rolePrincipalPrefix that does not belong to
+// a user refers to a role itself; such principals are only produced by token
+// providers that map an external identity onto a role.
+func principalRoles(tx AuthReadTx, name string) ([]string, bool) {
+	if user := tx.UnsafeGetUser(name); user != nil {
+		return user.Roles, true
+	}
+	if !strings.HasPrefix(name, rolePrincipalPrefix) {
+		return nil, false
+	}
+	roleName := strings.TrimPrefix(name, rolePrincipalPrefix)
+	if tx.UnsafeGetRole(roleName) == nil {
+		return nil, false
+	}
+	return []string{roleName}, true
 }
 
 func (as *authStore) commitRevision(tx AuthBatchTx) {
@@ -1123,6 +1192,9 @@ func NewTokenProvider(
 	case tokenTypeJWT:
 		return newTokenProviderJWT(lg, typeSpecificOpts)
 
+	case tokenTypeOIDC:
+		return newTokenProviderOIDC(lg, typeSpecificOpts)
+
 	case "":
 		return newTokenProviderNop()
 
@@ -1159,7 +1231,16 @@ func (as *authStore) WithRoot(ctx context.Context) context.Context {
 		ctxForAssign = ctx
 	}
 
-	token, err := as.tokenProvider.assign(ctxForAssign, "root", as.Revision())
+	var (
+		token string
+		err   error
+	)
+	if to, ok := as.tokenProvider.(*tokenOIDC); ok && to != nil {
+		// OIDC tokens are issued externally; sign a member-local one instead
+		token, err = to.assignInternal("root", as.Revision())
+	} else {
+		token, err = as.tokenProvider.assign(ctxForAssign, "root", as.Revision())
+	}
 	if err != nil {
 		// this must not happen
 		as.lg.Error(
@@ -16,13 +16,17 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 
@@ -32,6 +36,7 @@ import (
 	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/pkg/v3/adt"
 )
 
@@ -429,6 +434,86 @@ func TestIsOpPermitted(t *testing.T) {
 	}
 }
 
+func TestIsPermissionSupported(t *testing.T) {
+	tests := []struct {
+		cv   *semver.Version
+		perm *authpb.Permission
+		want bool
+	}{
+		{nil, &authpb.Permission{PermType: authpb.READWRITE}, true},
+		{&version.V3_5, &authpb.Permission{PermType: authpb.READ}, true},
+		{&version.V3_5, &authpb.Permission{PermType: authpb.READ, Deny: true}, false},
+		{&version.V3_5, &authpb.Permission{PermType: authpb.WATCH}, false},
+		{nil, &authpb.Permission{PermType: authpb.DELETE}, false},
+		{&version.V3_6, &authpb.Permission{PermType: authpb.READ, Deny: true}, true},
+		{&version.V3_6, &authpb.Permission{PermType: authpb.LEASE_ATTACH}, true},
+	}
+	for i, tt := range tests {
+		if got := IsPermissionSupported(tt.cv, tt.perm); got != tt.want {
+			t.Errorf("#%d: supported = %t, want %t", i, got, tt.want)
+		}
+	}
+}
+
+func TestIsOpPermittedDeny(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perms := []*authpb.Permission{
+		{PermType: authpb.READWRITE, Key: []byte("a"), RangeEnd: []byte("z")},
+		{PermType: authpb.DELETE, Key: []byte("c"), RangeEnd: []byte("d"), Deny: true},
+		{PermType: authpb.READ, Key: []byte("s"), Deny: true},
+	}
+	for _, perm := range perms {
+		_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test-1", Perm: perm})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the allow on [a, z) and the denies are all kept on the role
+	r, err := as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Perm) != len(perms) {
+		t.Fatalf("expected %d permissions, got %d", len(perms), len(r.Perm))
+	}
+
+	tests := []struct {
+		key, rangeEnd []byte
+		permType      authpb.Permission_Type
+		want          error
+	}{
+		{[]byte("b"), nil, authpb.DELETE, nil},
+		{[]byte("c"), nil, authpb.DELETE, ErrPermissionDenied},
+		{[]byte("c"), nil, authpb.WRITE, nil},
+		{[]byte("b"), []byte("e"), authpb.DELETE, ErrPermissionDenied},
+		{[]byte("s"), nil, authpb.READ, ErrPermissionDenied},
+		{[]byte("s"), nil, authpb.WATCH, ErrPermissionDenied},
+		{[]byte("s"), nil, authpb.WRITE, nil},
+		{[]byte("a"), []byte("z"), authpb.READ, ErrPermissionDenied},
+		{[]byte("t"), []byte("z"), authpb.WATCH, nil},
+		{[]byte("t"), nil, authpb.LEASE_ATTACH, nil},
+	}
+	for i, tt := range tests {
+		err = as.isOpPermitted("foo", as.Revision(), tt.key, tt.rangeEnd, tt.permType)
+		if err != tt.want {
+			t.Errorf("#%d: %s [%q, %q) expected %v, got %v", i, tt.permType, tt.key, tt.rangeEnd, tt.want, err)
+		}
+	}
+}
+
 func TestGetUser(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -605,6 +690,61 @@ func TestRoleRevokePermission(t *testing.T) {
 	}
 }
 
+// TestRoleRevokePermissionDeny ensures revoking a grant keeps a deny on the
+// same range, and the reverse.
+func TestRoleRevokePermissionDeny(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	_, err := as.RoleAdd(&pb.AuthRoleAddRequest{Name: "role-test-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, deny := range []bool{false, true} {
+		_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{
+			Name: "role-test-1",
+			Perm: &authpb.Permission{PermType: authpb.WRITE, Key: []byte("Keys"), RangeEnd: []byte("RangeEnd"), Deny: deny},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, deny := range []bool{true, false} {
+		_, err = as.RoleRevokePermission(&pb.AuthRoleRevokePermissionRequest{
+			Role:     "role-test-1",
+			Key:      []byte("Keys"),
+			RangeEnd: []byte("RangeEnd"),
+			Deny:     deny,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := as.RoleGet(&pb.AuthRoleGetRequest{Role: "role-test-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if deny {
+			if len(r.Perm) != 1 || r.Perm[0].Deny {
+				t.Fatalf("expected the grant to remain, got %v", r.Perm)
+			}
+		} else if len(r.Perm) != 0 {
+			t.Fatalf("expected no permission, got %v", r.Perm)
+		}
+	}
+
+	// the deny is gone, so revoking it again fails
+	_, err = as.RoleRevokePermission(&pb.AuthRoleRevokePermissionRequest{
+		Role:     "role-test-1",
+		Key:      []byte("Keys"),
+		RangeEnd: []byte("RangeEnd"),
+		Deny:     true,
+	})
+	if err != ErrPermissionNotGranted {
+		t.Fatalf("expected %v, got %v", ErrPermissionNotGranted, err)
+	}
+}
+
 func TestUserRevokePermission(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -984,6 +1124,18 @@ func TestAuthInfoFromCtxWithRootJWT(t *testing.T) {
 	testAuthInfoFromCtxWithRoot(t, opts)
 }
 
+func TestAuthInfoFromCtxWithRootOIDC(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, jwksFile, rsaJWK("rsa", key))
+
+	opts := fmt.Sprintf("%s,%s=%s", tokenTypeOIDC, optJWKSFile, jwksFile)
+	testAuthInfoFromCtxWithRoot(t, opts)
+}
+
 // testAuthInfoFromCtxWithRoot ensures "WithRoot" properly embeds token in the context.
 func testAuthInfoFromCtxWithRoot(t *testing.T, opts string) {
 	tp, err := NewTokenProvider(zaptest.NewLogger(t), opts, dummyIndexWaiter, simpleTokenTTLDefault)
@@ -28,6 +28,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
 
@@ -61,6 +62,15 @@ type ServerConfig struct {
 	MaxSnapFiles uint
 	MaxWALFiles  uint
 
+	// WALArchiveDir is the directory WAL segments and snapshot files are
+	// copied to before being purged. Archiving is disabled if empty.
+	WALArchiveDir string
+	// WALArchiveMaxFiles is the maximum number of archived files of each
+	// kind to retain (0 is unlimited).
+	WALArchiveMaxFiles uint
+	// WALArchiveRetention is how long archived files are retained (0 is unlimited).
+	WALArchiveRetention time.Duration
+
 	// BackendBatchInterval is the maximum time before commit the backend transaction.
 	BackendBatchInterval time.Duration
 	// BackendBatchLimit is the maximum operations before commit the backend transaction.
@@ -121,10 +131,13 @@ type ServerConfig struct {
 
 	AutoCompactionRetention time.Duration
 	AutoCompactionMode      string
-	CompactionBatchLimit    int
-	CompactionSleepInterval time.Duration
-	QuotaBackendBytes       int64
-	MaxTxnOps               uint
+	// CompactionPrefixRetention keeps the history of the keys under each
+	// prefix when compacting.
+	CompactionPrefixRetention []v3compactor.PrefixRetention
+	CompactionBatchLimit      int
+	CompactionSleepInterval   time.Duration
+	QuotaBackendBytes         int64
+	MaxTxnOps                 uint
 
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
@@ -161,6 +174,13 @@ type ServerConfig struct {
 	// Logger logs server-side operations.
 	Logger *zap.Logger
 
+	// AuditLogger records mutating and auth requests. Auditing is disabled when nil.
+	AuditLogger *zap.Logger
+	// AuditLogPrefixes limits audited key requests to those touching one of these key prefixes.
+	AuditLogPrefixes []string
+	// AuditLogVerbs limits auditing to these request verbs, see v3audit.Verbs.
+	AuditLogVerbs []string
+
 	ForceNewCluster bool
 
 	// EnableLeaseCheckpoint enables leader to send regular checkpoints to other members to prevent reset of remaining TTL on leader change.
@@ -201,6 +221,23 @@ type ServerConfig struct {
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
 
+	// ExperimentalAutoDefragRatio is the minimum fraction of a member's backend size that must be freeable
+	// for the leader to defragment the member.
+	ExperimentalAutoDefragRatio float64 `json:"experimental-auto-defrag-ratio"`
+	// ExperimentalAutoDefragThresholdMegabytes is the minimum number of megabytes that must be freeable
+	// for the leader to defragment a member.
+	// Automatic defragmentation is enabled if either ExperimentalAutoDefragRatio or this is non-zero.
+	ExperimentalAutoDefragThresholdMegabytes uint `json:"experimental-auto-defrag-threshold-megabytes"`
+	// ExperimentalAutoDefragCheckTime is the duration of time between the leader checks of the members' fragmentation.
+	ExperimentalAutoDefragCheckTime time.Duration `json:"experimental-auto-defrag-check-time"`
+
+	// ExperimentalValueCompression is the name of the compressor for the values written to the backend.
+	// Values are stored raw if empty or until the cluster version is at least v3.6.
+	ExperimentalValueCompression string `json:"experimental-value-compression"`
+
+	// ExperimentalPeerCompression enables compressing raft messages and snapshots sent to peers that enable it as well.
+	ExperimentalPeerCompression bool `json:"experimental-peer-compression"`
+
 	// ExperimentalMaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	ExperimentalMaxLearners int `json:"experimental-max-learners"`
 
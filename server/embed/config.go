@@ -38,8 +38,10 @@ import (
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -66,6 +68,7 @@ const (
 	DefaultGRPCKeepAliveInterval       = 2 * time.Hour
 	DefaultGRPCKeepAliveTimeout        = 20 * time.Second
 	DefaultDowngradeCheckTime          = 5 * time.Second
+	DefaultAutoDefragCheckTime         = 10 * time.Minute
 	DefaultWaitClusterReadyTimeout     = 5 * time.Second
 
 	DefaultDiscoveryDialTimeout      = 2 * time.Second
@@ -109,8 +112,10 @@ const (
 var (
 	ErrConflictBootstrapFlags = fmt.Errorf("multiple discovery or bootstrap flags are set. " +
 		"Choose one of \"initial-cluster\", \"discovery\", \"discovery-endpoints\" or \"discovery-srv\"")
-	ErrUnsetAdvertiseClientURLsFlag = fmt.Errorf("--advertise-client-urls is required when --listen-client-urls is set explicitly")
-	ErrLogRotationInvalidLogOutput  = fmt.Errorf("--log-outputs requires a single file path when --log-rotate-config-json is defined")
+	ErrUnsetAdvertiseClientURLsFlag  = fmt.Errorf("--advertise-client-urls is required when --listen-client-urls is set explicitly")
+	ErrLogRotationInvalidLogOutput   = fmt.Errorf("--log-outputs requires a single file path when --log-rotate-config-json is defined")
+	ErrAuditLogRotationInvalidOutput = fmt.Errorf("--enable-audit-log-rotation requires --audit-log-output to be a file path")
+	ErrInvalidWALArchiveRetention    = fmt.Errorf("--wal-archive-retention must not be negative")
 
 	DefaultInitialAdvertisePeerURLs = "http://localhost:2380"
 	DefaultAdvertiseClientURLs      = "http://localhost:2379"
@@ -161,6 +166,16 @@ type Config struct {
 	MaxSnapFiles uint `json:"max-snapshots"`
 	MaxWalFiles  uint `json:"max-wals"`
 
+	// WALArchiveDir is the directory WAL segments and snapshot files are
+	// copied to, with a manifest of their CRCs, before being purged.
+	// Archiving is disabled if empty.
+	WALArchiveDir string `json:"wal-archive-dir"`
+	// WALArchiveMaxFiles is the maximum number of archived files of each
+	// kind (WAL, raft snapshot, backend snapshot) to retain (0 is unlimited).
+	WALArchiveMaxFiles uint `json:"wal-archive-max-files"`
+	// WALArchiveRetention is how long archived files are retained (0 is unlimited).
+	WALArchiveRetention time.Duration `json:"wal-archive-retention"`
+
 	// TickMs is the number of milliseconds between heartbeat ticks.
 	// TODO: decouple tickMs and heartbeat tick (current heartbeat tick = 1).
 	// make ticks a cluster wide configuration.
@@ -246,6 +261,11 @@ type Config struct {
 	// If no time unit is provided and compaction mode is 'periodic',
 	// the unit defaults to hour. For example, '5' translates into 5-hour.
 	AutoCompactionRetention string `json:"auto-compaction-retention"`
+	// AutoCompactionPrefixRetention lists "<prefix>=<retention>" rules, which
+	// keep the history of the keys under prefix for a number of revisions
+	// (e.g. '1000') or for a duration (e.g. '72h'), regardless of the
+	// revision the store is compacted to.
+	AutoCompactionPrefixRetention []string `json:"auto-compaction-prefix-retention"`
 
 	// GRPCKeepAliveMinTime is the minimum interval that a client should
 	// wait before pinging server. When client pings "too fast", server
@@ -341,6 +361,21 @@ type Config struct {
 	// ExperimentalBootstrapDefragThresholdMegabytes is the minimum number of megabytes needed to be freed for etcd server to
 	// consider running defrag during bootstrap. Needs to be set to non-zero value to take effect.
 	ExperimentalBootstrapDefragThresholdMegabytes uint `json:"experimental-bootstrap-defrag-threshold-megabytes"`
+	// ExperimentalAutoDefragRatio is the minimum fraction of a member's backend size that must be freeable
+	// for the leader to defragment the member.
+	ExperimentalAutoDefragRatio float64 `json:"experimental-auto-defrag-ratio"`
+	// ExperimentalAutoDefragThresholdMegabytes is the minimum number of megabytes that must be freeable
+	// for the leader to defragment a member.
+	// Automatic defragmentation is enabled if either ExperimentalAutoDefragRatio or this is non-zero.
+	ExperimentalAutoDefragThresholdMegabytes uint `json:"experimental-auto-defrag-threshold-megabytes"`
+	// ExperimentalAutoDefragCheckTime is the duration of time between the leader checks of the members' fragmentation.
+	ExperimentalAutoDefragCheckTime time.Duration `json:"experimental-auto-defrag-check-time"`
+	// ExperimentalValueCompression is the name of the compressor for the values written to the backend.
+	// Values are stored raw if empty. Values are compressed once the cluster version is at least v3.6,
+	// after which the data cannot be downgraded below v3.6.
+	ExperimentalValueCompression string `json:"experimental-value-compression"`
+	// ExperimentalPeerCompression enables compressing raft messages and snapshots sent to peers that enable it as well.
+	ExperimentalPeerCompression bool `json:"experimental-peer-compression"`
 	// WarningUnaryRequestDuration is the time duration after which a warning is generated if applying
 	// unary request takes more time than this value.
 	WarningUnaryRequestDuration time.Duration `json:"warning-unary-request-duration"`
@@ -395,6 +430,23 @@ type Config struct {
 	// ZapLoggerBuilder is used to build the zap logger.
 	ZapLoggerBuilder func(*Config) error
 
+	// AuditLogOutput is where audit records of mutating and auth requests are
+	// written as JSON lines: "stdout", "stderr" or a file path to append to.
+	// Auditing is disabled if neither AuditLogOutput nor AuditLogger is set.
+	AuditLogOutput string `json:"audit-log-output"`
+	// EnableAuditLogRotation enables rotation of the AuditLogOutput file.
+	EnableAuditLogRotation bool `json:"enable-audit-log-rotation"`
+	// AuditLogRotationConfigJSON is a passthrough allowing an audit log rotation JSON config to be passed directly.
+	AuditLogRotationConfigJSON string `json:"audit-log-rotation-config-json"`
+	// AuditLogPrefixes limits audited key requests to those touching one of these key prefixes.
+	// Lease, auth and member requests are not affected.
+	AuditLogPrefixes []string `json:"audit-log-prefixes"`
+	// AuditLogVerbs limits auditing to these request verbs: put, delete, txn, lease, auth and member.
+	// All verbs are audited if empty.
+	AuditLogVerbs []string `json:"audit-log-verbs"`
+	// AuditLogger, if set, receives audit records instead of AuditLogOutput.
+	AuditLogger *zap.Logger `json:"-"`
+
 	// logger logs server-side operations. The default is nil,
 	// and "setupLogging" must be called before starting server.
 	// Do not set logger directly.
@@ -516,7 +568,9 @@ func NewConfig() *Config {
 		LogLevel:              logutil.DefaultLogLevel,
 		EnableLogRotation:     false,
 		LogRotationConfigJSON: DefaultLogRotationConfig,
-		EnableGRPCGateway:     true,
+
+		AuditLogRotationConfigJSON: DefaultLogRotationConfig,
+		EnableGRPCGateway:          true,
 
 		ExperimentalDowngradeCheckTime:           DefaultDowngradeCheckTime,
 		ExperimentalMemoryMlock:                  false,
@@ -526,6 +580,8 @@ func NewConfig() *Config {
 		ExperimentalCompactHashCheckEnabled: false,
 		ExperimentalCompactHashCheckTime:    time.Minute,
 
+		ExperimentalAutoDefragCheckTime: DefaultAutoDefragCheckTime,
+
 		V2Deprecation: config.V2_DEPR_DEFAULT,
 
 		DiscoveryCfg: v3discovery.DiscoveryConfig{
@@ -744,6 +800,9 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("unknown auto-compaction-mode %q", cfg.AutoCompactionMode)
 	}
+	if _, err := v3compactor.ParsePrefixRetention(cfg.AutoCompactionPrefixRetention); err != nil {
+		return err
+	}
 
 	// Validate distributed tracing configuration but only if enabled.
 	if cfg.ExperimentalEnableDistributedTracing {
@@ -752,6 +811,17 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if _, err := v3audit.ParseVerbs(cfg.AuditLogVerbs); err != nil {
+		return err
+	}
+	if cfg.EnableAuditLogRotation && (cfg.AuditLogOutput == "" || cfg.AuditLogOutput == v3audit.OutputStdout || cfg.AuditLogOutput == v3audit.OutputStderr) {
+		return ErrAuditLogRotationInvalidOutput
+	}
+
+	if cfg.WALArchiveRetention < 0 {
+		return ErrInvalidWALArchiveRetention
+	}
+
 	if !cfg.ExperimentalEnableLeaseCheckpointPersist && cfg.ExperimentalEnableLeaseCheckpoint {
 		cfg.logger.Warn("Detected that checkpointing is enabled without persistence. Consider enabling experimental-enable-lease-checkpoint-persist")
 	}
@@ -764,6 +834,18 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("--experimental-compact-hash-check-time must be >0 (set to %v)", cfg.ExperimentalCompactHashCheckTime)
 	}
 
+	if cfg.ExperimentalAutoDefragRatio < 0 || cfg.ExperimentalAutoDefragRatio >= 1 {
+		return fmt.Errorf("--experimental-auto-defrag-ratio must be >=0 and <1 (set to %v)", cfg.ExperimentalAutoDefragRatio)
+	}
+	if cfg.ExperimentalAutoDefragCheckTime <= 0 {
+		return fmt.Errorf("--experimental-auto-defrag-check-time must be >0 (set to %v)", cfg.ExperimentalAutoDefragCheckTime)
+	}
+	if cfg.ExperimentalValueCompression != "" {
+		if _, err := mvcc.ValueCompressorByName(cfg.ExperimentalValueCompression); err != nil {
+			return fmt.Errorf("--experimental-value-compression: %v", err)
+		}
+	}
+
 	// If `--name` isn't configured, then multiple members may have the same "default" name.
 	// When adding a new member with the "default" name as well, etcd may regards its peerURL
 	// as one additional peerURL of the existing member which has the same "default" name,
@@ -38,6 +38,8 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/verify"
 
@@ -71,6 +73,7 @@ type Etcd struct {
 	metricsListeners []net.Listener
 
 	tracingExporterShutdown func()
+	auditLogShutdown        func()
 
 	Server *etcdserver.EtcdServer
 
@@ -160,6 +163,11 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		return e, err
 	}
 
+	prefixRetention, err := v3compactor.ParsePrefixRetention(cfg.AutoCompactionPrefixRetention)
+	if err != nil {
+		return e, err
+	}
+
 	backendFreelistType := parseBackendFreelistType(cfg.BackendFreelistType)
 
 	srvcfg := config.ServerConfig{
@@ -172,6 +180,9 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		SnapshotCatchUpEntries:                   cfg.SnapshotCatchUpEntries,
 		MaxSnapFiles:                             cfg.MaxSnapFiles,
 		MaxWALFiles:                              cfg.MaxWalFiles,
+		WALArchiveDir:                            cfg.WALArchiveDir,
+		WALArchiveMaxFiles:                       cfg.WALArchiveMaxFiles,
+		WALArchiveRetention:                      cfg.WALArchiveRetention,
 		InitialPeerURLsMap:                       urlsmap,
 		InitialClusterToken:                      token,
 		DiscoveryURL:                             cfg.Durl,
@@ -185,6 +196,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		InitialElectionTickAdvance:               cfg.InitialElectionTickAdvance,
 		AutoCompactionRetention:                  autoCompactionRetention,
 		AutoCompactionMode:                       cfg.AutoCompactionMode,
+		CompactionPrefixRetention:                prefixRetention,
 		QuotaBackendBytes:                        cfg.QuotaBackendBytes,
 		BackendBatchLimit:                        cfg.BackendBatchLimit,
 		BackendFreelistType:                      backendFreelistType,
@@ -221,6 +233,11 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		ExperimentalMemoryMlock:                  cfg.ExperimentalMemoryMlock,
 		ExperimentalTxnModeWriteWithSharedBuffer: cfg.ExperimentalTxnModeWriteWithSharedBuffer,
 		ExperimentalBootstrapDefragThresholdMegabytes: cfg.ExperimentalBootstrapDefragThresholdMegabytes,
+		ExperimentalAutoDefragRatio:                   cfg.ExperimentalAutoDefragRatio,
+		ExperimentalAutoDefragThresholdMegabytes:      cfg.ExperimentalAutoDefragThresholdMegabytes,
+		ExperimentalAutoDefragCheckTime:               cfg.ExperimentalAutoDefragCheckTime,
+		ExperimentalValueCompression:                  cfg.ExperimentalValueCompression,
+		ExperimentalPeerCompression:                   cfg.ExperimentalPeerCompression,
 		ExperimentalMaxLearners:                       cfg.ExperimentalMaxLearners,
 		V2Deprecation:                                 cfg.V2DeprecationEffective(),
 	}
@@ -241,6 +258,10 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		)
 	}
 
+	if err = setupAuditLog(cfg, &srvcfg, e); err != nil {
+		return e, err
+	}
+
 	print(e.cfg.logger, *cfg, srvcfg, memberInitialized)
 
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
@@ -329,6 +350,7 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.Uint64("snapshot-count", sc.SnapshotCount),
 		zap.Uint("max-wals", sc.MaxWALFiles),
 		zap.Uint("max-snapshots", sc.MaxSnapFiles),
+		zap.String("wal-archive-dir", sc.WALArchiveDir),
 		zap.Uint64("snapshot-catchup-entries", sc.SnapshotCatchUpEntries),
 		zap.Strings("initial-advertise-peer-urls", ec.getAPURLs()),
 		zap.Strings("listen-peer-urls", ec.getLPURLs()),
@@ -352,6 +374,7 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
+		zap.Strings("auto-compaction-prefix-retention", ec.AutoCompactionPrefixRetention),
 		zap.String("discovery-url", sc.DiscoveryURL),
 		zap.String("discovery-proxy", sc.DiscoveryProxy),
 
@@ -441,6 +464,11 @@ func (e *Etcd) Close() {
 		e.Server.Stop()
 	}
 
+	// flush audit records of the requests served until now
+	if e.auditLogShutdown != nil {
+		e.auditLogShutdown()
+	}
+
 	// close all idle connections in peer handler (wait up to 1-second)
 	for i := range e.Peers {
 		if e.Peers[i] != nil && e.Peers[i].close != nil {
@@ -791,6 +819,44 @@ func (e *Etcd) GetLogger() *zap.Logger {
 	return l
 }
 
+// setupAuditLog configures where the server writes audit records, if anywhere.
+func setupAuditLog(cfg *Config, srvcfg *config.ServerConfig, e *Etcd) error {
+	lg := cfg.AuditLogger
+	if lg == nil {
+		if cfg.AuditLogOutput == "" {
+			return nil
+		}
+		var rotationJSON string
+		if cfg.EnableAuditLogRotation {
+			rotationJSON = cfg.AuditLogRotationConfigJSON
+		}
+		var (
+			closer io.Closer
+			err    error
+		)
+		lg, closer, err = v3audit.NewLogger(cfg.AuditLogOutput, rotationJSON)
+		if err != nil {
+			return err
+		}
+		e.auditLogShutdown = func() {
+			lg.Sync()
+			closer.Close()
+		}
+	}
+
+	srvcfg.AuditLogger = lg
+	srvcfg.AuditLogPrefixes = cfg.AuditLogPrefixes
+	srvcfg.AuditLogVerbs = cfg.AuditLogVerbs
+
+	e.cfg.logger.Info(
+		"audit log enabled",
+		zap.String("audit-log-output", cfg.AuditLogOutput),
+		zap.Strings("audit-log-prefixes", cfg.AuditLogPrefixes),
+		zap.Strings("audit-log-verbs", cfg.AuditLogVerbs),
+	)
+	return nil
+}
+
 func parseCompactionRetention(mode, retention string) (ret time.Duration, err error) {
 	h, err := strconv.Atoi(retention)
 	if err == nil && h >= 0 {
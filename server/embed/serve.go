@@ -40,6 +40,9 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
 	v3lockgw "go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb/gw"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+	v3semaphoregw "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb/gw"
 
 	gw "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/soheilhy/cmux"
@@ -113,6 +116,7 @@ func (sctx *serveCtx) serve(
 	v3c := v3client.New(s)
 	servElection := v3election.NewElectionServer(v3c)
 	servLock := v3lock.NewLockServer(v3c)
+	servSemaphore := v3semaphore.NewSemaphoreServer(v3c)
 
 	var gs *grpc.Server
 	defer func() {
@@ -130,6 +134,7 @@ func (sctx *serveCtx) serve(
 		gs = v3rpc.Server(s, nil, nil, gopts...)
 		v3electionpb.RegisterElectionServer(gs, servElection)
 		v3lockpb.RegisterLockServer(gs, servLock)
+		v3semaphorepb.RegisterSemaphoreServer(gs, servSemaphore)
 		if sctx.serviceRegister != nil {
 			sctx.serviceRegister(gs)
 		}
@@ -173,6 +178,7 @@ func (sctx *serveCtx) serve(
 		gs = v3rpc.Server(s, tlscfg, nil, gopts...)
 		v3electionpb.RegisterElectionServer(gs, servElection)
 		v3lockpb.RegisterLockServer(gs, servLock)
+		v3semaphorepb.RegisterSemaphoreServer(gs, servSemaphore)
 		if sctx.serviceRegister != nil {
 			sctx.serviceRegister(gs)
 		}
@@ -275,6 +281,7 @@ func (sctx *serveCtx) registerGateway(opts []grpc.DialOption) (*gw.ServeMux, err
 		etcdservergw.RegisterAuthHandler,
 		v3lockgw.RegisterLockHandler,
 		v3electiongw.RegisterElectionHandler,
+		v3semaphoregw.RegisterSemaphoreHandler,
 	}
 	for _, h := range handlers {
 		if err := h(ctx, gwmux, conn); err != nil {
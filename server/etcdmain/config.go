@@ -123,6 +123,9 @@ func newConfig() *config {
 	)
 	fs.UintVar(&cfg.ec.MaxSnapFiles, "max-snapshots", cfg.ec.MaxSnapFiles, "Maximum number of snapshot files to retain (0 is unlimited).")
 	fs.UintVar(&cfg.ec.MaxWalFiles, "max-wals", cfg.ec.MaxWalFiles, "Maximum number of wal files to retain (0 is unlimited).")
+	fs.StringVar(&cfg.ec.WALArchiveDir, "wal-archive-dir", cfg.ec.WALArchiveDir, "Path to a directory WAL and snapshot files are archived to before being purged. Disabled if empty.")
+	fs.UintVar(&cfg.ec.WALArchiveMaxFiles, "wal-archive-max-files", cfg.ec.WALArchiveMaxFiles, "Maximum number of archived files of each kind to retain (0 is unlimited).")
+	fs.DurationVar(&cfg.ec.WALArchiveRetention, "wal-archive-retention", cfg.ec.WALArchiveRetention, "Maximum age of archived files to retain (0 is unlimited).")
 	fs.StringVar(&cfg.ec.Name, "name", cfg.ec.Name, "Human-readable name for this member.")
 	fs.Uint64Var(&cfg.ec.SnapshotCount, "snapshot-count", cfg.ec.SnapshotCount, "Number of committed transactions to trigger a snapshot to disk.")
 	fs.UintVar(&cfg.ec.TickMs, "heartbeat-interval", cfg.ec.TickMs, "Time (in milliseconds) of a heartbeat interval.")
@@ -231,10 +234,18 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.ec.EnableLogRotation, "enable-log-rotation", false, "Enable log rotation of a single log-outputs file target.")
 	fs.StringVar(&cfg.ec.LogRotationConfigJSON, "log-rotation-config-json", embed.DefaultLogRotationConfig, "Configures log rotation if enabled with a JSON logger config. Default: MaxSize=100(MB), MaxAge=0(days,no limit), MaxBackups=0(no limit), LocalTime=false(UTC), Compress=false(gzip)")
 
+	// audit logging
+	fs.StringVar(&cfg.ec.AuditLogOutput, "audit-log-output", "", "Record mutating and auth requests as JSON lines to 'stdout', 'stderr' or a file path. Disabled if empty.")
+	fs.BoolVar(&cfg.ec.EnableAuditLogRotation, "enable-audit-log-rotation", false, "Enable rotation of the audit-log-output file.")
+	fs.StringVar(&cfg.ec.AuditLogRotationConfigJSON, "audit-log-rotation-config-json", embed.DefaultLogRotationConfig, "Configures audit log rotation if enabled with a JSON logger config. Default: MaxSize=100(MB), MaxAge=0(days,no limit), MaxBackups=0(no limit), LocalTime=false(UTC), Compress=false(gzip)")
+	fs.Var(flags.NewUniqueStringsValue(""), "audit-log-prefixes", "Comma-separated key prefixes; only key requests touching one of them are audited (empty means all keys).")
+	fs.Var(flags.NewUniqueStringsValue(""), "audit-log-verbs", "Comma-separated request verbs to audit: put, delete, txn, lease, auth, member (empty means all).")
+
 	// version
 	fs.BoolVar(&cfg.printVersion, "version", false, "Print the version and exit.")
 
 	fs.StringVar(&cfg.ec.AutoCompactionRetention, "auto-compaction-retention", "0", "Auto compaction retention for mvcc key value store. 0 means disable auto compaction.")
+	fs.Var(flags.NewStringsValue(""), "auto-compaction-prefix-retention", "Comma-separated list of <prefix>=<retention> rules keeping the history of the keys under prefix for a number of revisions (e.g. '1000') or a duration (e.g. '72h') when compacting.")
 	fs.StringVar(&cfg.ec.AutoCompactionMode, "auto-compaction-mode", "periodic", "interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.")
 
 	// pprof profiler via HTTP
@@ -277,6 +288,11 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.ec.ExperimentalMemoryMlock, "experimental-memory-mlock", cfg.ec.ExperimentalMemoryMlock, "Enable to enforce etcd pages (in particular bbolt) to stay in RAM.")
 	fs.BoolVar(&cfg.ec.ExperimentalTxnModeWriteWithSharedBuffer, "experimental-txn-mode-write-with-shared-buffer", true, "Enable the write transaction to use a shared buffer in its readonly check operations.")
 	fs.UintVar(&cfg.ec.ExperimentalBootstrapDefragThresholdMegabytes, "experimental-bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
+	fs.Float64Var(&cfg.ec.ExperimentalAutoDefragRatio, "experimental-auto-defrag-ratio", 0, "Enable the leader to defragment members, one at a time, on condition that it will free at least the provided fraction of their database size.")
+	fs.UintVar(&cfg.ec.ExperimentalAutoDefragThresholdMegabytes, "experimental-auto-defrag-threshold-megabytes", 0, "Enable the leader to defragment members, one at a time, on condition that it will free at least the provided threshold of disk space.")
+	fs.DurationVar(&cfg.ec.ExperimentalAutoDefragCheckTime, "experimental-auto-defrag-check-time", cfg.ec.ExperimentalAutoDefragCheckTime, "Duration of time between leader checks of members fragmentation.")
+	fs.StringVar(&cfg.ec.ExperimentalValueCompression, "experimental-value-compression", "", "Compressor for the values written to the backend ('flate', 'snappy' or 'zstd'), or empty to store them raw. Values are compressed once the cluster version is at least v3.6, after which the data cannot be downgraded below v3.6.")
+	fs.BoolVar(&cfg.ec.ExperimentalPeerCompression, "experimental-peer-compression", false, "Enable compressing raft messages and snapshots sent to peers that enable it as well.")
 	fs.IntVar(&cfg.ec.ExperimentalMaxLearners, "experimental-max-learners", membership.DefaultMaxLearners, "Sets the maximum number of learners that can be available in the cluster membership.")
 	fs.DurationVar(&cfg.ec.ExperimentalWaitClusterReadyTimeout, "experimental-wait-cluster-ready-timeout", cfg.ec.ExperimentalWaitClusterReadyTimeout, "Maximum duration to wait for the cluster to be ready.")
 	fs.Uint64Var(&cfg.ec.SnapshotCatchUpEntries, "experimental-snapshot-catchup-entries", cfg.ec.SnapshotCatchUpEntries, "Number of entries for a slow follower to catch up after compacting the the raft storage entries.")
@@ -392,10 +408,13 @@ func (cfg *config) configFromCmdLine() error {
 	cfg.ec.HostWhitelist = flags.UniqueStringsMapFromFlag(cfg.cf.flagSet, "host-whitelist")
 
 	cfg.ec.CipherSuites = flags.StringsFromFlag(cfg.cf.flagSet, "cipher-suites")
+	cfg.ec.AutoCompactionPrefixRetention = flags.StringsFromFlag(cfg.cf.flagSet, "auto-compaction-prefix-retention")
 
 	cfg.ec.MaxConcurrentStreams = flags.Uint32FromFlag(cfg.cf.flagSet, "max-concurrent-streams")
 
 	cfg.ec.LogOutputs = flags.UniqueStringsFromFlag(cfg.cf.flagSet, "log-outputs")
+	cfg.ec.AuditLogPrefixes = flags.UniqueStringsFromFlag(cfg.cf.flagSet, "audit-log-prefixes")
+	cfg.ec.AuditLogVerbs = flags.UniqueStringsFromFlag(cfg.cf.flagSet, "audit-log-verbs")
 
 	cfg.ec.ClusterState = cfg.cf.clusterState.String()
 
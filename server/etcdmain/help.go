@@ -70,6 +70,12 @@ Member:
     Maximum number of snapshot files to retain (0 is unlimited).
   --max-wals '` + strconv.Itoa(embed.DefaultMaxWALs) + `'
     Maximum number of wal files to retain (0 is unlimited).
+  --wal-archive-dir ''
+    Path to a directory WAL and snapshot files are archived to before being purged. Disabled if empty.
+  --wal-archive-max-files '0'
+    Maximum number of archived files of each kind to retain (0 is unlimited).
+  --wal-archive-retention '0s'
+    Maximum age of archived files to retain (0 is unlimited).
   --quota-backend-bytes '0'
     Raise alarms when backend size exceeds the given quota (0 defaults to low space quota).
   --backend-bbolt-freelist-type 'map'
@@ -152,6 +158,8 @@ Clustering:
     Auto compaction retention length. 0 means disable auto compaction.
   --auto-compaction-mode 'periodic'
     Interpret 'auto-compaction-retention' one of: periodic|revision. 'periodic' for duration based retention, defaulting to hours if no time unit is provided (e.g. '5m'). 'revision' for revision number based retention.
+  --auto-compaction-prefix-retention ''
+    Comma-separated list of <prefix>=<retention> rules keeping the history of the keys under prefix for a number of revisions (e.g. '1000') or a duration (e.g. '72h') when compacting.
   --v2-deprecation '` + string(cconfig.V2_DEPR_DEFAULT) + `'
     Phase of v2store deprecation. Allows to opt-in for higher compatibility mode.
     Supported values:
@@ -202,7 +210,9 @@ Security:
 
 Auth:
   --auth-token 'simple'
-    Specify a v3 authentication token type and its options ('simple' or 'jwt').
+    Specify a v3 authentication token type and its options ('simple', 'jwt' or 'oidc').
+    'oidc' verifies externally issued tokens against a JWKS file (reloaded on SIGHUP), e.g.
+    'oidc,jwks-file=/path/to/jwks.json,issuer=https://sso.example.com,audience=etcd,claim=sub,claim-type=user'.
   --bcrypt-cost ` + fmt.Sprintf("%d", bcrypt.DefaultCost) + `
     Specify the cost / strength of the bcrypt algorithm for hashing auth passwords. Valid values are between ` + fmt.Sprintf("%d", bcrypt.MinCost) + ` and ` + fmt.Sprintf("%d", bcrypt.MaxCost) + `.
   --auth-token-ttl 300
@@ -232,6 +242,18 @@ Logging:
   --warning-unary-request-duration '300ms'
     Set time duration after which a warning is logged if a unary request takes more than this duration.
 
+Audit logging:
+  --audit-log-output ''
+    Record mutating and auth requests as JSON lines to 'stdout', 'stderr' or a file path. Disabled if empty.
+  --enable-audit-log-rotation 'false'
+    Enable rotation of the audit-log-output file.
+  --audit-log-rotation-config-json '{"maxsize": 100, "maxage": 0, "maxbackups": 0, "localtime": false, "compress": false}'
+    Configures audit log rotation if enabled with a JSON logger config. MaxSize(MB), MaxAge(days,0=no limit), MaxBackups(0=no limit), LocalTime(use computers local time), Compress(gzip).
+  --audit-log-prefixes ''
+    Comma-separated key prefixes; only key requests touching one of them are audited (empty means all keys).
+  --audit-log-verbs ''
+    Comma-separated request verbs to audit: put, delete, txn, lease, auth, member (empty means all).
+
 Experimental distributed tracing:
   --experimental-enable-distributed-tracing 'false'
     Enable experimental distributed tracing.
@@ -263,6 +285,16 @@ Experimental feature:
     Enable the write transaction to use a shared buffer in its readonly check operations.
   --experimental-bootstrap-defrag-threshold-megabytes
     Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.
+  --experimental-auto-defrag-ratio
+    Enable the leader to defragment members, one at a time, on condition that it will free at least the provided fraction of their database size.
+  --experimental-auto-defrag-threshold-megabytes
+    Enable the leader to defragment members, one at a time, on condition that it will free at least the provided threshold of disk space.
+  --experimental-auto-defrag-check-time '10m'
+    Duration of time between leader checks of members fragmentation.
+  --experimental-value-compression ''
+    Compressor for the values written to the backend ('flate', 'snappy' or 'zstd'), or empty to store them raw. Values are compressed once the cluster version is at least v3.6, after which the data cannot be downgraded below v3.6.
+  --experimental-peer-compression 'false'
+    Enable compressing raft messages and snapshots sent to peers that enable it as well.
   --experimental-warning-unary-request-duration '300ms'
     Set time duration after which a warning is generated if a unary request takes more than this duration. It's deprecated, and will be decommissioned in v3.7. Use --warning-unary-request-duration instead.
   --experimental-max-learners '1'
@@ -0,0 +1,187 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"compress/flate"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// compressionFlate compresses with DEFLATE at the fastest level.
+	compressionFlate = "flate"
+	// compressionSnappy compresses with the Snappy framing format, which is
+	// fast enough for the latency of raft messages.
+	compressionSnappy = "snappy"
+	// compressionZstd compresses with Zstandard, which compresses the bulk
+	// data of snapshots better.
+	compressionZstd = "zstd"
+
+	// acceptCompressionHeader lists the codecs a member can decompress. Stream
+	// readers send it in their requests and stream handlers in their
+	// responses.
+	acceptCompressionHeader = "X-Raft-Accept-Compression"
+	// compressionHeader names the codec that compresses a stream response or
+	// a snapshot request.
+	compressionHeader = "X-Raft-Compression"
+)
+
+var (
+	// supportedCompressions lists the codecs that can be decompressed.
+	supportedCompressions = []string{compressionSnappy, compressionZstd, compressionFlate}
+	// streamCompressions lists the codecs for streams in order of preference.
+	streamCompressions = []string{compressionSnappy, compressionFlate}
+	// snapshotCompressions lists the codecs for snapshots in order of
+	// preference.
+	snapshotCompressions = []string{compressionZstd, compressionFlate}
+)
+
+// negotiateCompression returns the first codec of preferred listed in
+// accepted, a comma separated list of codecs, or "" if there is none.
+func negotiateCompression(preferred []string, accepted string) string {
+	for _, c := range preferred {
+		for _, a := range strings.Split(accepted, ",") {
+			if strings.TrimSpace(a) == c {
+				return c
+			}
+		}
+	}
+	return ""
+}
+
+// flushWriteCloser is a compressing writer.
+type flushWriteCloser interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// newCompressor returns a writer compressing to w with the codec.
+func newCompressor(w io.Writer, codec string) (flushWriteCloser, error) {
+	switch codec {
+	case compressionFlate:
+		return flate.NewWriter(w, flate.BestSpeed)
+	case compressionSnappy:
+		return s2.NewWriter(w, s2.WriterSnappyCompat(), s2.WriterConcurrency(1)), nil
+	case compressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", codec)
+	}
+}
+
+// compressWriter compresses the messages written to a peer stream. Flush
+// flushes the compressed data through to the underlying flusher, so that
+// messages are not held back in the compressor.
+type compressWriter struct {
+	cw      flushWriteCloser
+	flusher http.Flusher
+
+	uncompressed prometheus.Counter
+}
+
+func newCompressWriter(w io.Writer, flusher http.Flusher, to, codec string) (*compressWriter, error) {
+	cw, err := newCompressor(&countingWriter{w: w, c: sentCompressedBytes.WithLabelValues(to)}, codec)
+	if err != nil {
+		return nil, err
+	}
+	return &compressWriter{
+		cw:           cw,
+		flusher:      flusher,
+		uncompressed: sentUncompressedBytes.WithLabelValues(to),
+	}, nil
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	n, err := cw.cw.Write(p)
+	cw.uncompressed.Add(float64(n))
+	return n, err
+}
+
+func (cw *compressWriter) Flush() {
+	if err := cw.cw.Flush(); err != nil {
+		// the error surfaces on the next write
+		return
+	}
+	cw.flusher.Flush()
+}
+
+// newCompressReader returns a reader that compresses r with the codec for
+// sending to the given peer.
+func newCompressReader(r io.Reader, to, codec string) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	cw, err := newCompressor(&countingWriter{w: pw, c: sentCompressedBytes.WithLabelValues(to)}, codec)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		n, err := io.Copy(cw, r)
+		sentUncompressedBytes.WithLabelValues(to).Add(float64(n))
+		if cerr := cw.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, nil
+}
+
+// decompressReadCloser decompresses the data read from a peer and closes
+// the underlying reader on Close.
+type decompressReadCloser struct {
+	io.ReadCloser
+	rc io.Closer
+}
+
+// newDecompressReadCloser returns a reader decompressing rc with the codec.
+func newDecompressReadCloser(rc io.ReadCloser, codec string) (io.ReadCloser, error) {
+	var r io.ReadCloser
+	switch codec {
+	case compressionFlate:
+		r = flate.NewReader(rc)
+	case compressionSnappy:
+		r = io.NopCloser(s2.NewReader(rc))
+	case compressionZstd:
+		dec, err := zstd.NewReader(rc, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		r = dec.IOReadCloser()
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", codec)
+	}
+	return &decompressReadCloser{ReadCloser: r, rc: rc}, nil
+}
+
+func (d *decompressReadCloser) Close() error {
+	d.ReadCloser.Close()
+	return d.rc.Close()
+}
+
+type countingWriter struct {
+	w io.Writer
+	c prometheus.Counter
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.c.Add(float64(n))
+	return n, err
+}
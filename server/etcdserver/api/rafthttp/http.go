@@ -216,7 +216,36 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	addRemoteFromRequest(h.tr, r)
 
-	dec := &messageDecoder{r: r.Body}
+	body := io.Reader(r.Body)
+	switch c := r.Header.Get(compressionHeader); c {
+	case "":
+	case compressionSnappy, compressionZstd, compressionFlate:
+		rc, err := newDecompressReadCloser(r.Body, c)
+		if err != nil {
+			h.lg.Warn(
+				"failed to decompress snapshot",
+				zap.String("local-member-id", h.localID.String()),
+				zap.String("compression", c),
+				zap.Error(err),
+			)
+			http.Error(w, "error decompressing snapshot", http.StatusBadRequest)
+			snapshotReceiveFailures.WithLabelValues(unknownSnapshotSender).Inc()
+			return
+		}
+		defer rc.Close()
+		body = rc
+	default:
+		h.lg.Warn(
+			"unsupported snapshot compression",
+			zap.String("local-member-id", h.localID.String()),
+			zap.String("compression", c),
+		)
+		http.Error(w, "unsupported compression", http.StatusBadRequest)
+		snapshotReceiveFailures.WithLabelValues(unknownSnapshotSender).Inc()
+		return
+	}
+
+	dec := &messageDecoder{r: body}
 	// let snapshots be very large since they can exceed 512MB for large installations
 	m, err := dec.decodeLimit(snapshotLimitByte)
 	from := types.ID(m.From).String()
@@ -265,7 +294,7 @@ func (h *snapshotHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// save incoming database snapshot.
 
-	n, err := h.snapshotter.SaveDBFrom(r.Body, m.Snapshot.Metadata.Index)
+	n, err := h.snapshotter.SaveDBFrom(body, m.Snapshot.Metadata.Index)
 	if err != nil {
 		msg := fmt.Sprintf("failed to save KV snapshot (%v)", err)
 		h.lg.Warn(
@@ -435,6 +464,14 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var compression string
+	if h.tr.Compression {
+		compression = negotiateCompression(streamCompressions, r.Header.Get(acceptCompressionHeader))
+		w.Header().Set(acceptCompressionHeader, strings.Join(supportedCompressions, ","))
+	}
+	if compression != "" {
+		w.Header().Set(compressionHeader, compression)
+	}
 	w.WriteHeader(http.StatusOK)
 	w.(http.Flusher).Flush()
 
@@ -447,6 +484,20 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		localID: h.tr.ID,
 		peerID:  from,
 	}
+	if compression != "" {
+		cw, err := newCompressWriter(w, w.(http.Flusher), from.String(), compression)
+		if err != nil {
+			h.lg.Warn(
+				"failed to create stream compressor",
+				zap.String("local-member-id", h.tr.ID.String()),
+				zap.String("remote-peer-id", from.String()),
+				zap.String("compression", compression),
+				zap.Error(err),
+			)
+			return
+		}
+		conn.Writer, conn.Flusher = cw, cw
+	}
 	p.attachOutgoingConn(conn)
 	<-c.closeNotify()
 }
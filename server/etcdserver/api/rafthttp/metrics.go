@@ -53,6 +53,24 @@ var (
 		[]string{"From"},
 	)
 
+	sentUncompressedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "peer_sent_uncompressed_bytes_total",
+		Help:      "The total number of bytes sent to peers in compressed streams and snapshots, before compression.",
+	},
+		[]string{"To"},
+	)
+
+	sentCompressedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "network",
+		Name:      "peer_sent_compressed_bytes_total",
+		Help:      "The total number of bytes sent to peers in compressed streams and snapshots, after compression.",
+	},
+		[]string{"To"},
+	)
+
 	sentFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "network",
@@ -170,6 +188,8 @@ func init() {
 	prometheus.MustRegister(disconnectedPeers)
 	prometheus.MustRegister(sentBytes)
 	prometheus.MustRegister(receivedBytes)
+	prometheus.MustRegister(sentUncompressedBytes)
+	prometheus.MustRegister(sentCompressedBytes)
 	prometheus.MustRegister(sentFailures)
 	prometheus.MustRegister(recvFailures)
 
@@ -37,6 +37,9 @@ type peerStatus struct {
 	mu     sync.Mutex // protect variables below
 	active bool
 	since  time.Time
+	// compressions lists the codecs the peer accepted on the last stream
+	// connection, or is "" if it did not accept compression.
+	compressions string
 }
 
 func newPeerStatus(lg *zap.Logger, local, id types.ID) *peerStatus {
@@ -88,3 +91,15 @@ func (s *peerStatus) activeSince() time.Time {
 	defer s.mu.Unlock()
 	return s.since
 }
+
+func (s *peerStatus) setCompressions(c string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.compressions = c
+}
+
+func (s *peerStatus) getCompressions() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compressions
+}
@@ -74,8 +74,34 @@ func (s *snapshotSender) send(merged snap.Message) {
 	body := createSnapBody(s.tr.Logger, merged)
 	defer body.Close()
 
+	var compression string
+	if s.tr.Compression {
+		compression = negotiateCompression(snapshotCompressions, s.status.getCompressions())
+	}
+	reqBody := io.Reader(body)
+	if compression != "" {
+		cr, err := newCompressReader(body, to, compression)
+		if err != nil {
+			if s.tr.Logger != nil {
+				s.tr.Logger.Warn(
+					"failed to create snapshot compressor",
+					zap.String("remote-peer-id", to),
+					zap.String("compression", compression),
+					zap.Error(err),
+				)
+			}
+			compression = ""
+		} else {
+			defer cr.Close()
+			reqBody = cr
+		}
+	}
+
 	u := s.picker.pick()
-	req := createPostRequest(s.tr.Logger, u, RaftSnapshotPrefix, body, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+	req := createPostRequest(s.tr.Logger, u, RaftSnapshotPrefix, reqBody, "application/octet-stream", s.tr.URLs, s.from, s.cid)
+	if compression != "" {
+		req.Header.Set(compressionHeader, compression)
+	}
 
 	snapshotSizeVal := uint64(merged.TotalSize)
 	snapshotSize := humanize.Bytes(snapshotSizeVal)
@@ -86,6 +112,7 @@ func (s *snapshotSender) send(merged snap.Message) {
 			zap.String("remote-peer-id", to),
 			zap.Uint64("bytes", snapshotSizeVal),
 			zap.String("size", snapshotSize),
+			zap.String("compression", compression),
 		)
 	}
 
@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -126,6 +127,75 @@ func testSnapshotSend(t *testing.T, sm *snap.Message) (bool, []os.DirEntry) {
 	return sent, files
 }
 
+func TestSnapshotSendCompressed(t *testing.T) {
+	tests := []struct {
+		accepted     string
+		wcompression string
+	}{
+		{strings.Join(supportedCompressions, ","), compressionZstd},
+		{compressionZstd, compressionZstd},
+		{compressionFlate, compressionFlate},
+		{compressionSnappy + "," + compressionFlate, compressionFlate},
+		{compressionSnappy, ""},
+		{"", ""},
+	}
+	for i, tt := range tests {
+		testSnapshotSendCompressed(t, i, tt.accepted, tt.wcompression)
+	}
+}
+
+func testSnapshotSendCompressed(t *testing.T, i int, accepted, wcompression string) {
+	d := t.TempDir()
+	data := strings.Repeat("hello", 1000)
+	sm := snap.NewMessage(raftpb.Message{Type: raftpb.MsgSnap, To: 1, Snapshot: &raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Index: 1}}}, strReaderCloser{strings.NewReader(data)}, int64(len(data)))
+
+	r := &fakeRaft{}
+	tr := &Transport{pipelineRt: &http.Transport{}, ClusterID: types.ID(1), Raft: r, Compression: true}
+	ch := make(chan struct{}, 1)
+	var compression string
+	h := &syncHandler{http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		compression = req.Header.Get(compressionHeader)
+		newSnapshotHandler(tr, r, snap.New(zaptest.NewLogger(t), d), types.ID(1)).ServeHTTP(w, req)
+	}), ch}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	picker := mustNewURLPicker(t, []string{srv.URL})
+	status := newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1))
+	status.setCompressions(accepted)
+	snapsend := newSnapshotSender(tr, picker, types.ID(1), status)
+	defer snapsend.stop()
+
+	snapsend.send(*sm)
+	select {
+	case <-time.After(time.Second):
+		t.Fatalf("#%d: timed out sending snapshot", i)
+	case sent := <-sm.CloseNotify():
+		if !sent {
+			t.Fatalf("#%d: failed to send snapshot", i)
+		}
+	}
+	<-ch
+
+	if compression != wcompression {
+		t.Errorf("#%d: compression = %q, want %q", i, compression, wcompression)
+	}
+	files, err := os.ReadDir(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("#%d: expected 1 file, got %d files", i, len(files))
+	}
+	got, err := os.ReadFile(filepath.Join(d, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != data {
+		t.Errorf("#%d: saved snapshot has %d bytes, want %d", i, len(got), len(data))
+	}
+}
+
 type errReadCloser struct{ err error }
 
 func (s *errReadCloser) Read(p []byte) (int, error) { return 0, s.err }
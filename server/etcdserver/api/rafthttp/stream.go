@@ -584,6 +584,9 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 	req.Header.Set("X-Min-Cluster-Version", version.MinClusterVersion)
 	req.Header.Set("X-Etcd-Cluster-ID", cr.tr.ClusterID.String())
 	req.Header.Set("X-Raft-To", cr.peerID.String())
+	if cr.tr.Compression {
+		req.Header.Set(acceptCompressionHeader, strings.Join(supportedCompressions, ","))
+	}
 
 	setPeerURLsHeader(req, cr.tr.URLs)
 
@@ -620,7 +623,22 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 		return nil, errMemberRemoved
 
 	case http.StatusOK:
-		return resp.Body, nil
+		c := resp.Header.Get(compressionHeader)
+		accepted := resp.Header.Get(acceptCompressionHeader)
+		if accepted == "" {
+			accepted = c
+		}
+		cr.status.setCompressions(accepted)
+		if c == "" {
+			return resp.Body, nil
+		}
+		rc, err := newDecompressReadCloser(resp.Body, c)
+		if err != nil {
+			httputil.GracefulClose(resp)
+			cr.picker.unreachable(u)
+			return nil, err
+		}
+		return rc, nil
 
 	case http.StatusNotFound:
 		httputil.GracefulClose(resp)
@@ -171,6 +171,7 @@ func TestStreamReaderDialResult(t *testing.T) {
 			peerID: types.ID(2),
 			tr:     &Transport{streamRt: tr, ClusterID: types.ID(1)},
 			picker: mustNewURLPicker(t, []string{"http://localhost:2380"}),
+			status: newPeerStatus(zaptest.NewLogger(t), types.ID(1), types.ID(2)),
 			errorc: make(chan error, 1),
 			ctx:    context.Background(),
 		}
@@ -300,51 +301,61 @@ func TestStream(t *testing.T) {
 		},
 	}
 	for i, tt := range tests {
-		h := &fakeStreamHandler{t: tt.t}
-		srv := httptest.NewServer(h)
-		defer srv.Close()
+		for _, compression := range []string{"", compressionSnappy, compressionFlate} {
+			testStream(t, i, tt.t, tt.m, tt.wc, recvc, propc, compression)
+		}
+	}
+}
 
-		sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)), &stats.FollowerStats{}, &fakeRaft{})
-		defer sw.stop()
-		h.sw = sw
+func testStream(t *testing.T, i int, typ streamType, wm raftpb.Message, wc, recvc, propc chan raftpb.Message, compression string) {
+	h := &fakeStreamHandler{t: typ, compression: compression}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
 
-		picker := mustNewURLPicker(t, []string{srv.URL})
-		tr := &Transport{streamRt: &http.Transport{}, ClusterID: types.ID(1)}
+	sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)), &stats.FollowerStats{}, &fakeRaft{})
+	defer sw.stop()
+	h.sw = sw
 
-		sr := &streamReader{
-			peerID: types.ID(2),
-			typ:    tt.t,
-			tr:     tr,
-			picker: picker,
-			status: newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(2)),
-			recvc:  recvc,
-			propc:  propc,
-			rl:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
-		}
-		sr.start()
-
-		// wait for stream to work
-		var writec chan<- raftpb.Message
-		for {
-			var ok bool
-			if writec, ok = sw.writec(); ok {
-				break
-			}
-			time.Sleep(time.Millisecond)
-		}
+	picker := mustNewURLPicker(t, []string{srv.URL})
+	tr := &Transport{streamRt: &http.Transport{}, ClusterID: types.ID(1), Compression: compression != ""}
 
-		writec <- tt.m
-		var m raftpb.Message
-		select {
-		case m = <-tt.wc:
-		case <-time.After(time.Second):
-			t.Fatalf("#%d: failed to receive message from the channel", i)
-		}
-		if !reflect.DeepEqual(m, tt.m) {
-			t.Fatalf("#%d: message = %+v, want %+v", i, m, tt.m)
+	sr := &streamReader{
+		peerID: types.ID(2),
+		typ:    typ,
+		tr:     tr,
+		picker: picker,
+		status: newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(2)),
+		recvc:  recvc,
+		propc:  propc,
+		rl:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
+	}
+	sr.start()
+
+	// wait for stream to work
+	var writec chan<- raftpb.Message
+	for {
+		var ok bool
+		if writec, ok = sw.writec(); ok {
+			break
 		}
+		time.Sleep(time.Millisecond)
+	}
 
-		sr.stop()
+	writec <- wm
+	var m raftpb.Message
+	select {
+	case m = <-wc:
+	case <-time.After(time.Second):
+		t.Fatalf("#%d: failed to receive message from the channel", i)
+	}
+	if !reflect.DeepEqual(m, wm) {
+		t.Fatalf("#%d: message = %+v, want %+v", i, m, wm)
+	}
+
+	sr.stop()
+
+	if c := sr.status.getCompressions(); c != compression {
+		t.Errorf("#%d: compressions = %q, want %q", i, c, compression)
 	}
 }
 
@@ -440,17 +451,35 @@ func (wfc *fakeWriteFlushCloser) Closed() bool {
 type fakeStreamHandler struct {
 	t  streamType
 	sw *streamWriter
+	// compression is the only codec the handler offers and accepts,
+	// or "" to disable compression.
+	compression string
 }
 
 func (h *fakeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("X-Server-Version", version.Version)
+	var compression string
+	if h.compression != "" {
+		compression = negotiateCompression([]string{h.compression}, r.Header.Get(acceptCompressionHeader))
+		w.Header().Set(acceptCompressionHeader, h.compression)
+		w.Header().Set(compressionHeader, compression)
+	}
 	w.(http.Flusher).Flush()
 	c := newCloseNotifier()
-	h.sw.attach(&outgoingConn{
+	conn := &outgoingConn{
 		t:       h.t,
 		Writer:  w,
 		Flusher: w.(http.Flusher),
 		Closer:  c,
-	})
+	}
+	if compression != "" {
+		cw, err := newCompressWriter(w, w.(http.Flusher), "test", compression)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		conn.Writer, conn.Flusher = cw, cw
+	}
+	h.sw.attach(conn)
 	<-c.closeNotify()
 }
@@ -0,0 +1,356 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3audit records an audit trail of mutating and auth requests served by etcd.
+package v3audit
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Verb classifies an audited request.
+type Verb string
+
+const (
+	VerbPut    Verb = "put"
+	VerbDelete Verb = "delete"
+	VerbTxn    Verb = "txn"
+	VerbLease  Verb = "lease"
+	VerbAuth   Verb = "auth"
+	VerbMember Verb = "member"
+)
+
+// Verbs lists all verbs that can be audited.
+var Verbs = []Verb{VerbPut, VerbDelete, VerbTxn, VerbLease, VerbAuth, VerbMember}
+
+// ParseVerbs converts verb names into verbs, rejecting unknown ones.
+func ParseVerbs(names []string) ([]Verb, error) {
+	verbs := make([]Verb, 0, len(names))
+	for _, name := range names {
+		v := Verb(strings.ToLower(strings.TrimSpace(name)))
+		if !isKnownVerb(v) {
+			return nil, fmt.Errorf("unknown audit verb %q (expected one of %v)", name, Verbs)
+		}
+		verbs = append(verbs, v)
+	}
+	return verbs, nil
+}
+
+func isKnownVerb(v Verb) bool {
+	for _, known := range Verbs {
+		if v == known {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyRange is a key or key range touched by a request. An empty RangeEnd
+// means the single key Key.
+type KeyRange struct {
+	Key      []byte
+	RangeEnd []byte
+}
+
+// Event is a single audit record.
+type Event struct {
+	// User is the authenticated user name, empty if auth is disabled or the
+	// request carried no token.
+	User string
+	// Remote is the address of the client.
+	Remote string
+	// Method is the full gRPC method name.
+	Method string
+	Verb   Verb
+	Keys   []KeyRange
+	// Target names the object of a lease, auth or member request.
+	Target string
+	// Revision is the store revision in the response header, zero on failure.
+	Revision int64
+	Err      error
+}
+
+// Auditor writes audit records of the requests selected by its filters.
+type Auditor struct {
+	lg       *zap.Logger
+	prefixes [][]byte
+	verbs    map[Verb]struct{}
+}
+
+// NewAuditor creates an Auditor writing records to lg. Only requests with
+// one of the given verbs are audited, or all verbs if none are given.
+// Key requests are further limited to those touching one of the given key
+// prefixes, if any.
+func NewAuditor(lg *zap.Logger, prefixes []string, verbs []Verb) *Auditor {
+	a := &Auditor{lg: lg}
+	for _, p := range prefixes {
+		a.prefixes = append(a.prefixes, []byte(p))
+	}
+	if len(verbs) > 0 {
+		a.verbs = make(map[Verb]struct{}, len(verbs))
+		for _, v := range verbs {
+			a.verbs[v] = struct{}{}
+		}
+	}
+	return a
+}
+
+// Audited returns true if requests of the given verb touching keys would be
+// recorded.
+func (a *Auditor) Audited(verb Verb, keys []KeyRange) bool {
+	if a.verbs != nil {
+		if _, ok := a.verbs[verb]; !ok {
+			return false
+		}
+	}
+	switch verb {
+	case VerbPut, VerbDelete, VerbTxn:
+	default:
+		// prefixes only apply to key requests
+		return true
+	}
+	if len(a.prefixes) == 0 {
+		return true
+	}
+	for _, kr := range keys {
+		for _, p := range a.prefixes {
+			if matchPrefix(kr, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Record writes ev to the audit log if it passes the filters.
+func (a *Auditor) Record(ev Event) {
+	if !a.Audited(ev.Verb, ev.Keys) {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("user", ev.User),
+		zap.String("remote", ev.Remote),
+		zap.String("method", ev.Method),
+		zap.String("verb", string(ev.Verb)),
+	}
+	if len(ev.Keys) > 0 {
+		fields = append(fields, zap.Array("keys", keyRanges(ev.Keys)))
+	}
+	if ev.Target != "" {
+		fields = append(fields, zap.String("target", ev.Target))
+	}
+	fields = append(fields, zap.Int64("revision", ev.Revision))
+	if ev.Err != nil {
+		fields = append(fields, zap.String("outcome", "failure"), zap.String("error", ev.Err.Error()))
+	} else {
+		fields = append(fields, zap.String("outcome", "success"))
+	}
+	a.lg.Info("audit", fields...)
+}
+
+// Sync flushes buffered audit records.
+func (a *Auditor) Sync() error {
+	return a.lg.Sync()
+}
+
+// matchPrefix returns true if the key range includes any key with prefix p.
+func matchPrefix(kr KeyRange, p []byte) bool {
+	if len(kr.RangeEnd) == 0 {
+		return bytes.HasPrefix(kr.Key, p)
+	}
+	pEnd := prefixEnd(p)
+	// the range must start before the end of the prefix range ...
+	if len(pEnd) != 0 && bytes.Compare(kr.Key, pEnd) >= 0 {
+		return false
+	}
+	// ... and end after its beginning; "\x00" means no end
+	if len(kr.RangeEnd) == 1 && kr.RangeEnd[0] == 0 {
+		return true
+	}
+	return bytes.Compare(kr.RangeEnd, p) > 0
+}
+
+// prefixEnd returns the end of the range of keys with prefix p, or nil if
+// the range has no end.
+func prefixEnd(p []byte) []byte {
+	end := make([]byte, len(p))
+	copy(end, p)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+type keyRanges []KeyRange
+
+func (krs keyRanges) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, kr := range krs {
+		if err := enc.AppendObject(kr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (kr KeyRange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddByteString("key", kr.Key)
+	if len(kr.RangeEnd) > 0 {
+		enc.AddByteString("range_end", kr.RangeEnd)
+	}
+	return nil
+}
+
+// RequestEvent fills in the verb, keys and target of an audit event for
+// req, returning false if req is not audited.
+func RequestEvent(req interface{}) (Event, bool) {
+	var ev Event
+	switch r := req.(type) {
+	case *pb.PutRequest:
+		ev.Verb = VerbPut
+		ev.Keys = []KeyRange{{Key: r.Key}}
+	case *pb.DeleteRangeRequest:
+		ev.Verb = VerbDelete
+		ev.Keys = []KeyRange{{Key: r.Key, RangeEnd: r.RangeEnd}}
+	case *pb.TxnRequest:
+		ev.Verb = VerbTxn
+		ev.Keys = txnKeys(r, nil)
+
+	case *pb.LeaseGrantRequest:
+		ev.Verb = VerbLease
+		ev.Target = leaseTarget(r.ID)
+	case *pb.LeaseRevokeRequest:
+		ev.Verb = VerbLease
+		ev.Target = leaseTarget(r.ID)
+	case *pb.LeaseAttachRequest:
+		ev.Verb = VerbLease
+		ev.Target = leaseTarget(r.ID)
+		ev.Keys = leaseKeys(r.Keys)
+	case *pb.LeaseDetachRequest:
+		ev.Verb = VerbLease
+		ev.Keys = leaseKeys(r.Keys)
+
+	case *pb.AuthEnableRequest, *pb.AuthDisableRequest:
+		ev.Verb = VerbAuth
+	case *pb.AuthenticateRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+	case *pb.AuthUserAddRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+	case *pb.AuthUserDeleteRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+	case *pb.AuthUserChangePasswordRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+	case *pb.AuthUserGrantRoleRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.User + "/" + r.Role
+	case *pb.AuthUserRevokeRoleRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name + "/" + r.Role
+	case *pb.AuthRoleAddRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+	case *pb.AuthRoleDeleteRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Role
+	case *pb.AuthRoleGrantPermissionRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Name
+		if r.Perm != nil {
+			ev.Keys = []KeyRange{{Key: r.Perm.Key, RangeEnd: r.Perm.RangeEnd}}
+		}
+	case *pb.AuthRoleRevokePermissionRequest:
+		ev.Verb = VerbAuth
+		ev.Target = r.Role
+		ev.Keys = []KeyRange{{Key: r.Key, RangeEnd: r.RangeEnd}}
+
+	case *pb.MemberAddRequest:
+		ev.Verb = VerbMember
+		ev.Target = strings.Join(r.PeerURLs, ",")
+	case *pb.MemberRemoveRequest:
+		ev.Verb = VerbMember
+		ev.Target = memberTarget(r.ID)
+	case *pb.MemberUpdateRequest:
+		ev.Verb = VerbMember
+		ev.Target = memberTarget(r.ID)
+	case *pb.MemberPromoteRequest:
+		ev.Verb = VerbMember
+		ev.Target = memberTarget(r.ID)
+
+	default:
+		return ev, false
+	}
+	return ev, true
+}
+
+func txnKeys(r *pb.TxnRequest, keys []KeyRange) []KeyRange {
+	for _, c := range r.Compare {
+		keys = append(keys, KeyRange{Key: c.Key, RangeEnd: c.RangeEnd})
+	}
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				if tv.RequestRange != nil {
+					keys = append(keys, KeyRange{Key: tv.RequestRange.Key, RangeEnd: tv.RequestRange.RangeEnd})
+				}
+			case *pb.RequestOp_RequestPut:
+				if tv.RequestPut != nil {
+					keys = append(keys, KeyRange{Key: tv.RequestPut.Key})
+				}
+			case *pb.RequestOp_RequestDeleteRange:
+				if tv.RequestDeleteRange != nil {
+					keys = append(keys, KeyRange{Key: tv.RequestDeleteRange.Key, RangeEnd: tv.RequestDeleteRange.RangeEnd})
+				}
+			case *pb.RequestOp_RequestTxn:
+				if tv.RequestTxn != nil {
+					keys = txnKeys(tv.RequestTxn, keys)
+				}
+			}
+		}
+	}
+	return keys
+}
+
+func leaseKeys(keys [][]byte) []KeyRange {
+	krs := make([]KeyRange, len(keys))
+	for i, k := range keys {
+		krs[i] = KeyRange{Key: k}
+	}
+	return krs
+}
+
+func leaseTarget(id int64) string   { return fmt.Sprintf("%016x", id) }
+func memberTarget(id uint64) string { return fmt.Sprintf("%x", id) }
+
+// ResponseRevision returns the revision in the header of resp, if any.
+func ResponseRevision(resp interface{}) int64 {
+	if r, ok := resp.(interface{ GetHeader() *pb.ResponseHeader }); ok {
+		return r.GetHeader().GetRevision()
+	}
+	return 0
+}
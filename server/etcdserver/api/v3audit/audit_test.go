@@ -0,0 +1,157 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3audit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestMatchPrefix(t *testing.T) {
+	tests := []struct {
+		kr     KeyRange
+		prefix string
+		want   bool
+	}{
+		{KeyRange{Key: []byte("foo/a")}, "foo/", true},
+		{KeyRange{Key: []byte("bar/a")}, "foo/", false},
+		{KeyRange{Key: []byte("a")}, "", true},
+		// ranges overlapping the prefix range
+		{KeyRange{Key: []byte("a"), RangeEnd: []byte("z")}, "foo/", true},
+		{KeyRange{Key: []byte("foo/x"), RangeEnd: []byte("foo0")}, "foo/", true},
+		{KeyRange{Key: []byte("a"), RangeEnd: []byte("foo/")}, "foo/", false},
+		{KeyRange{Key: []byte("foo0"), RangeEnd: []byte("z")}, "foo/", false},
+		// open ended ranges
+		{KeyRange{Key: []byte("a"), RangeEnd: []byte{0}}, "foo/", true},
+		{KeyRange{Key: []byte("g"), RangeEnd: []byte{0}}, "foo/", false},
+		{KeyRange{Key: []byte{0xff, 0xff}, RangeEnd: []byte{0}}, "\xff", true},
+	}
+	for i, tt := range tests {
+		if got := matchPrefix(tt.kr, []byte(tt.prefix)); got != tt.want {
+			t.Errorf("#%d: matchPrefix([%q, %q), %q) = %t, want %t", i, tt.kr.Key, tt.kr.RangeEnd, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestAuditorAudited(t *testing.T) {
+	a := NewAuditor(zap.NewNop(), []string{"secret/"}, []Verb{VerbPut, VerbTxn, VerbAuth})
+
+	tests := []struct {
+		verb Verb
+		keys []KeyRange
+		want bool
+	}{
+		{VerbPut, []KeyRange{{Key: []byte("secret/a")}}, true},
+		{VerbPut, []KeyRange{{Key: []byte("public/a")}}, false},
+		{VerbDelete, []KeyRange{{Key: []byte("secret/a")}}, false},
+		{VerbTxn, []KeyRange{{Key: []byte("public/a")}, {Key: []byte("secret/b")}}, true},
+		// prefixes don't apply to requests without keys
+		{VerbAuth, nil, true},
+		{VerbLease, nil, false},
+	}
+	for i, tt := range tests {
+		if got := a.Audited(tt.verb, tt.keys); got != tt.want {
+			t.Errorf("#%d: Audited(%s) = %t, want %t", i, tt.verb, got, tt.want)
+		}
+	}
+}
+
+func TestParseVerbs(t *testing.T) {
+	verbs, err := ParseVerbs([]string{"put", " Member "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(verbs, []Verb{VerbPut, VerbMember}) {
+		t.Errorf("unexpected verbs %v", verbs)
+	}
+	if _, err = ParseVerbs([]string{"range"}); err == nil {
+		t.Error("expected error for unknown verb")
+	}
+}
+
+func TestRequestEvent(t *testing.T) {
+	txn := &pb.TxnRequest{
+		Compare: []*pb.Compare{{Key: []byte("c")}},
+		Success: []*pb.RequestOp{
+			{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("p"), Value: []byte("v")}}},
+			{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{
+				Failure: []*pb.RequestOp{{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: &pb.DeleteRangeRequest{Key: []byte("d"), RangeEnd: []byte("e")}}}},
+			}}},
+		},
+	}
+	ev, ok := RequestEvent(txn)
+	if !ok || ev.Verb != VerbTxn {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	wkeys := []KeyRange{{Key: []byte("c")}, {Key: []byte("p")}, {Key: []byte("d"), RangeEnd: []byte("e")}}
+	if !reflect.DeepEqual(ev.Keys, wkeys) {
+		t.Errorf("expected keys %v, got %v", wkeys, ev.Keys)
+	}
+
+	ev, ok = RequestEvent(&pb.AuthUserAddRequest{Name: "alice", Password: "secret"})
+	if !ok || ev.Verb != VerbAuth || ev.Target != "alice" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+
+	if _, ok = RequestEvent(&pb.RangeRequest{Key: []byte("a")}); ok {
+		t.Error("expected range requests not to be audited")
+	}
+}
+
+func TestAuditorRecord(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	a := NewAuditor(zap.New(core), nil, nil)
+
+	a.Record(Event{
+		User:     "alice",
+		Remote:   "127.0.0.1:5000",
+		Method:   "/etcdserverpb.KV/Put",
+		Verb:     VerbPut,
+		Keys:     []KeyRange{{Key: []byte("foo")}},
+		Revision: 5,
+	})
+	a.Record(Event{
+		Method: "/etcdserverpb.Auth/UserAdd",
+		Verb:   VerbAuth,
+		Target: "bob",
+		Err:    errors.New("permission denied"),
+	})
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(entries))
+	}
+
+	put := entries[0].ContextMap()
+	if put["user"] != "alice" || put["outcome"] != "success" || put["revision"] != int64(5) {
+		t.Errorf("unexpected put record %v", put)
+	}
+	keys, ok := put["keys"].([]interface{})
+	if !ok || len(keys) != 1 || !reflect.DeepEqual(keys[0], map[string]interface{}{"key": "foo"}) {
+		t.Errorf("unexpected keys %v", put["keys"])
+	}
+
+	auth := entries[1].ContextMap()
+	if auth["target"] != "bob" || auth["outcome"] != "failure" || auth["error"] != "permission denied" {
+		t.Errorf("unexpected auth record %v", auth)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
+)
+
+// NewLogger returns a logger writing audit records as JSON lines to output,
+// which is "stdout", "stderr" or a file path. If rotationJSON is not empty,
+// the file is rotated with the given lumberjack configuration. The returned
+// closer releases the file.
+func NewLogger(output string, rotationJSON string) (*zap.Logger, io.Closer, error) {
+	var w io.WriteCloser
+	switch output {
+	case "":
+		return nil, nil, fmt.Errorf("audit log output is not set")
+	case OutputStdout:
+		w = nopCloser{os.Stdout}
+	case OutputStderr:
+		w = nopCloser{os.Stderr}
+	default:
+		if rotationJSON != "" {
+			rotation := &lumberjack.Logger{}
+			if err := json.Unmarshal([]byte(rotationJSON), rotation); err != nil {
+				return nil, nil, fmt.Errorf("improperly formatted audit log rotation config: %w", err)
+			}
+			rotation.Filename = output
+			w = rotation
+		} else {
+			f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+			if err != nil {
+				return nil, nil, err
+			}
+			w = f
+		}
+	}
+
+	encCfg := zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		MessageKey:     "msg",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+	}
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encCfg), zapcore.AddSync(w), zap.InfoLevel)
+	return zap.New(core), w, nil
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
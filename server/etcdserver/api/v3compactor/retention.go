@@ -0,0 +1,214 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+// PrefixRetention retains the history of the keys under Prefix for the last
+// Revisions revisions, or for Period if Revisions is zero, regardless of the
+// revision the store is compacted to.
+type PrefixRetention struct {
+	Prefix    string
+	Revisions int64
+	Period    time.Duration
+}
+
+// ParsePrefixRetention parses retention rules of the form
+// "<prefix>=<retention>", where retention is either a number of revisions
+// or a duration with a time unit (e.g. '72h').
+func ParsePrefixRetention(rules []string) ([]PrefixRetention, error) {
+	var prs []PrefixRetention
+	for _, rule := range rules {
+		i := strings.LastIndex(rule, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid prefix retention %q, expected <prefix>=<retention>", rule)
+		}
+		pr := PrefixRetention{Prefix: rule[:i]}
+		retention := rule[i+1:]
+		if n, err := strconv.ParseInt(retention, 10, 64); err == nil {
+			pr.Revisions = n
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid prefix retention %q, revisions must be positive", rule)
+			}
+		} else {
+			d, err := time.ParseDuration(retention)
+			if err != nil {
+				return nil, fmt.Errorf("invalid prefix retention %q: %v", rule, err)
+			}
+			if d <= 0 {
+				return nil, fmt.Errorf("invalid prefix retention %q, period must be positive", rule)
+			}
+			pr.Period = d
+		}
+		prs = append(prs, pr)
+	}
+	return prs, nil
+}
+
+type revSample struct {
+	rev int64
+	at  time.Time
+}
+
+// Retainer resolves prefix retention rules into the retention of a
+// compaction. It samples the revision over time to resolve the
+// period based rules.
+type Retainer struct {
+	lg    *zap.Logger
+	clock clockwork.Clock
+	rg    RevGetter
+	rules []PrefixRetention
+
+	// period is the longest period of the rules.
+	period time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu protects samples
+	mu      sync.Mutex
+	samples []revSample
+}
+
+// NewRetainer returns a Retainer for the given rules.
+func NewRetainer(lg *zap.Logger, rules []PrefixRetention, rg RevGetter) *Retainer {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return newRetainer(lg, clockwork.NewRealClock(), rules, rg)
+}
+
+func newRetainer(lg *zap.Logger, clock clockwork.Clock, rules []PrefixRetention, rg RevGetter) *Retainer {
+	r := &Retainer{
+		lg:    lg,
+		clock: clock,
+		rg:    rg,
+		rules: rules,
+	}
+	for _, pr := range rules {
+		if pr.Revisions == 0 && pr.Period > r.period {
+			r.period = pr.Period
+		}
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r
+}
+
+// Run starts sampling the revision in background for the period based rules.
+// Use Stop() to halt it.
+func (r *Retainer) Run() {
+	if r.period == 0 {
+		return
+	}
+	interval := r.getSampleInterval()
+	go func() {
+		for {
+			r.sample()
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-r.clock.After(interval):
+			}
+		}
+	}()
+}
+
+// Stop stops sampling the revision.
+func (r *Retainer) Stop() {
+	r.cancel()
+}
+
+// getSampleInterval samples the revision every 1/10 of the shortest period,
+// but at least every 6 minutes, the same as the periodic compactor.
+func (r *Retainer) getSampleInterval() time.Duration {
+	itv := time.Hour
+	for _, pr := range r.rules {
+		if pr.Revisions == 0 && pr.Period < itv {
+			itv = pr.Period
+		}
+	}
+	return itv / retryDivisor
+}
+
+func (r *Retainer) sample() {
+	now := r.clock.Now()
+	rev := r.rg.Rev()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples = append(r.samples, revSample{rev: rev, at: now})
+	// keep the newest sample older than the longest period
+	i := 0
+	for i+1 < len(r.samples) && !r.samples[i+1].at.After(now.Add(-r.period)) {
+		i++
+	}
+	r.samples = r.samples[i:]
+}
+
+// revAt returns the newest sampled revision at or before t. It returns 0 if
+// there is no such sample, as the history since then is unknown.
+func (r *Retainer) revAt(t time.Time) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rev := int64(0)
+	for _, s := range r.samples {
+		if s.at.After(t) {
+			break
+		}
+		rev = s.rev
+	}
+	return rev
+}
+
+// Retention returns the retention of the rules for a compaction at rev.
+func (r *Retainer) Retention(rev int64) []*pb.CompactionRetention {
+	var retention []*pb.CompactionRetention
+	now := r.clock.Now()
+	for _, pr := range r.rules {
+		var retained int64
+		if pr.Revisions != 0 {
+			retained = r.rg.Rev() - pr.Revisions
+		} else {
+			retained = r.revAt(now.Add(-pr.Period))
+		}
+		if retained < 0 {
+			retained = 0
+		}
+		if retained >= rev {
+			continue
+		}
+		retention = append(retention, &pb.CompactionRetention{Prefix: []byte(pr.Prefix), Revision: retained})
+	}
+	if len(retention) != 0 {
+		r.lg.Info(
+			"retaining prefix history from compaction",
+			zap.Int64("revision", rev),
+			zap.Int("retained-prefixes", len(retention)),
+		)
+	}
+	return retention
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+)
+
+func TestParsePrefixRetention(t *testing.T) {
+	prs, err := ParsePrefixRetention([]string{"/config/=72h", "/status/=1000", "a=b=5m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wprs := []PrefixRetention{
+		{Prefix: "/config/", Period: 72 * time.Hour},
+		{Prefix: "/status/", Revisions: 1000},
+		{Prefix: "a=b", Period: 5 * time.Minute},
+	}
+	if !reflect.DeepEqual(prs, wprs) {
+		t.Errorf("rules = %+v, want %+v", prs, wprs)
+	}
+
+	for _, rule := range []string{"/config/", "/config/=0", "/config/=-1h", "/config/=1x"} {
+		if _, err := ParsePrefixRetention([]string{rule}); err == nil {
+			t.Errorf("%q: expected error", rule)
+		}
+	}
+}
+
+func TestRetainer(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fakeRevGetter{&testutil.RecorderBuffered{}, 0}
+	rules := []PrefixRetention{
+		{Prefix: "/config/", Period: time.Hour},
+		{Prefix: "/status/", Revisions: 5},
+	}
+	r := newRetainer(zaptest.NewLogger(t), fc, rules, rg)
+	if itv := r.getSampleInterval(); itv != 6*time.Minute {
+		t.Fatalf("sample interval = %v, want 6m", itv)
+	}
+
+	// one revision for each sample interval for half an hour
+	for i := 0; i < 5; i++ {
+		r.sample()
+		fc.Advance(r.getSampleInterval())
+	}
+	// the revision an hour ago is unknown before an hour is sampled
+	rg.SetRev(99)
+	wretention := []*pb.CompactionRetention{
+		{Prefix: []byte("/config/"), Revision: 0},
+		{Prefix: []byte("/status/"), Revision: 95},
+	}
+	if retention := r.Retention(100); !reflect.DeepEqual(retention, wretention) {
+		t.Errorf("retention = %+v, want %+v", retention, wretention)
+	}
+
+	rg.SetRev(10)
+	for i := 0; i < 15; i++ {
+		r.sample()
+		fc.Advance(r.getSampleInterval())
+	}
+	if len(r.samples) != 11 {
+		t.Errorf("len(samples) = %d, want 11", len(r.samples))
+	}
+	// the revision an hour ago was sampled as 16, and the last 5 revisions
+	// are not older than the compaction
+	rg.SetRev(99)
+	wretention = []*pb.CompactionRetention{
+		{Prefix: []byte("/config/"), Revision: 16},
+	}
+	if retention := r.Retention(50); !reflect.DeepEqual(retention, wretention) {
+		t.Errorf("retention = %+v, want %+v", retention, wretention)
+	}
+}
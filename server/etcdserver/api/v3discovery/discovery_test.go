@@ -757,6 +757,10 @@ func (fkv *fakeBaseKV) Compact(ctx context.Context, rev int64, opts ...clientv3.
 	return nil, nil
 }
 
+func (fkv *fakeBaseKV) History(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.HistoryResponse, error) {
+	return nil, nil
+}
+
 func (fkv *fakeBaseKV) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
 	return clientv3.OpResponse{}, nil
 }
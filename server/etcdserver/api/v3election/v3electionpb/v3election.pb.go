@@ -40,7 +40,11 @@ type CampaignRequest struct {
 	Lease int64 `protobuf:"varint,2,opt,name=lease,proto3" json:"lease,omitempty"`
 	// value is the initial proclaimed value set when the campaigner wins the
 	// election.
-	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// priority orders the campaigner ahead of the waiting campaigners with a
+	// lower priority. It must not be negative; the current leader is never
+	// preempted.
+	Priority             int64    `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -100,6 +104,13 @@ func (m *CampaignRequest) GetValue() []byte {
 	return nil
 }
 
+func (m *CampaignRequest) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type CampaignResponse struct {
 	Header *etcdserverpb.ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	// leader describes the resources used for holding leadereship of the election.
@@ -164,7 +175,8 @@ type LeaderKey struct {
 	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	// rev is the creation revision of the key. It can be used to test for ownership
 	// of an election during transactions by testing the key's creation revision
-	// matches rev.
+	// matches rev. It increases with every new leader, so it can be passed to
+	// other systems as a fencing token.
 	Rev int64 `protobuf:"varint,3,opt,name=rev,proto3" json:"rev,omitempty"`
 	// lease is the lease ID of the election leader.
 	Lease                int64    `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
@@ -433,6 +445,110 @@ func (m *ResignResponse) GetHeader() *etcdserverpb.ResponseHeader {
 	return nil
 }
 
+type TransferRequest struct {
+	// leader is the leadership to hand over.
+	Leader *LeaderKey `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	// candidate is the key of the waiting campaigner to become the leader.
+	Candidate            []byte   `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferRequest) Reset()         { *m = TransferRequest{} }
+func (m *TransferRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRequest) ProtoMessage()    {}
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b1f26cc432a035, []int{7}
+}
+func (m *TransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRequest.Merge(m, src)
+}
+func (m *TransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRequest proto.InternalMessageInfo
+
+func (m *TransferRequest) GetLeader() *LeaderKey {
+	if m != nil {
+		return m.Leader
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetCandidate() []byte {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+type TransferResponse struct {
+	Header               *etcdserverpb.ResponseHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TransferResponse) Reset()         { *m = TransferResponse{} }
+func (m *TransferResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferResponse) ProtoMessage()    {}
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9b1f26cc432a035, []int{8}
+}
+func (m *TransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResponse.Merge(m, src)
+}
+func (m *TransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferResponse proto.InternalMessageInfo
+
+func (m *TransferResponse) GetHeader() *etcdserverpb.ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 type ProclaimRequest struct {
 	// leader is the leadership hold on the election.
 	Leader *LeaderKey `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
@@ -447,7 +563,7 @@ func (m *ProclaimRequest) Reset()         { *m = ProclaimRequest{} }
 func (m *ProclaimRequest) String() string { return proto.CompactTextString(m) }
 func (*ProclaimRequest) ProtoMessage()    {}
 func (*ProclaimRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9b1f26cc432a035, []int{7}
+	return fileDescriptor_c9b1f26cc432a035, []int{9}
 }
 func (m *ProclaimRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +617,7 @@ func (m *ProclaimResponse) Reset()         { *m = ProclaimResponse{} }
 func (m *ProclaimResponse) String() string { return proto.CompactTextString(m) }
 func (*ProclaimResponse) ProtoMessage()    {}
 func (*ProclaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9b1f26cc432a035, []int{8}
+	return fileDescriptor_c9b1f26cc432a035, []int{10}
 }
 func (m *ProclaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,6 +661,8 @@ func init() {
 	proto.RegisterType((*LeaderResponse)(nil), "v3electionpb.LeaderResponse")
 	proto.RegisterType((*ResignRequest)(nil), "v3electionpb.ResignRequest")
 	proto.RegisterType((*ResignResponse)(nil), "v3electionpb.ResignResponse")
+	proto.RegisterType((*TransferRequest)(nil), "v3electionpb.TransferRequest")
+	proto.RegisterType((*TransferResponse)(nil), "v3electionpb.TransferResponse")
 	proto.RegisterType((*ProclaimRequest)(nil), "v3electionpb.ProclaimRequest")
 	proto.RegisterType((*ProclaimResponse)(nil), "v3electionpb.ProclaimResponse")
 }
@@ -552,41 +670,45 @@ func init() {
 func init() { proto.RegisterFile("v3election.proto", fileDescriptor_c9b1f26cc432a035) }
 
 var fileDescriptor_c9b1f26cc432a035 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x59, 0x27, 0x84, 0x32, 0xa4, 0xad, 0x65, 0x82, 0x08, 0x21, 0xb8, 0xd1, 0x72, 0xa9,
-	0x72, 0xb0, 0x51, 0xc3, 0x29, 0x27, 0x04, 0x02, 0x55, 0x2a, 0x12, 0xe0, 0x03, 0x82, 0xe3, 0xda,
-	0x1d, 0xb9, 0x91, 0x1d, 0xaf, 0xb1, 0x5d, 0x4b, 0xb9, 0xf2, 0x0a, 0x1c, 0xe0, 0x91, 0x38, 0x22,
-	0xf1, 0x02, 0x28, 0xf0, 0x20, 0x68, 0x77, 0xed, 0xfa, 0x8f, 0x12, 0x84, 0x9a, 0xdb, 0x78, 0xe7,
-	0xdb, 0xf9, 0xcd, 0x37, 0x3b, 0x09, 0xe8, 0xf9, 0x0c, 0x43, 0xf4, 0xb2, 0x05, 0x8f, 0xac, 0x38,
-	0xe1, 0x19, 0x37, 0xfa, 0xd5, 0x49, 0xec, 0x8e, 0x06, 0x3e, 0xf7, 0xb9, 0x4c, 0xd8, 0x22, 0x52,
-	0x9a, 0xd1, 0x11, 0x66, 0xde, 0xb9, 0xcd, 0xe2, 0x85, 0x2d, 0x82, 0x14, 0x93, 0x1c, 0x93, 0xd8,
-	0xb5, 0x93, 0xd8, 0x2b, 0x04, 0xc3, 0x2b, 0xc1, 0x32, 0xf7, 0xbc, 0xd8, 0xb5, 0x83, 0xbc, 0xc8,
-	0x8c, 0x7d, 0xce, 0xfd, 0x10, 0x65, 0x8e, 0x45, 0x11, 0xcf, 0x98, 0x20, 0xa5, 0x2a, 0x4b, 0xdf,
-	0xc1, 0xe1, 0x0b, 0xb6, 0x8c, 0xd9, 0xc2, 0x8f, 0x1c, 0xfc, 0x74, 0x89, 0x69, 0x66, 0x18, 0xd0,
-	0x8d, 0xd8, 0x12, 0x87, 0x64, 0x42, 0x8e, 0xfb, 0x8e, 0x8c, 0x8d, 0x01, 0xdc, 0x0c, 0x91, 0xa5,
-	0x38, 0xd4, 0x26, 0xe4, 0xb8, 0xe3, 0xa8, 0x0f, 0x71, 0x9a, 0xb3, 0xf0, 0x12, 0x87, 0x1d, 0x29,
-	0x55, 0x1f, 0x74, 0x05, 0x7a, 0x55, 0x32, 0x8d, 0x79, 0x94, 0xa2, 0xf1, 0x14, 0x7a, 0x17, 0xc8,
-	0xce, 0x31, 0x91, 0x55, 0xef, 0x9c, 0x8c, 0xad, 0xba, 0x0f, 0xab, 0xd4, 0x9d, 0x4a, 0x8d, 0x53,
-	0x68, 0x0d, 0x1b, 0x7a, 0xa1, 0xba, 0xa5, 0xc9, 0x5b, 0xf7, 0xad, 0xfa, 0xa8, 0xac, 0xd7, 0x32,
-	0x77, 0x86, 0x2b, 0xa7, 0x90, 0xd1, 0x8f, 0x70, 0xfb, 0xea, 0x70, 0xa3, 0x0f, 0x1d, 0x3a, 0x01,
-	0xae, 0x64, 0xb9, 0xbe, 0x23, 0x42, 0x71, 0x92, 0x60, 0x2e, 0x1d, 0x74, 0x1c, 0x11, 0x56, 0x5e,
-	0xbb, 0x35, 0xaf, 0xf4, 0x31, 0xec, 0xab, 0xd2, 0xff, 0x18, 0x13, 0xbd, 0x80, 0x83, 0x52, 0xb4,
-	0x93, 0xf1, 0x09, 0x68, 0x41, 0x5e, 0x98, 0xd6, 0x2d, 0xf5, 0xa2, 0xd6, 0x19, 0xae, 0xde, 0x8b,
-	0x01, 0x3b, 0x5a, 0x90, 0xd3, 0x67, 0xb0, 0xef, 0x60, 0x5a, 0x7b, 0xb5, 0x6a, 0x56, 0xe4, 0xff,
-	0x66, 0xf5, 0x0a, 0x0e, 0xca, 0x0a, 0xbb, 0xf4, 0x4a, 0x3f, 0xc0, 0xe1, 0xdb, 0x84, 0x7b, 0x21,
-	0x5b, 0x2c, 0xaf, 0xdb, 0x4b, 0xb5, 0x48, 0x5a, 0x7d, 0x91, 0x4e, 0x41, 0xaf, 0x2a, 0xef, 0xd2,
-	0xe3, 0xc9, 0xd7, 0x2e, 0xec, 0xbd, 0x2c, 0x1a, 0x30, 0x02, 0xd8, 0x2b, 0xf7, 0xd3, 0x78, 0xd4,
-	0xec, 0xac, 0xf5, 0x53, 0x18, 0x99, 0xdb, 0xd2, 0x8a, 0x42, 0x27, 0x9f, 0x7f, 0xfe, 0xf9, 0xa2,
-	0x8d, 0xe8, 0x3d, 0x3b, 0x9f, 0xd9, 0xa5, 0xd0, 0xf6, 0x0a, 0xd9, 0x9c, 0x4c, 0x05, 0xac, 0xf4,
-	0xd0, 0x86, 0xb5, 0xa6, 0xd6, 0x86, 0xb5, 0xad, 0x6f, 0x81, 0xc5, 0x85, 0x4c, 0xc0, 0x3c, 0xe8,
-	0xa9, 0xd9, 0x1a, 0x0f, 0x37, 0x4d, 0xbc, 0x04, 0x8d, 0x37, 0x27, 0x0b, 0x8c, 0x29, 0x31, 0x43,
-	0x7a, 0xb7, 0x81, 0x51, 0x0f, 0x25, 0x20, 0x3e, 0xdc, 0x7a, 0xe3, 0xca, 0x81, 0xef, 0x42, 0x39,
-	0x92, 0x94, 0x07, 0x74, 0xd0, 0xa0, 0x70, 0x55, 0x78, 0x4e, 0xa6, 0x4f, 0x88, 0x70, 0xa3, 0x16,
-	0xb4, 0xcd, 0x69, 0x2c, 0x7e, 0x9b, 0xd3, 0xdc, 0xe9, 0x2d, 0x6e, 0x12, 0x29, 0x9a, 0x93, 0xe9,
-	0x73, 0xfd, 0xfb, 0xda, 0x24, 0x3f, 0xd6, 0x26, 0xf9, 0xb5, 0x36, 0xc9, 0xb7, 0xdf, 0xe6, 0x0d,
-	0xb7, 0x27, 0xff, 0x18, 0x67, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe6, 0x7c, 0x66, 0xa9,
-	0x05, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb1, 0xd3, 0x86, 0x76, 0x48, 0x1b, 0xcb, 0x04, 0x61, 0x42, 0x70, 0xa3, 0xe5, 0x52,
+	0xe5, 0x60, 0xa3, 0x86, 0x53, 0x4e, 0x08, 0x04, 0xaa, 0x54, 0x24, 0x90, 0x85, 0x10, 0xdc, 0xd8,
+	0x38, 0x8b, 0x6b, 0xc5, 0xf1, 0x9a, 0xb5, 0x6b, 0x29, 0x57, 0xc4, 0x1b, 0x70, 0xe1, 0x91, 0x38,
+	0x22, 0xf1, 0x02, 0x28, 0xf0, 0x20, 0x68, 0xff, 0x38, 0x4e, 0x56, 0x09, 0x02, 0x72, 0xdb, 0xdd,
+	0xf9, 0x3c, 0xbf, 0x99, 0x6f, 0x34, 0x32, 0x58, 0xe5, 0x90, 0x24, 0x24, 0x2c, 0x62, 0x9a, 0x7a,
+	0x19, 0xa3, 0x05, 0xb5, 0x5b, 0xf5, 0x4b, 0x36, 0xee, 0x76, 0x22, 0x1a, 0x51, 0x11, 0xf0, 0xf9,
+	0x49, 0x6a, 0xba, 0x27, 0xa4, 0x08, 0x27, 0x3e, 0xce, 0x62, 0x9f, 0x1f, 0x72, 0xc2, 0x4a, 0xc2,
+	0xb2, 0xb1, 0xcf, 0xb2, 0x50, 0x09, 0x9c, 0xa5, 0x60, 0x56, 0x86, 0x61, 0x36, 0xf6, 0xa7, 0xa5,
+	0x8a, 0xf4, 0x22, 0x4a, 0xa3, 0x84, 0x88, 0x18, 0x4e, 0x53, 0x5a, 0x60, 0x4e, 0xca, 0x65, 0x14,
+	0xcd, 0xa0, 0xfd, 0x04, 0xcf, 0x32, 0x1c, 0x47, 0x69, 0x40, 0x3e, 0x5c, 0x91, 0xbc, 0xb0, 0x6d,
+	0xd8, 0x4b, 0xf1, 0x8c, 0x38, 0x46, 0xdf, 0x38, 0x6d, 0x05, 0xe2, 0x6c, 0x77, 0x60, 0x3f, 0x21,
+	0x38, 0x27, 0x8e, 0xd9, 0x37, 0x4e, 0x1b, 0x81, 0xbc, 0xf0, 0xd7, 0x12, 0x27, 0x57, 0xc4, 0x69,
+	0x08, 0xa9, 0xbc, 0xd8, 0x5d, 0x38, 0xc8, 0x58, 0x4c, 0x59, 0x5c, 0xcc, 0x9d, 0x3d, 0x21, 0x5f,
+	0xde, 0xd1, 0x1c, 0xac, 0x1a, 0x97, 0x67, 0x34, 0xcd, 0x89, 0xfd, 0x10, 0x9a, 0x97, 0x04, 0x4f,
+	0x08, 0x13, 0xc4, 0x1b, 0x67, 0x3d, 0x6f, 0xb5, 0x47, 0xaf, 0xd2, 0x9d, 0x0b, 0x4d, 0xa0, 0xb4,
+	0xb6, 0x0f, 0xcd, 0x44, 0x7e, 0x65, 0x8a, 0xaf, 0x6e, 0x7b, 0xab, 0x36, 0x7a, 0xcf, 0x45, 0xec,
+	0x82, 0xcc, 0x03, 0x25, 0x43, 0x6f, 0xe1, 0x70, 0xf9, 0xb8, 0xb1, 0x47, 0x0b, 0x1a, 0x53, 0x32,
+	0x17, 0xe9, 0x5a, 0x01, 0x3f, 0xf2, 0x17, 0x46, 0x4a, 0xd1, 0x5d, 0x23, 0xe0, 0xc7, 0xda, 0x87,
+	0xbd, 0x15, 0x1f, 0xd0, 0x7d, 0x38, 0x92, 0xa9, 0xff, 0x60, 0x21, 0xba, 0x84, 0xe3, 0x4a, 0xb4,
+	0x53, 0xe3, 0x7d, 0x30, 0xa7, 0xa5, 0x6a, 0xda, 0xf2, 0xe4, 0xb4, 0xbd, 0x0b, 0x32, 0x7f, 0xcd,
+	0xcd, 0x0f, 0xcc, 0x69, 0x89, 0x1e, 0xc1, 0x51, 0x40, 0xf2, 0x95, 0x89, 0xd6, 0x5e, 0x19, 0x7f,
+	0xe7, 0xd5, 0x33, 0x38, 0xae, 0x32, 0xec, 0x52, 0x2b, 0x7a, 0x07, 0xed, 0x57, 0x0c, 0xa7, 0xf9,
+	0xfb, 0xda, 0x9a, 0x7f, 0xad, 0xc5, 0xee, 0xc1, 0x61, 0x88, 0xd3, 0x49, 0x3c, 0xc1, 0x05, 0x51,
+	0xc3, 0xa9, 0x1f, 0xd0, 0x39, 0x58, 0x35, 0x61, 0xa7, 0x5a, 0xdf, 0x40, 0xfb, 0x25, 0xa3, 0x61,
+	0x82, 0xe3, 0xd9, 0x7f, 0xd7, 0xba, 0x5c, 0x08, 0x73, 0x65, 0x21, 0x78, 0x8d, 0x75, 0xe6, 0x5d,
+	0x6a, 0x3c, 0xfb, 0xb4, 0x0f, 0x07, 0x4f, 0x55, 0x01, 0xf6, 0x14, 0x0e, 0xaa, 0x5d, 0xb2, 0xef,
+	0xad, 0x57, 0xa6, 0xad, 0x74, 0xd7, 0xdd, 0x16, 0x96, 0x14, 0xd4, 0xff, 0xf8, 0xfd, 0xd7, 0x67,
+	0xb3, 0x8b, 0x6e, 0xf9, 0xe5, 0xd0, 0xaf, 0x84, 0x7e, 0xa8, 0x64, 0x23, 0x63, 0xc0, 0x61, 0x55,
+	0x0f, 0x3a, 0x4c, 0x73, 0x4d, 0x87, 0xe9, 0xad, 0x6f, 0x81, 0x65, 0x4a, 0xc6, 0x61, 0x21, 0x34,
+	0xa5, 0xb7, 0xf6, 0xdd, 0x4d, 0x8e, 0x57, 0xa0, 0xde, 0xe6, 0xa0, 0xc2, 0xb8, 0x02, 0xe3, 0xa0,
+	0x9b, 0x6b, 0x18, 0x39, 0x28, 0x0e, 0x89, 0xe0, 0xfa, 0x8b, 0xb1, 0x30, 0x7c, 0x17, 0xca, 0x89,
+	0xa0, 0xdc, 0x41, 0x9d, 0x35, 0x0a, 0x95, 0x89, 0x47, 0xc6, 0xe0, 0x81, 0xc1, 0xbb, 0x91, 0xcb,
+	0xa4, 0x73, 0xd6, 0x96, 0x54, 0xe7, 0xac, 0xef, 0xdf, 0x96, 0x6e, 0x98, 0x10, 0xa9, 0xf9, 0x54,
+	0x7b, 0xa0, 0xcf, 0x47, 0xdb, 0x40, 0x7d, 0x3e, 0xfa, 0xfa, 0x6c, 0x99, 0x4f, 0xa1, 0x64, 0x23,
+	0x63, 0xf0, 0xd8, 0xfa, 0xba, 0x70, 0x8d, 0x6f, 0x0b, 0xd7, 0xf8, 0xb1, 0x70, 0x8d, 0x2f, 0x3f,
+	0xdd, 0x6b, 0xe3, 0xa6, 0xf8, 0x9b, 0x0c, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x30, 0x71, 0x55,
+	0x61, 0xde, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -616,6 +738,9 @@ type ElectionClient interface {
 	// Resign releases election leadership so other campaigners may acquire
 	// leadership on the election.
 	Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*ResignResponse, error)
+	// Transfer hands leadership over to a waiting campaigner. The campaigners
+	// waiting before it rejoin the election behind it.
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
 }
 
 type electionClient struct {
@@ -694,6 +819,15 @@ func (c *electionClient) Resign(ctx context.Context, in *ResignRequest, opts ...
 	return out, nil
 }
 
+func (c *electionClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, "/v3electionpb.Election/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ElectionServer is the server API for Election service.
 type ElectionServer interface {
 	// Campaign waits to acquire leadership in an election, returning a LeaderKey
@@ -711,6 +845,9 @@ type ElectionServer interface {
 	// Resign releases election leadership so other campaigners may acquire
 	// leadership on the election.
 	Resign(context.Context, *ResignRequest) (*ResignResponse, error)
+	// Transfer hands leadership over to a waiting campaigner. The campaigners
+	// waiting before it rejoin the election behind it.
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
 }
 
 // UnimplementedElectionServer can be embedded to have forward compatible implementations.
@@ -732,6 +869,9 @@ func (*UnimplementedElectionServer) Observe(req *LeaderRequest, srv Election_Obs
 func (*UnimplementedElectionServer) Resign(ctx context.Context, req *ResignRequest) (*ResignResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resign not implemented")
 }
+func (*UnimplementedElectionServer) Transfer(ctx context.Context, req *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
 
 func RegisterElectionServer(s *grpc.Server, srv ElectionServer) {
 	s.RegisterService(&_Election_serviceDesc, srv)
@@ -830,6 +970,24 @@ func _Election_Resign_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Election_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElectionServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v3electionpb.Election/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElectionServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Election_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v3electionpb.Election",
 	HandlerType: (*ElectionServer)(nil),
@@ -850,6 +1008,10 @@ var _Election_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Resign",
 			Handler:    _Election_Resign_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Election_Transfer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -885,6 +1047,11 @@ func (m *CampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Priority != 0 {
+		i = encodeVarintV3Election(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -1172,6 +1339,91 @@ func (m *ResignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Candidate) > 0 {
+		i -= len(m.Candidate)
+		copy(dAtA[i:], m.Candidate)
+		i = encodeVarintV3Election(dAtA, i, uint64(len(m.Candidate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Leader != nil {
+		{
+			size, err := m.Leader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintV3Election(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintV3Election(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProclaimRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1285,6 +1537,9 @@ func (m *CampaignRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovV3Election(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovV3Election(uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1405,6 +1660,42 @@ func (m *ResignResponse) Size() (n int) {
 	return n
 }
 
+func (m *TransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovV3Election(uint64(l))
+	}
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovV3Election(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovV3Election(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ProclaimRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1563,6 +1854,25 @@ func (m *CampaignRequest) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowV3Election
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipV3Election(dAtA[iNdEx:])
@@ -2247,6 +2557,214 @@ func (m *ResignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowV3Election
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowV3Election
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &LeaderKey{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowV3Election
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = append(m.Candidate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Candidate == nil {
+				m.Candidate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipV3Election(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowV3Election
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowV3Election
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &etcdserverpb.ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipV3Election(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthV3Election
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProclaimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
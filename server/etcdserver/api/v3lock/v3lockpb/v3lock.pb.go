@@ -96,7 +96,12 @@ type LockResponse struct {
 	// key is a key that will exist on etcd for the duration that the Lock caller
 	// owns the lock. Users should not modify this key or the lock may exhibit
 	// undefined behavior.
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// rev is the creation revision of key. It increases with every acquisition of
+	// the lock, so it can be passed to other systems as a fencing token. Updates
+	// to etcd can be guarded by testing that the key's creation revision matches
+	// rev.
+	Rev                  int64    `protobuf:"varint,3,opt,name=rev,proto3" json:"rev,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -149,6 +154,13 @@ func (m *LockResponse) GetKey() []byte {
 	return nil
 }
 
+func (m *LockResponse) GetRev() int64 {
+	if m != nil {
+		return m.Rev
+	}
+	return 0
+}
+
 type UnlockRequest struct {
 	// key is the lock ownership key granted by Lock.
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -254,28 +266,29 @@ func init() {
 func init() { proto.RegisterFile("v3lock.proto", fileDescriptor_52389b3e2f253201) }
 
 var fileDescriptor_52389b3e2f253201 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x33, 0xce, 0xc9,
-	0x4f, 0xce, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x80, 0xf0, 0x0a, 0x92, 0xa4, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x82, 0xfa, 0x20, 0x16, 0x44, 0x5e, 0x4a, 0x3e, 0xb5, 0x24, 0x39,
-	0x45, 0x3f, 0xb1, 0x20, 0x53, 0x1f, 0xc4, 0x28, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0x2a, 0x48, 0xd2,
-	0x2f, 0x2a, 0x48, 0x86, 0x2a, 0x90, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0x05, 0x2b, 0x49, 0xcc,
-	0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xc8, 0x2a, 0x99, 0x73, 0x71, 0xfb,
-	0xe4, 0x27, 0x67, 0x07, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x08, 0x09, 0x71, 0xb1, 0xe4, 0x25,
-	0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x81, 0xd9, 0x42, 0x22, 0x5c, 0xac, 0x39,
-	0xa9, 0x89, 0xc5, 0xa9, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x10, 0x8e, 0x52, 0x18, 0x17,
-	0x0f, 0x44, 0x63, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x09, 0x17, 0x5b, 0x46, 0x6a, 0x62,
-	0x4a, 0x6a, 0x11, 0x58, 0x2f, 0xb7, 0x91, 0x8c, 0x1e, 0xb2, 0x7b, 0xf4, 0x60, 0xea, 0x3c, 0xc0,
-	0x6a, 0x82, 0xa0, 0x6a, 0x85, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0xc1, 0x26, 0xf3, 0x04, 0x81,
-	0x98, 0x4a, 0x8a, 0x5c, 0xbc, 0xa1, 0x79, 0x39, 0x48, 0x4e, 0x82, 0x2a, 0x61, 0x44, 0x28, 0x71,
-	0xe3, 0xe2, 0x83, 0x29, 0xa1, 0xc4, 0x72, 0xa3, 0x0d, 0x8c, 0x5c, 0x2c, 0x20, 0x3f, 0x08, 0xf9,
-	0x43, 0x69, 0x51, 0x3d, 0x58, 0x60, 0xeb, 0x21, 0x05, 0x8a, 0x94, 0x18, 0xba, 0x30, 0xc4, 0x34,
-	0x25, 0x89, 0xa6, 0xcb, 0x4f, 0x26, 0x33, 0x09, 0x29, 0xf1, 0xea, 0x97, 0x19, 0xeb, 0x83, 0x14,
-	0x80, 0x09, 0x2b, 0x46, 0x2d, 0xa1, 0x70, 0x2e, 0x36, 0x88, 0x0b, 0x85, 0xc4, 0x11, 0x7a, 0x51,
-	0xbc, 0x25, 0x25, 0x81, 0x29, 0x01, 0x35, 0x56, 0x0a, 0x6c, 0xac, 0x88, 0x12, 0x3f, 0xdc, 0xd8,
-	0xd2, 0x3c, 0xa8, 0xc1, 0x4e, 0x02, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0xe0, 0x78, 0x34, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x4a, 0x4d, 0xca, 0xbb, 0x36, 0x02, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xcd, 0x4a, 0xc3, 0x40,
+	0x10, 0x76, 0xdb, 0x5a, 0x64, 0x9b, 0x6a, 0x59, 0xaa, 0x86, 0x50, 0x62, 0xdd, 0x53, 0xf1, 0x90,
+	0x40, 0x2b, 0x08, 0x1e, 0x3d, 0x88, 0x07, 0x41, 0x08, 0x88, 0xe7, 0x34, 0x1d, 0xd2, 0xd2, 0xb8,
+	0x1b, 0x77, 0xd3, 0x80, 0x57, 0x5f, 0xc1, 0x8b, 0x8f, 0xe1, 0x63, 0x78, 0x14, 0x7c, 0x01, 0xa9,
+	0x3e, 0x88, 0xec, 0x4f, 0x6b, 0xd4, 0xa3, 0x97, 0xe4, 0x9b, 0x99, 0xef, 0xfb, 0xf2, 0xcd, 0x04,
+	0x3b, 0xe5, 0x28, 0xe3, 0xc9, 0x3c, 0xc8, 0x05, 0x2f, 0x38, 0xd9, 0x32, 0x55, 0x3e, 0xf6, 0xba,
+	0x29, 0x4f, 0xb9, 0x6e, 0x86, 0x0a, 0x99, 0xb9, 0x77, 0x00, 0x45, 0x32, 0x09, 0xe3, 0x7c, 0x16,
+	0x2a, 0x20, 0x41, 0x94, 0x20, 0xf2, 0x71, 0x28, 0xf2, 0xc4, 0x12, 0x7a, 0x29, 0xe7, 0x69, 0x06,
+	0x9a, 0x12, 0x33, 0xc6, 0x8b, 0xb8, 0x98, 0x71, 0x26, 0xcd, 0x94, 0x9e, 0xe0, 0xd6, 0x25, 0x4f,
+	0xe6, 0x11, 0xdc, 0x2d, 0x40, 0x16, 0x84, 0xe0, 0x06, 0x8b, 0x6f, 0xc1, 0x45, 0x7d, 0x34, 0x70,
+	0x22, 0x8d, 0x49, 0x17, 0x6f, 0x66, 0x10, 0x4b, 0x70, 0x6b, 0x7d, 0x34, 0xa8, 0x47, 0xa6, 0xa0,
+	0x53, 0xec, 0x18, 0xa1, 0xcc, 0x39, 0x93, 0x40, 0x8e, 0x71, 0x73, 0x0a, 0xf1, 0x04, 0x84, 0xd6,
+	0xb6, 0x86, 0xbd, 0xa0, 0x9a, 0x27, 0x58, 0xf1, 0x2e, 0x34, 0x27, 0xb2, 0x5c, 0xd2, 0xc1, 0xf5,
+	0x39, 0xdc, 0x6b, 0x67, 0x27, 0x52, 0x50, 0x75, 0x04, 0x94, 0x6e, 0x5d, 0x7f, 0x4b, 0x41, 0x7a,
+	0x88, 0xdb, 0xd7, 0x2c, 0xab, 0x84, 0xb4, 0x22, 0xb4, 0x16, 0xd1, 0x73, 0xbc, 0xbd, 0xa2, 0xfc,
+	0x27, 0xce, 0xf0, 0x19, 0xe1, 0x86, 0xda, 0x8a, 0x5c, 0xd9, 0xf7, 0x6e, 0xb0, 0x3a, 0x7f, 0x50,
+	0x39, 0x93, 0xb7, 0xf7, 0xbb, 0x6d, 0xdc, 0xa8, 0xfb, 0xf0, 0xf6, 0xf9, 0x58, 0x23, 0xb4, 0x1d,
+	0x96, 0xa3, 0x50, 0x11, 0xf4, 0xe3, 0x14, 0x1d, 0x91, 0x1b, 0xdc, 0x34, 0x09, 0xc9, 0xfe, 0xb7,
+	0xf6, 0xc7, 0x5a, 0x9e, 0xfb, 0x77, 0x60, 0x6d, 0x3d, 0x6d, 0xdb, 0xa5, 0x3b, 0x6b, 0xdb, 0x05,
+	0xb3, 0xc6, 0x67, 0x9d, 0x97, 0xa5, 0x8f, 0x5e, 0x97, 0x3e, 0x7a, 0x5f, 0xfa, 0xe8, 0xe9, 0xc3,
+	0xdf, 0x18, 0x37, 0xf5, 0x9f, 0x1d, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x0a, 0xda, 0x0b,
+	0x48, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -475,6 +488,11 @@ func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Rev != 0 {
+		i = encodeVarintV3Lock(dAtA, i, uint64(m.Rev))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -614,6 +632,9 @@ func (m *LockResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovV3Lock(uint64(l))
 	}
+	if m.Rev != 0 {
+		n += 1 + sovV3Lock(uint64(m.Rev))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -861,6 +882,25 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 				m.Key = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rev", wireType)
+			}
+			m.Rev = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowV3Lock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rev |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipV3Lock(dAtA[iNdEx:])
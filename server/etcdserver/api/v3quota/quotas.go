@@ -0,0 +1,401 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v3quota manages per-prefix storage quotas in etcd.
+package v3quota
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/coreos/go-semver/semver"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+
+	"go.uber.org/zap"
+)
+
+type QuotaBackend interface {
+	CreateQuotaBucket()
+	MustPutQuota(status *pb.PrefixQuotaStatus)
+	MustUnsafePutQuota(tx backend.BatchTx, status *pb.PrefixQuotaStatus)
+	MustDeleteQuota(prefix []byte)
+	GetAllQuotas() ([]*pb.PrefixQuotaStatus, error)
+	ForceCommit()
+}
+
+// QuotaStore persists per-prefix storage quotas to the backend, along with
+// the usage of each prefix. It implements mvcc.UsageTracker to keep the
+// usage up to date with the writes to the store.
+type QuotaStore struct {
+	lg *zap.Logger
+	// mu protects quotas. It is acquired with the backend transaction
+	// locked by UnsafeTrack, so it must not be held while locking it.
+	mu     sync.RWMutex
+	quotas map[string]*pb.PrefixQuotaStatus
+
+	be QuotaBackend
+}
+
+// IsSupported returns true if the cluster version cv supports setting prefix
+// quotas. Members before v3.6 cannot apply them.
+func IsSupported(cv *semver.Version) bool {
+	return cv != nil && !version.LessThan(*cv, version.V3_6)
+}
+
+func NewQuotaStore(lg *zap.Logger, be QuotaBackend) (*QuotaStore, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	ret := &QuotaStore{lg: lg, quotas: make(map[string]*pb.PrefixQuotaStatus), be: be}
+	err := ret.restore()
+	return ret, err
+}
+
+// Set sets the quota of q.Prefix. A quota without any limit removes
+// the quota of the prefix. The usage of a prefix without a quota is
+// read from rv, which must reflect all writes applied so far.
+func (s *QuotaStore) Set(ctx context.Context, rv mvcc.ReadView, q *pb.PrefixQuota) error {
+	s.mu.RLock()
+	old, ok := s.quotas[string(q.Prefix)]
+	var u Usage
+	if ok {
+		u = Usage{Keys: old.KeyCount, Bytes: old.ByteCount}
+	}
+	s.mu.RUnlock()
+
+	if q.MaxKeys == 0 && q.MaxBytes == 0 {
+		if ok {
+			s.be.MustDeleteQuota(q.Prefix)
+			s.mu.Lock()
+			delete(s.quotas, string(q.Prefix))
+			s.mu.Unlock()
+		}
+		return nil
+	}
+	if !ok {
+		var err error
+		if u, err = ReadUsage(ctx, rv, q.Prefix, true); err != nil {
+			return err
+		}
+	}
+	status := &pb.PrefixQuotaStatus{Quota: q, KeyCount: u.Keys, ByteCount: u.Bytes}
+	s.be.MustPutQuota(status)
+	s.mu.Lock()
+	s.quotas[string(q.Prefix)] = status
+	s.mu.Unlock()
+	return nil
+}
+
+// Get returns the quota of the given prefix, or nil if it has none.
+func (s *QuotaStore) Get(prefix []byte) *pb.PrefixQuota {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if status, ok := s.quotas[string(prefix)]; ok {
+		return status.Quota
+	}
+	return nil
+}
+
+// List returns all quotas ordered by prefix.
+func (s *QuotaStore) List() []*pb.PrefixQuota {
+	statuses := s.Statuses()
+	ret := make([]*pb.PrefixQuota, len(statuses))
+	for i := range statuses {
+		ret[i] = statuses[i].Quota
+	}
+	return ret
+}
+
+// Status returns the quota and usage of the given prefix, or nil if it
+// has no quota.
+func (s *QuotaStore) Status(prefix []byte) *pb.PrefixQuotaStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if status, ok := s.quotas[string(prefix)]; ok {
+		return copyStatus(status)
+	}
+	return nil
+}
+
+// Statuses returns the quotas and usage of all prefixes ordered by prefix.
+func (s *QuotaStore) Statuses() []*pb.PrefixQuotaStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ret := make([]*pb.PrefixQuotaStatus, 0, len(s.quotas))
+	for _, status := range s.quotas {
+		ret = append(ret, copyStatus(status))
+	}
+	sort.Slice(ret, func(i, j int) bool { return bytes.Compare(ret[i].Quota.Prefix, ret[j].Quota.Prefix) < 0 })
+	return ret
+}
+
+// Empty returns true if no quota is set.
+func (s *QuotaStore) Empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.quotas) == 0
+}
+
+// Tracks returns true if key is under a prefix with a quota.
+func (s *QuotaStore) Tracks(key []byte) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.match(key)) != 0
+}
+
+// UnsafeTrack adds the change in usage of key to the usage of all prefixes
+// of the key with a quota, and persists it in tx.
+func (s *QuotaStore) UnsafeTrack(tx backend.BatchTx, key []byte, keys, size int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, status := range s.match(key) {
+		status.KeyCount += keys
+		status.ByteCount += size
+		s.be.MustUnsafePutQuota(tx, status)
+	}
+}
+
+// Check returns ErrPrefixQuotaExceeded if applying the given put and
+// delete range requests on top of rv would grow the usage of any prefix
+// beyond its quota. Requests are expected in the order they are applied;
+// writes which do not grow the usage of a prefix are always allowed, even
+// if the prefix is already over quota.
+func (s *QuotaStore) Check(ctx context.Context, rv mvcc.ReadView, ops []*pb.RequestOp) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.quotas) == 0 {
+		return nil
+	}
+
+	sizes, err := s.keySizes(ctx, rv, ops)
+	if err != nil {
+		return err
+	}
+	deltas := make(map[string]*Usage)
+	for _, ks := range sizes {
+		d := ks.delta()
+		if d == (Usage{}) {
+			continue
+		}
+		for _, status := range s.match(ks.key) {
+			u, ok := deltas[string(status.Quota.Prefix)]
+			if !ok {
+				u = &Usage{}
+				deltas[string(status.Quota.Prefix)] = u
+			}
+			u.Keys += d.Keys
+			u.Bytes += d.Bytes
+		}
+	}
+
+	for prefix, d := range deltas {
+		status := s.quotas[prefix]
+		q := status.Quota
+		growsKeys := q.MaxKeys > 0 && d.Keys > 0
+		growsBytes := q.MaxBytes > 0 && d.Bytes > 0
+		if (growsKeys && status.KeyCount+d.Keys > q.MaxKeys) || (growsBytes && status.ByteCount+d.Bytes > q.MaxBytes) {
+			s.lg.Warn(
+				"rejected write exceeding prefix quota",
+				zap.String("prefix", string(q.Prefix)),
+				zap.Int64("max-keys", q.MaxKeys),
+				zap.Int64("max-bytes", q.MaxBytes),
+				zap.Int64("key-count", status.KeyCount),
+				zap.Int64("byte-count", status.ByteCount),
+			)
+			return errors.ErrPrefixQuotaExceeded
+		}
+	}
+	return nil
+}
+
+// keySize is the size of a key and its value before and after a request,
+// or zero if the key does not exist.
+type keySize struct {
+	key        []byte
+	prev, next int64
+}
+
+func (ks *keySize) delta() Usage {
+	var keys int64
+	if ks.prev == 0 && ks.next != 0 {
+		keys = 1
+	} else if ks.prev != 0 && ks.next == 0 {
+		keys = -1
+	}
+	return Usage{Keys: keys, Bytes: ks.next - ks.prev}
+}
+
+// keySizes returns the size before and after ops are applied on top of
+// rv of every key with a quota the ops write, in the order first written.
+func (s *QuotaStore) keySizes(ctx context.Context, rv mvcc.ReadView, ops []*pb.RequestOp) ([]*keySize, error) {
+	var ret []*keySize
+	sizes := make(map[string]*keySize)
+	load := func(key []byte, size int64) *keySize {
+		ks, ok := sizes[string(key)]
+		if !ok {
+			ks = &keySize{key: key, prev: size, next: size}
+			sizes[string(key)] = ks
+			ret = append(ret, ks)
+		}
+		return ks
+	}
+
+	for _, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			p := tv.RequestPut
+			if len(s.match(p.Key)) == 0 {
+				continue
+			}
+			ks, ok := sizes[string(p.Key)]
+			if !ok {
+				rr, err := rv.Range(ctx, p.Key, nil, mvcc.RangeOptions{})
+				if err != nil {
+					return nil, err
+				}
+				var size int64
+				if len(rr.KVs) != 0 {
+					size = int64(len(p.Key) + len(rr.KVs[0].Value))
+				}
+				ks = load(p.Key, size)
+			}
+			if p.IgnoreValue {
+				// the put keeps the value, or fails with ErrKeyNotFound
+				continue
+			}
+			ks.next = int64(len(p.Key) + len(p.Value))
+		case *pb.RequestOp_RequestDeleteRange:
+			dr := tv.RequestDeleteRange
+			end := dr.RangeEnd
+			if len(end) == 1 && end[0] == 0 {
+				end = []byte{}
+			}
+			if !s.overlaps(dr.Key, end) {
+				continue
+			}
+			rr, err := rv.Range(ctx, dr.Key, end, mvcc.RangeOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for i := range rr.KVs {
+				if kv := rr.KVs[i]; len(s.match(kv.Key)) != 0 {
+					load(kv.Key, int64(len(kv.Key)+len(kv.Value)))
+				}
+			}
+			for _, ks := range ret {
+				if inRange(ks.key, dr.Key, end) {
+					ks.next = 0
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// match returns the quotas of all prefixes of the given key.
+func (s *QuotaStore) match(key []byte) (ret []*pb.PrefixQuotaStatus) {
+	for _, status := range s.quotas {
+		if bytes.HasPrefix(key, status.Quota.Prefix) {
+			ret = append(ret, status)
+		}
+	}
+	return ret
+}
+
+// overlaps returns true if the range [key, end) contains a key under a
+// prefix with a quota.
+func (s *QuotaStore) overlaps(key, end []byte) bool {
+	for _, status := range s.quotas {
+		prefix := status.Quota.Prefix
+		if end == nil {
+			if bytes.HasPrefix(key, prefix) {
+				return true
+			}
+			continue
+		}
+		pend := prefixEnd(prefix)
+		if (len(pend) == 0 || bytes.Compare(key, pend) < 0) && (len(end) == 0 || bytes.Compare(prefix, end) < 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *QuotaStore) restore() error {
+	s.be.CreateQuotaBucket()
+	qs, err := s.be.GetAllQuotas()
+	if err != nil {
+		return err
+	}
+	for _, q := range qs {
+		s.quotas[string(q.Quota.Prefix)] = q
+	}
+	s.be.ForceCommit()
+	return err
+}
+
+func copyStatus(status *pb.PrefixQuotaStatus) *pb.PrefixQuotaStatus {
+	return &pb.PrefixQuotaStatus{Quota: status.Quota, KeyCount: status.KeyCount, ByteCount: status.ByteCount}
+}
+
+// Usage is the storage used by the keys under a prefix.
+type Usage struct {
+	// Keys is the number of keys.
+	Keys int64
+	// Bytes is the total size of the keys and their values.
+	Bytes int64
+}
+
+// ReadUsage reads the usage of the given prefix from rv. The byte count is
+// only computed if withBytes is set, as it requires reading all values.
+func ReadUsage(ctx context.Context, rv mvcc.ReadView, prefix []byte, withBytes bool) (Usage, error) {
+	rr, err := rv.Range(ctx, prefix, prefixEnd(prefix), mvcc.RangeOptions{Count: !withBytes})
+	if err != nil {
+		return Usage{}, err
+	}
+	u := Usage{Keys: int64(rr.Count)}
+	for i := range rr.KVs {
+		u.Bytes += int64(len(rr.KVs[i].Key) + len(rr.KVs[i].Value))
+	}
+	return u, nil
+}
+
+// inRange returns true if key is in the range [start, end). A nil end
+// is the single key start; an empty end is the end of the keyspace.
+func inRange(key, start, end []byte) bool {
+	if end == nil {
+		return bytes.Equal(key, start)
+	}
+	return bytes.Compare(key, start) >= 0 && (len(end) == 0 || bytes.Compare(key, end) < 0)
+}
+
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// prefix is all 0xff, range to the end of the keyspace
+	return []byte{}
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestQuotaStoreRestore(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(lg, b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	s.Put([]byte("a/1"), []byte("12"), lease.NoLease)
+	qs, err := NewQuotaStore(lg, schema.NewQuotaBackend(lg, b))
+	require.NoError(t, err)
+	s.SetUsageTracker(qs)
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("b/"), MaxKeys: 10})
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("a/"), MaxBytes: 100})
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("c/"), MaxKeys: 1})
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("c/")})
+	s.Put([]byte("b/1"), []byte("1"), lease.NoLease)
+	b.ForceCommit()
+
+	qs, err = NewQuotaStore(lg, schema.NewQuotaBackend(lg, b))
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.PrefixQuota{
+		{Prefix: []byte("a/"), MaxBytes: 100},
+		{Prefix: []byte("b/"), MaxKeys: 10},
+	}, qs.List())
+	assert.Equal(t, []*pb.PrefixQuotaStatus{
+		{Quota: &pb.PrefixQuota{Prefix: []byte("a/"), MaxBytes: 100}, KeyCount: 1, ByteCount: 5},
+		{Quota: &pb.PrefixQuota{Prefix: []byte("b/"), MaxKeys: 10}, KeyCount: 1, ByteCount: 4},
+	}, qs.Statuses())
+	assert.Nil(t, qs.Get([]byte("c/")))
+	assert.Nil(t, qs.Status([]byte("c/")))
+}
+
+func TestQuotaStoreTrack(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(lg, b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	qs, err := NewQuotaStore(lg, schema.NewQuotaBackend(lg, b))
+	require.NoError(t, err)
+	s.SetUsageTracker(qs)
+	s.Put([]byte("t/a"), []byte("1"), lease.NoLease)
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("t/"), MaxKeys: 10})
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("t/sub/"), MaxKeys: 10})
+
+	s.Put([]byte("t/a"), []byte("123"), lease.NoLease)
+	s.Put([]byte("t/sub/a"), []byte("1"), lease.NoLease)
+	s.Put([]byte("u/a"), []byte("1"), lease.NoLease)
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("t/b"), []byte("1"), lease.NoLease)
+	txn.Put([]byte("t/b"), []byte("12"), lease.NoLease)
+	txn.DeleteRange([]byte("t/a"), nil)
+	txn.End()
+
+	// the usage matches a scan of the prefix
+	rv := s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	defer rv.End()
+	for _, prefix := range []string{"t/", "t/sub/"} {
+		u, err := ReadUsage(context.TODO(), rv, []byte(prefix), true)
+		require.NoError(t, err)
+		status := qs.Status([]byte(prefix))
+		assert.Equal(t, u, Usage{Keys: status.KeyCount, Bytes: status.ByteCount}, prefix)
+	}
+	assert.Equal(t, int64(2), qs.Status([]byte("t/")).KeyCount)
+}
+
+func TestQuotaStoreCheck(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(lg, b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	qs, err := NewQuotaStore(lg, schema.NewQuotaBackend(lg, b))
+	require.NoError(t, err)
+	s.SetUsageTracker(qs)
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("t/"), MaxKeys: 2, MaxBytes: 20})
+	set(t, s, qs, &pb.PrefixQuota{Prefix: []byte("t/sub/"), MaxKeys: 1})
+
+	s.Put([]byte("t/a"), []byte("12345"), lease.NoLease)
+
+	put := func(p *pb.PutRequest) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: p}}
+	}
+	del := func(key, end string) *pb.RequestOp {
+		dr := &pb.DeleteRangeRequest{Key: []byte(key)}
+		if end != "" {
+			dr.RangeEnd = []byte(end)
+		}
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: dr}}
+	}
+	tcs := []struct {
+		name    string
+		ops     []*pb.RequestOp
+		wantErr error
+	}{
+		{
+			name: "untracked key",
+			ops:  []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("u/a"), Value: make([]byte, 100)})},
+		},
+		{
+			name: "new key within quota",
+			ops:  []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/b"), Value: []byte("1")})},
+		},
+		{
+			name:    "too many keys",
+			ops:     []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/b")}), put(&pb.PutRequest{Key: []byte("t/c")})},
+			wantErr: errors.ErrPrefixQuotaExceeded,
+		},
+		{
+			name: "repeated puts to the same key",
+			ops:  []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/b")}), put(&pb.PutRequest{Key: []byte("t/b")})},
+		},
+		{
+			name:    "too many bytes",
+			ops:     []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/a"), Value: make([]byte, 18)})},
+			wantErr: errors.ErrPrefixQuotaExceeded,
+		},
+		{
+			name: "shrinking value",
+			ops:  []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/a"), Value: []byte("1")})},
+		},
+		{
+			name: "ignore value",
+			ops:  []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/a"), IgnoreValue: true})},
+		},
+		{
+			name:    "nested prefix",
+			ops:     []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/sub/a")}), put(&pb.PutRequest{Key: []byte("t/sub/b")})},
+			wantErr: errors.ErrPrefixQuotaExceeded,
+		},
+		{
+			name: "delete makes room",
+			ops:  []*pb.RequestOp{del("t/a", ""), put(&pb.PutRequest{Key: []byte("t/b")}), put(&pb.PutRequest{Key: []byte("t/c")})},
+		},
+		{
+			name: "delete range makes room",
+			ops:  []*pb.RequestOp{del("t/", "t0"), put(&pb.PutRequest{Key: []byte("t/b")}), put(&pb.PutRequest{Key: []byte("t/c")})},
+		},
+		{
+			name:    "delete before put of the same key",
+			ops:     []*pb.RequestOp{put(&pb.PutRequest{Key: []byte("t/b")}), del("t/b", ""), put(&pb.PutRequest{Key: []byte("t/c")}), put(&pb.PutRequest{Key: []byte("t/d")})},
+			wantErr: errors.ErrPrefixQuotaExceeded,
+		},
+		{
+			name:    "delete of another prefix",
+			ops:     []*pb.RequestOp{del("u/", "\x00"), put(&pb.PutRequest{Key: []byte("t/b")}), put(&pb.PutRequest{Key: []byte("t/c")})},
+			wantErr: errors.ErrPrefixQuotaExceeded,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			rv := s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+			defer rv.End()
+			assert.Equal(t, tc.wantErr, qs.Check(context.TODO(), rv, tc.ops))
+		})
+	}
+}
+
+func set(t *testing.T, s mvcc.KV, qs *QuotaStore, q *pb.PrefixQuota) {
+	rv := s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	defer rv.End()
+	require.NoError(t, qs.Set(context.TODO(), rv, q))
+}
+
+func TestReadUsage(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(lg, b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	s.Put([]byte("t/a"), []byte("1"), lease.NoLease)
+	s.Put([]byte("t/b"), []byte("12"), lease.NoLease)
+	s.Put([]byte("u"), []byte("123"), lease.NoLease)
+	s.Put([]byte("\xff\xff"), []byte("1234"), lease.NoLease)
+
+	rv := s.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	defer rv.End()
+
+	u, err := ReadUsage(context.TODO(), rv, []byte("t/"), true)
+	require.NoError(t, err)
+	assert.Equal(t, Usage{Keys: 2, Bytes: 9}, u)
+
+	u, err = ReadUsage(context.TODO(), rv, []byte("t/"), false)
+	require.NoError(t, err)
+	assert.Equal(t, Usage{Keys: 2}, u)
+
+	u, err = ReadUsage(context.TODO(), rv, []byte("\xff"), true)
+	require.NoError(t, err)
+	assert.Equal(t, Usage{Keys: 1, Bytes: 6}, u)
+}
+
+func TestIsSupported(t *testing.T) {
+	tests := []struct {
+		cv   *semver.Version
+		want bool
+	}{
+		{nil, false},
+		{&version.V3_5, false},
+		{&version.V3_6, true},
+	}
+	for i, tt := range tests {
+		if got := IsSupported(tt.cv); got != tt.want {
+			t.Errorf("#%d: supported = %t, want %t", i, got, tt.want)
+		}
+	}
+}
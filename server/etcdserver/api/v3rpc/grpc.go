@@ -44,9 +44,14 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 	}
 	chainUnaryInterceptors := []grpc.UnaryServerInterceptor{
 		newLogUnaryInterceptor(s),
+	}
+	if s.Cfg.AuditLogger != nil {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newAuditUnaryInterceptor(s))
+	}
+	chainUnaryInterceptors = append(chainUnaryInterceptors,
 		newUnaryInterceptor(s),
 		grpc_prometheus.UnaryServerInterceptor,
-	}
+	)
 	if interceptor != nil {
 		chainUnaryInterceptors = append(chainUnaryInterceptors, interceptor)
 	}
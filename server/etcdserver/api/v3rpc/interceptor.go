@@ -24,6 +24,7 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3audit"
 	"go.etcd.io/raft/v3"
 
 	"go.uber.org/zap"
@@ -88,6 +89,40 @@ func newLogUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerIntercepto
 	}
 }
 
+// newAuditUnaryInterceptor records mutating and auth requests, including those
+// rejected by later interceptors, to the audit log.
+func newAuditUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
+	verbs, err := v3audit.ParseVerbs(s.Cfg.AuditLogVerbs)
+	if err != nil {
+		// verbs are validated with the rest of the configuration
+		s.Logger().Panic("invalid audit log verbs", zap.Error(err))
+	}
+	auditor := v3audit.NewAuditor(s.Cfg.AuditLogger, s.Cfg.AuditLogPrefixes, verbs)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ev, ok := v3audit.RequestEvent(req)
+		if !ok || !auditor.Audited(ev.Verb, ev.Keys) {
+			return handler(ctx, req)
+		}
+
+		ev.Method = info.FullMethod
+		if peerInfo, ok := peer.FromContext(ctx); ok {
+			ev.Remote = peerInfo.Addr.String()
+		}
+		// an invalid token leaves the user empty; the request itself then
+		// fails and is recorded as such
+		if ai, aerr := s.AuthInfoFromCtx(ctx); aerr == nil && ai != nil {
+			ev.User = ai.Username
+		}
+
+		resp, err := handler(ctx, req)
+		ev.Revision = v3audit.ResponseRevision(resp)
+		ev.Err = err
+		auditor.Record(ev)
+		return resp, err
+	}
+}
+
 func logUnaryRequestStats(ctx context.Context, lg *zap.Logger, warnLatency time.Duration, info *grpc.UnaryServerInfo, startTime time.Time, req interface{}, resp interface{}) {
 	duration := time.Since(startTime)
 	var enabledDebugLevel, expensiveRequest bool
@@ -167,6 +202,17 @@ func logUnaryRequestStats(ctx context.Context, lg *zap.Logger, warnLatency time.
 			respCount = 0
 			respSize = _resp.Size()
 		}
+	case *pb.KeyHistoryResponse:
+		_req, ok := req.(*pb.KeyHistoryRequest)
+		if ok {
+			reqCount = 0
+			reqSize = _req.Size()
+			reqContent = _req.String()
+		}
+		if _resp != nil {
+			respCount = int64(len(_resp.GetEvents()))
+			respSize = _resp.Size()
+		}
 	default:
 		reqCount = -1
 		reqSize = -1
@@ -111,6 +111,20 @@ func (s *kvServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.Co
 	return resp, nil
 }
 
+func (s *kvServer) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	if err := checkKeyHistoryRequest(r); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.kv.KeyHistory(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	s.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func checkRangeRequest(r *pb.RangeRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
@@ -127,6 +141,19 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 	return nil
 }
 
+func checkKeyHistoryRequest(r *pb.KeyHistoryRequest) error {
+	if len(r.Key) == 0 {
+		return rpctypes.ErrGRPCEmptyKey
+	}
+	if r.StartRevision < 0 || r.EndRevision < 0 || r.Limit < 0 {
+		return rpctypes.ErrGRPCInvalidHistoryRange
+	}
+	if r.EndRevision != 0 && r.EndRevision < r.StartRevision {
+		return rpctypes.ErrGRPCInvalidHistoryRange
+	}
+	return nil
+}
+
 func checkPutRequest(r *pb.PutRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
@@ -137,6 +164,9 @@ func checkPutRequest(r *pb.PutRequest) error {
 	if r.IgnoreLease && r.Lease != 0 {
 		return rpctypes.ErrGRPCLeaseProvided
 	}
+	if r.Ttl < 0 || r.ExpireTime < 0 {
+		return rpctypes.ErrGRPCInvalidKeyTTL
+	}
 	return nil
 }
 
@@ -59,6 +59,39 @@ func (ls *LeaseServer) LeaseRevoke(ctx context.Context, rr *pb.LeaseRevokeReques
 	return resp, nil
 }
 
+func (ls *LeaseServer) LeaseAttach(ctx context.Context, rr *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	if err := checkLeaseKeys(rr.Keys); err != nil {
+		return nil, err
+	}
+	resp, err := ls.le.LeaseAttach(ctx, rr)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ls.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ls *LeaseServer) LeaseDetach(ctx context.Context, rr *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	if err := checkLeaseKeys(rr.Keys); err != nil {
+		return nil, err
+	}
+	resp, err := ls.le.LeaseDetach(ctx, rr)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ls.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func checkLeaseKeys(keys [][]byte) error {
+	for _, k := range keys {
+		if len(k) == 0 {
+			return rpctypes.ErrGRPCEmptyKey
+		}
+	}
+	return nil
+}
+
 func (ls *LeaseServer) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
 	resp, err := ls.le.LeaseTimeToLive(ctx, rr)
 	if err != nil && err != lease.ErrLeaseNotFound {
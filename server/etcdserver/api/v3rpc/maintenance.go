@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"io"
+	"os"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -56,6 +57,16 @@ type Downgrader interface {
 	Downgrade(ctx context.Context, dr *pb.DowngradeRequest) (*pb.DowngradeResponse, error)
 }
 
+type Quotaer interface {
+	QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error)
+	QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error)
+	QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error)
+}
+
+type CompactEstimator interface {
+	CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest) (*pb.CompactEstimateResponse, error)
+}
+
 type LeaderTransferrer interface {
 	MoveLeader(ctx context.Context, lead, target uint64) error
 }
@@ -74,11 +85,16 @@ type maintenanceServer struct {
 	hdr    header
 	cs     ClusterStatusGetter
 	d      Downgrader
+	q      Quotaer
+	ce     CompactEstimator
 	vs     serverversion.Server
+	kg     KVGetter
+	// snapDir holds the temporary files of incremental snapshots
+	snapDir string
 }
 
 func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
-	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, hasher: s.KV().HashStorage(), bg: s, a: s, lt: s, hdr: newHeader(s), cs: s, d: s, vs: etcdserver.NewServerVersionAdapter(s)}
+	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, hasher: s.KV().HashStorage(), bg: s, a: s, lt: s, hdr: newHeader(s), cs: s, d: s, q: s, ce: s, vs: etcdserver.NewServerVersionAdapter(s), kg: s, snapDir: s.Cfg.SnapDir()}
 	if srv.lg == nil {
 		srv.lg = zap.NewNop()
 	}
@@ -86,8 +102,13 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
 }
 
 func (ms *maintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
-	ms.lg.Info("starting defragment")
-	err := ms.bg.Backend().Defrag()
+	ms.lg.Info("starting defragment", zap.Bool("online", sr.Online))
+	var err error
+	if sr.Online {
+		err = ms.bg.Backend().DefragOnline()
+	} else {
+		err = ms.bg.Backend().Defrag()
+	}
 	if err != nil {
 		ms.lg.Warn("failed to defragment", zap.Error(err))
 		return nil, err
@@ -118,20 +139,35 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 		pw.Close()
 	}()
 
-	// record SHA digest of snapshot data
-	// used for integrity checks during snapshot restore operation
-	h := sha256.New()
-
-	sent := int64(0)
+	start := time.Now()
 	total := snap.Size()
 	size := humanize.Bytes(uint64(total))
-
-	start := time.Now()
 	ms.lg.Info("sending database snapshot to client",
 		zap.Int64("total-bytes", total),
 		zap.String("size", size),
 		zap.String("storage-version", storageVersion),
 	)
+	if err := ms.sendSnapshot(srv, pr, total, nil, storageVersion); err != nil {
+		return err
+	}
+
+	ms.lg.Info("successfully sent database snapshot to client",
+		zap.Int64("total-bytes", total),
+		zap.String("size", size),
+		zap.Duration("took", time.Since(start)),
+		zap.String("storage-version", storageVersion),
+	)
+	return nil
+}
+
+// sendSnapshot streams total bytes of snapshot data read from r, followed by
+// their SHA256 digest. If hdr is non-nil it is set on the first response.
+func (ms *maintenanceServer) sendSnapshot(srv pb.Maintenance_SnapshotServer, r io.Reader, total int64, hdr *pb.ResponseHeader, storageVersion string) error {
+	// record SHA digest of snapshot data
+	// used for integrity checks during snapshot restore operation
+	h := sha256.New()
+
+	sent := int64(0)
 	for total-sent > 0 {
 		// buffer just holds read bytes from stream
 		// response size is multiple of OS page size, fetched in boltdb
@@ -140,7 +176,7 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 		// Therefore the buffer can not be safely reused between Send operations
 		buf := make([]byte, snapshotSendBufferSize)
 
-		n, err := io.ReadFull(pr, buf)
+		n, err := io.ReadFull(r, buf)
 		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
 			return togRPCError(err)
 		}
@@ -155,10 +191,12 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 		// No, the client will still receive non-nil response
 		// until server closes the stream with EOF
 		resp := &pb.SnapshotResponse{
+			Header:         hdr,
 			RemainingBytes: uint64(total - sent),
 			Blob:           buf[:n],
 			Version:        storageVersion,
 		}
+		hdr = nil
 		if err = srv.Send(resp); err != nil {
 			return togRPCError(err)
 		}
@@ -177,13 +215,63 @@ func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance
 	if err := srv.Send(hresp); err != nil {
 		return togRPCError(err)
 	}
+	return nil
+}
 
-	ms.lg.Info("successfully sent database snapshot to client",
+func (ms *maintenanceServer) SnapshotIncremental(sr *pb.SnapshotIncrementalRequest, srv pb.Maintenance_SnapshotIncrementalServer) error {
+	if sr.BaseRevision <= 0 {
+		return rpctypes.ErrGRPCInvalidBaseRevision
+	}
+	ver := schema.ReadStorageVersion(ms.bg.Backend().ReadTx())
+	storageVersion := ""
+	if ver != nil {
+		storageVersion = ver.String()
+	}
+
+	f, err := os.CreateTemp(ms.snapDir, "incremental-*.tmp")
+	if err != nil {
+		return togRPCError(err)
+	}
+	path := f.Name()
+	f.Close()
+	defer os.Remove(path)
+
+	start := time.Now()
+	rev, err := ms.kg.KV().SaveIncremental(sr.BaseRevision, path)
+	if err != nil {
+		return togRPCError(err)
+	}
+	f, err = os.Open(path)
+	if err != nil {
+		return togRPCError(err)
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return togRPCError(err)
+	}
+	total := fi.Size()
+	size := humanize.Bytes(uint64(total))
+
+	ms.lg.Info("sending incremental database snapshot to client",
+		zap.Int64("base-revision", sr.BaseRevision),
+		zap.Int64("revision", rev),
 		zap.Int64("total-bytes", total),
 		zap.String("size", size),
-		zap.Duration("took", time.Since(start)),
 		zap.String("storage-version", storageVersion),
 	)
+	hdr := &pb.ResponseHeader{Revision: rev}
+	ms.hdr.fill(hdr)
+	if err = ms.sendSnapshot(srv, f, total, hdr, storageVersion); err != nil {
+		return err
+	}
+	ms.lg.Info("successfully sent incremental database snapshot to client",
+		zap.Int64("base-revision", sr.BaseRevision),
+		zap.Int64("revision", rev),
+		zap.Int64("total-bytes", total),
+		zap.String("size", size),
+		zap.Duration("took", time.Since(start)),
+	)
 	return nil
 }
 
@@ -242,6 +330,14 @@ func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (
 	if storageVersion := ms.vs.GetStorageVersion(); storageVersion != nil {
 		resp.StorageVersion = storageVersion.String()
 	}
+	if p := ms.bg.Backend().DefragProgress(); p.Phase != backend.DefragPhaseNone {
+		resp.Defrag = &pb.DefragStatus{
+			Phase:         string(p.Phase),
+			CopiedKeys:    p.CopiedKeys,
+			TotalKeys:     p.TotalKeys,
+			PendingWrites: p.PendingWrites,
+		}
+	}
 	if resp.Leader == raft.None {
 		resp.Errors = append(resp.Errors, errors.ErrNoLeader.Error())
 	}
@@ -272,6 +368,63 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 	return resp, nil
 }
 
+func (ms *maintenanceServer) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	if err := checkQuotaSetRequest(r); err != nil {
+		return nil, err
+	}
+	resp, err := ms.q.QuotaSet(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	if resp.Header == nil {
+		resp.Header = &pb.ResponseHeader{}
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	if len(r.Prefix) == 0 {
+		return nil, rpctypes.ErrGRPCQuotaPrefixEmpty
+	}
+	resp, err := ms.q.QuotaGet(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	resp.Header = &pb.ResponseHeader{}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	resp, err := ms.q.QuotaList(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	resp.Header = &pb.ResponseHeader{}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest) (*pb.CompactEstimateResponse, error) {
+	resp, err := ms.ce.CompactEstimate(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func checkQuotaSetRequest(r *pb.QuotaSetRequest) error {
+	if r.Quota == nil || len(r.Quota.Prefix) == 0 {
+		return rpctypes.ErrGRPCQuotaPrefixEmpty
+	}
+	if r.Quota.MaxKeys < 0 || r.Quota.MaxBytes < 0 {
+		return rpctypes.ErrGRPCInvalidQuota
+	}
+	return nil
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	*AuthAdmin
@@ -293,6 +446,14 @@ func (ams *authMaintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Mainte
 	return ams.maintenanceServer.Snapshot(sr, srv)
 }
 
+func (ams *authMaintenanceServer) SnapshotIncremental(sr *pb.SnapshotIncrementalRequest, srv pb.Maintenance_SnapshotIncrementalServer) error {
+	if err := ams.isPermitted(srv.Context()); err != nil {
+		return err
+	}
+
+	return ams.maintenanceServer.SnapshotIncremental(sr, srv)
+}
+
 func (ams *authMaintenanceServer) Hash(ctx context.Context, r *pb.HashRequest) (*pb.HashResponse, error) {
 	if err := ams.isPermitted(ctx); err != nil {
 		return nil, err
@@ -331,3 +492,35 @@ func (ams *authMaintenanceServer) Downgrade(ctx context.Context, r *pb.Downgrade
 
 	return ams.maintenanceServer.Downgrade(ctx, r)
 }
+
+func (ams *authMaintenanceServer) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, err
+	}
+
+	return ams.maintenanceServer.QuotaSet(ctx, r)
+}
+
+func (ams *authMaintenanceServer) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, err
+	}
+
+	return ams.maintenanceServer.QuotaGet(ctx, r)
+}
+
+func (ams *authMaintenanceServer) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, err
+	}
+
+	return ams.maintenanceServer.QuotaList(ctx, r)
+}
+
+func (ams *authMaintenanceServer) CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest) (*pb.CompactEstimateResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, err
+	}
+
+	return ams.maintenanceServer.CompactEstimate(ctx, r)
+}
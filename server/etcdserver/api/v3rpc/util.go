@@ -47,6 +47,12 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrNoSpace:         rpctypes.ErrGRPCNoSpace,
 	errors.ErrTooManyRequests: rpctypes.ErrTooManyRequests,
 
+	errors.ErrPrefixQuotaExceeded: rpctypes.ErrGRPCPrefixQuotaExceeded,
+	errors.ErrQuotaNotFound:       rpctypes.ErrGRPCQuotaNotFound,
+	errors.ErrQuotaNotSupported:   rpctypes.ErrGRPCQuotaNotSupported,
+
+	errors.ErrRetentionNotSupported: rpctypes.ErrGRPCRetentionNotSupported,
+
 	errors.ErrNoLeader:                   rpctypes.ErrGRPCNoLeader,
 	errors.ErrNotLeader:                  rpctypes.ErrGRPCNotLeader,
 	errors.ErrLeaderChanged:              rpctypes.ErrGRPCLeaderChanged,
@@ -57,6 +63,7 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrTimeoutWaitAppliedIndex:    rpctypes.ErrGRPCTimeoutWaitAppliedIndex,
 	errors.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
+	errors.ErrInvalidContinueToken:       rpctypes.ErrGRPCInvalidContinueToken,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
 
@@ -66,27 +73,32 @@ var toGRPCErrorMap = map[error]error{
 	version.ErrDowngradeInProcess:            rpctypes.ErrGRPCDowngradeInProcess,
 	version.ErrNoInflightDowngrade:           rpctypes.ErrGRPCNoInflightDowngrade,
 
-	lease.ErrLeaseNotFound:    rpctypes.ErrGRPCLeaseNotFound,
-	lease.ErrLeaseExists:      rpctypes.ErrGRPCLeaseExist,
-	lease.ErrLeaseTTLTooLarge: rpctypes.ErrGRPCLeaseTTLTooLarge,
-
-	auth.ErrRootUserNotExist:     rpctypes.ErrGRPCRootUserNotExist,
-	auth.ErrRootRoleNotExist:     rpctypes.ErrGRPCRootRoleNotExist,
-	auth.ErrUserAlreadyExist:     rpctypes.ErrGRPCUserAlreadyExist,
-	auth.ErrUserEmpty:            rpctypes.ErrGRPCUserEmpty,
-	auth.ErrUserNotFound:         rpctypes.ErrGRPCUserNotFound,
-	auth.ErrRoleAlreadyExist:     rpctypes.ErrGRPCRoleAlreadyExist,
-	auth.ErrRoleNotFound:         rpctypes.ErrGRPCRoleNotFound,
-	auth.ErrRoleEmpty:            rpctypes.ErrGRPCRoleEmpty,
-	auth.ErrAuthFailed:           rpctypes.ErrGRPCAuthFailed,
-	auth.ErrPermissionNotGiven:   rpctypes.ErrGRPCPermissionNotGiven,
-	auth.ErrPermissionDenied:     rpctypes.ErrGRPCPermissionDenied,
-	auth.ErrRoleNotGranted:       rpctypes.ErrGRPCRoleNotGranted,
-	auth.ErrPermissionNotGranted: rpctypes.ErrGRPCPermissionNotGranted,
-	auth.ErrAuthNotEnabled:       rpctypes.ErrGRPCAuthNotEnabled,
-	auth.ErrInvalidAuthToken:     rpctypes.ErrGRPCInvalidAuthToken,
-	auth.ErrInvalidAuthMgmt:      rpctypes.ErrGRPCInvalidAuthMgmt,
-	auth.ErrAuthOldRevision:      rpctypes.ErrGRPCAuthOldRevision,
+	lease.ErrLeaseNotFound:       rpctypes.ErrGRPCLeaseNotFound,
+	lease.ErrLeaseExists:         rpctypes.ErrGRPCLeaseExist,
+	lease.ErrLeaseTTLTooLarge:    rpctypes.ErrGRPCLeaseTTLTooLarge,
+	lease.ErrParentLeaseNotFound: rpctypes.ErrGRPCParentLeaseNotFound,
+	lease.ErrAttachNotSupported:  rpctypes.ErrGRPCAttachNotSupported,
+	lease.ErrExpiryNotSupported:  rpctypes.ErrGRPCExpiryNotSupported,
+	lease.ErrParentNotSupported:  rpctypes.ErrGRPCParentNotSupported,
+
+	auth.ErrRootUserNotExist:       rpctypes.ErrGRPCRootUserNotExist,
+	auth.ErrRootRoleNotExist:       rpctypes.ErrGRPCRootRoleNotExist,
+	auth.ErrUserAlreadyExist:       rpctypes.ErrGRPCUserAlreadyExist,
+	auth.ErrUserEmpty:              rpctypes.ErrGRPCUserEmpty,
+	auth.ErrUserNotFound:           rpctypes.ErrGRPCUserNotFound,
+	auth.ErrRoleAlreadyExist:       rpctypes.ErrGRPCRoleAlreadyExist,
+	auth.ErrRoleNotFound:           rpctypes.ErrGRPCRoleNotFound,
+	auth.ErrRoleEmpty:              rpctypes.ErrGRPCRoleEmpty,
+	auth.ErrAuthFailed:             rpctypes.ErrGRPCAuthFailed,
+	auth.ErrPermissionNotGiven:     rpctypes.ErrGRPCPermissionNotGiven,
+	auth.ErrPermissionDenied:       rpctypes.ErrGRPCPermissionDenied,
+	auth.ErrRoleNotGranted:         rpctypes.ErrGRPCRoleNotGranted,
+	auth.ErrPermissionNotGranted:   rpctypes.ErrGRPCPermissionNotGranted,
+	auth.ErrPermissionNotSupported: rpctypes.ErrGRPCPermissionNotSupported,
+	auth.ErrAuthNotEnabled:         rpctypes.ErrGRPCAuthNotEnabled,
+	auth.ErrInvalidAuthToken:       rpctypes.ErrGRPCInvalidAuthToken,
+	auth.ErrInvalidAuthMgmt:        rpctypes.ErrGRPCInvalidAuthMgmt,
+	auth.ErrAuthOldRevision:        rpctypes.ErrGRPCAuthOldRevision,
 
 	// In sync with status.FromContextError
 	context.Canceled:         rpctypes.ErrGRPCCanceled,
@@ -144,6 +156,8 @@ func isRPCSupportedForLearner(req interface{}) bool {
 		return true
 	case *pb.RangeRequest:
 		return r.Serializable
+	case *pb.KeyHistoryRequest:
+		return r.Serializable
 	default:
 		return false
 	}
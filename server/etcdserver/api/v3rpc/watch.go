@@ -232,10 +232,10 @@ func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) error
 		return err
 	}
 	if authInfo == nil {
-		// if auth is enabled, IsRangePermitted() can cause an error
+		// if auth is enabled, IsWatchPermitted() can cause an error
 		authInfo = &auth.AuthInfo{}
 	}
-	return sws.ag.AuthStore().IsRangePermitted(authInfo, wcr.Key, wcr.RangeEnd)
+	return sws.ag.AuthStore().IsWatchPermitted(authInfo, wcr.Key, wcr.RangeEnd)
 }
 
 func (sws *serverWatchStream) recvLoop() error {
@@ -302,14 +302,16 @@ func (sws *serverWatchStream) recvLoop() error {
 				}
 			}
 
-			filters := FiltersFromRequest(creq)
-
 			wsrev := sws.watchStream.Rev()
 			rev := creq.StartRevision
 			if rev == 0 {
 				rev = wsrev + 1
 			}
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+			var id mvcc.WatchID
+			filters, err := FiltersFromRequest(creq)
+			if err == nil {
+				id, err = sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+			}
 			if err == nil {
 				sws.mu.Lock()
 				if creq.ProgressNotify {
@@ -593,9 +595,13 @@ func filterNoPut(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT
 }
 
+func filterNoLease(e mvccpb.Event) bool {
+	return e.Type == mvccpb.LEASE
+}
+
 // FiltersFromRequest returns "mvcc.FilterFunc" from a given watch create request.
-func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
-	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters))
+func FiltersFromRequest(creq *pb.WatchCreateRequest) ([]mvcc.FilterFunc, error) {
+	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters)+1)
 	for _, ft := range creq.Filters {
 		switch ft {
 		case pb.WatchCreateRequest_NOPUT:
@@ -605,5 +611,36 @@ func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
 		default:
 		}
 	}
-	return filters
+	// lease events are opt-in, as watchers predating them do not expect them
+	if !creq.LeaseEvents {
+		filters = append(filters, filterNoLease)
+	}
+
+	ef := creq.EventFilter
+	if ef == nil {
+		return filters, nil
+	}
+	if len(ef.KeySuffix) != 0 {
+		filters = append(filters, mvcc.NewKeySuffixFilter(ef.KeySuffix))
+	}
+	if ef.KeyGlob != "" {
+		f, err := mvcc.NewKeyGlobFilter(ef.KeyGlob)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	switch vm := ef.ValueMatch.(type) {
+	case *pb.WatchEventFilter_ValuePrefix:
+		filters = append(filters, mvcc.NewValuePrefixFilter(vm.ValuePrefix))
+	case *pb.WatchEventFilter_ValueEqual:
+		filters = append(filters, mvcc.NewValueFilter(vm.ValueEqual))
+	}
+	switch ef.Lease {
+	case pb.WatchEventFilter_ATTACHED:
+		filters = append(filters, mvcc.NewLeaseFilter(true))
+	case pb.WatchEventFilter_DETACHED:
+		filters = append(filters, mvcc.NewLeaseFilter(false))
+	}
+	return filters, nil
 }
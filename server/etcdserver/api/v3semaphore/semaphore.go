@@ -0,0 +1,60 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3semaphore
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+)
+
+type semaphoreServer struct {
+	c *clientv3.Client
+}
+
+func NewSemaphoreServer(c *clientv3.Client) v3semaphorepb.SemaphoreServer {
+	return &semaphoreServer{c}
+}
+
+func (ss *semaphoreServer) Acquire(ctx context.Context, req *v3semaphorepb.AcquireRequest) (*v3semaphorepb.AcquireResponse, error) {
+	s, err := concurrency.NewSession(
+		ss.c,
+		concurrency.WithLease(clientv3.LeaseID(req.Lease)),
+		concurrency.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.Orphan()
+	weight := req.Weight
+	if weight == 0 {
+		weight = 1
+	}
+	sm := concurrency.NewSemaphore(s, string(req.Name), req.Limit)
+	if err = sm.Acquire(ctx, weight); err != nil {
+		return nil, err
+	}
+	return &v3semaphorepb.AcquireResponse{Header: sm.Header(), Key: []byte(sm.Key())}, nil
+}
+
+func (ss *semaphoreServer) Release(ctx context.Context, req *v3semaphorepb.ReleaseRequest) (*v3semaphorepb.ReleaseResponse, error) {
+	resp, err := ss.c.Delete(ctx, string(req.Key))
+	if err != nil {
+		return nil, err
+	}
+	return &v3semaphorepb.ReleaseResponse{Header: resp.Header}, nil
+}
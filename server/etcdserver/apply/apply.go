@@ -19,6 +19,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -27,6 +28,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3quota"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	mvcctxn "go.etcd.io/etcd/server/v3/etcdserver/txn"
@@ -82,8 +84,15 @@ type applierV3 interface {
 
 	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error)
 
+	KeyExpire(r *pb.KeyExpireRequest) (*pb.KeyExpireResponse, error)
+
+	LeaseAttach(r *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error)
+	LeaseDetach(r *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error)
+
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
+	QuotaSet(r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error)
+
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
 
 	AuthEnable() (*pb.AuthEnableResponse, error)
@@ -119,6 +128,7 @@ type applierV3backend struct {
 	lg              *zap.Logger
 	kv              mvcc.KV
 	alarmStore      *v3alarm.AlarmStore
+	quotaStore      *v3quota.QuotaStore
 	authStore       auth.AuthStore
 	lessor          lease.Lessor
 	cluster         *membership.RaftCluster
@@ -133,6 +143,7 @@ func newApplierV3Backend(
 	lg *zap.Logger,
 	kv mvcc.KV,
 	alarmStore *v3alarm.AlarmStore,
+	quotaStore *v3quota.QuotaStore,
 	authStore auth.AuthStore,
 	lessor lease.Lessor,
 	cluster *membership.RaftCluster,
@@ -144,6 +155,7 @@ func newApplierV3Backend(
 		lg:                           lg,
 		kv:                           kv,
 		alarmStore:                   alarmStore,
+		quotaStore:                   quotaStore,
 		authStore:                    authStore,
 		lessor:                       lessor,
 		cluster:                      cluster,
@@ -158,6 +170,9 @@ func (a *applierV3backend) Apply(ctx context.Context, r *pb.InternalRaftRequest,
 }
 
 func (a *applierV3backend) Put(ctx context.Context, txn mvcc.TxnWrite, p *pb.PutRequest) (resp *pb.PutResponse, trace *traceutil.Trace, err error) {
+	if p.ExpireTime != 0 && !lease.IsExpirySupported(a.cluster.Version()) {
+		return nil, nil, lease.ErrExpiryNotSupported
+	}
 	return mvcctxn.Put(ctx, a.lg, a.lessor, a.kv, txn, p)
 }
 
@@ -170,6 +185,9 @@ func (a *applierV3backend) Range(ctx context.Context, txn mvcc.TxnRead, r *pb.Ra
 }
 
 func (a *applierV3backend) Txn(ctx context.Context, rt *pb.TxnRequest) (*pb.TxnResponse, *traceutil.Trace, error) {
+	if mvcctxn.IsTxnWithExpiry(rt) && !lease.IsExpirySupported(a.cluster.Version()) {
+		return nil, nil, lease.ErrExpiryNotSupported
+	}
 	return mvcctxn.Txn(ctx, a.lg, rt, a.txnModeWriteWithSharedBuffer, a.kv, a.lessor)
 }
 
@@ -181,7 +199,15 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 		traceutil.Field{Key: "revision", Value: compaction.Revision},
 	)
 
-	ch, err := a.kv.Compact(trace, compaction.Revision)
+	var retention []mvcc.Retention
+	// members before v3.6 ignore the retention, so it is ignored here as
+	// well until all members support it
+	if mvcc.IsRetentionSupported(a.cluster.Version()) {
+		for _, r := range compaction.Retention {
+			retention = append(retention, mvcc.Retention{Prefix: r.Prefix, Rev: r.Revision})
+		}
+	}
+	ch, err := a.kv.CompactWithRetention(trace, compaction.Revision, retention)
 	if err != nil {
 		return nil, ch, nil, err
 	}
@@ -192,7 +218,18 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 }
 
 func (a *applierV3backend) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
-	l, err := a.lessor.Grant(lease.LeaseID(lc.ID), lc.TTL)
+	var (
+		l   *lease.Lease
+		err error
+	)
+	if lc.Parent != 0 {
+		if !lease.IsParentSupported(a.cluster.Version()) {
+			return nil, lease.ErrParentNotSupported
+		}
+		l, err = a.lessor.GrantChild(lease.LeaseID(lc.ID), lease.LeaseID(lc.Parent), lc.TTL)
+	} else {
+		l, err = a.lessor.Grant(lease.LeaseID(lc.ID), lc.TTL)
+	}
 	resp := &pb.LeaseGrantResponse{}
 	if err == nil {
 		resp.ID = int64(l.ID)
@@ -217,6 +254,76 @@ func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.L
 	return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, nil
 }
 
+func (a *applierV3backend) LeaseAttach(r *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	if a.lessor.Lookup(lease.LeaseID(r.ID)) == nil {
+		return nil, lease.ErrLeaseNotFound
+	}
+	n, err := a.setLease(r.Keys, lease.LeaseID(r.ID))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseAttachResponse{Header: a.newHeader(), Attached: n}, nil
+}
+
+func (a *applierV3backend) LeaseDetach(r *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	n, err := a.setLease(r.Keys, lease.NoLease)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LeaseDetachResponse{Header: a.newHeader(), Detached: n}, nil
+}
+
+// setLease moves the keys to the given lease in a single revision without
+// changing their values. Either all keys exist and are moved, or none is.
+func (a *applierV3backend) setLease(keys [][]byte, id lease.LeaseID) (int64, error) {
+	if !lease.IsAttachSupported(a.cluster.Version()) {
+		return 0, lease.ErrAttachNotSupported
+	}
+	txn := a.kv.Write(traceutil.TODO())
+	defer txn.End()
+	for _, k := range keys {
+		rr, err := txn.Range(context.TODO(), k, nil, mvcc.RangeOptions{Limit: 1})
+		if err != nil {
+			return 0, err
+		}
+		if len(rr.KVs) == 0 {
+			return 0, errors.ErrKeyNotFound
+		}
+	}
+	var n int64
+	for _, k := range keys {
+		m, _ := txn.SetLease(k, id)
+		n += m
+	}
+	return n, nil
+}
+
+// KeyExpire deletes the expired keys that have not been modified since
+// their expiry was observed by the leader. All keys are deleted in a
+// single revision.
+func (a *applierV3backend) KeyExpire(r *pb.KeyExpireRequest) (*pb.KeyExpireResponse, error) {
+	if !lease.IsExpirySupported(a.cluster.Version()) {
+		return nil, lease.ErrExpiryNotSupported
+	}
+	resp := &pb.KeyExpireResponse{}
+	txn := a.kv.Write(traceutil.TODO())
+	for _, k := range r.Keys {
+		rr, err := txn.Range(context.TODO(), k.Key, nil, mvcc.RangeOptions{})
+		if err != nil {
+			txn.End()
+			return nil, err
+		}
+		if len(rr.KVs) == 0 || rr.KVs[0].ModRevision != k.ModRevision || rr.KVs[0].ExpireTime == 0 {
+			continue
+		}
+		n, _ := txn.DeleteRange(k.Key, nil)
+		resp.Deleted += n
+	}
+	txn.End()
+	resp.Header = a.newHeader()
+	return resp, nil
+}
+
 func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	resp := &pb.AlarmResponse{}
 
@@ -246,6 +353,19 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 	return resp, nil
 }
 
+func (a *applierV3backend) QuotaSet(r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	if !v3quota.IsSupported(a.cluster.Version()) {
+		return nil, errors.ErrQuotaNotSupported
+	}
+	rv := a.kv.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	err := a.quotaStore.Set(context.TODO(), rv, r.Quota)
+	rv.End()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.QuotaSetResponse{Header: a.newHeader()}, nil
+}
+
 type applierV3Capped struct {
 	applierV3
 	q serverstorage.BackendQuota
@@ -270,6 +390,14 @@ func (a *applierV3Capped) LeaseGrant(_ *pb.LeaseGrantRequest) (*pb.LeaseGrantRes
 	return nil, errors.ErrNoSpace
 }
 
+func (a *applierV3Capped) LeaseAttach(_ *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	return nil, errors.ErrNoSpace
+}
+
+func (a *applierV3Capped) LeaseDetach(_ *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	return nil, errors.ErrNoSpace
+}
+
 func (a *applierV3backend) AuthEnable() (*pb.AuthEnableResponse, error) {
 	err := a.authStore.AuthEnable()
 	if err != nil {
@@ -355,6 +483,9 @@ func (a *applierV3backend) RoleAdd(r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddRes
 }
 
 func (a *applierV3backend) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
+	if !auth.IsPermissionSupported(a.cluster.Version(), r.Perm) {
+		return nil, auth.ErrPermissionNotSupported
+	}
 	resp, err := a.authStore.RoleGrantPermission(r)
 	if resp != nil {
 		resp.Header = a.newHeader()
@@ -371,6 +502,10 @@ func (a *applierV3backend) RoleGet(r *pb.AuthRoleGetRequest) (*pb.AuthRoleGetRes
 }
 
 func (a *applierV3backend) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
+	// members before v3.6 drop the deny field, revoking the grant instead
+	if r.Deny && !auth.IsPermissionSupported(a.cluster.Version(), &authpb.Permission{Deny: true}) {
+		return nil, auth.ErrPermissionNotSupported
+	}
 	resp, err := a.authStore.RoleRevokePermission(r)
 	if resp != nil {
 		resp.Header = a.newHeader()
@@ -474,6 +609,44 @@ func (a *quotaApplierV3) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantRes
 	return resp, err
 }
 
+type prefixQuotaApplierV3 struct {
+	applierV3
+	kv mvcc.KV
+	qs *v3quota.QuotaStore
+}
+
+// newPrefixQuotaApplierV3 creates an applyV3 that rejects Puts and
+// transactions which would grow the usage of a key prefix beyond its quota.
+// Unlike the backend quota, the request fails before it is applied and no
+// alarm is raised, so other prefixes remain writable.
+func newPrefixQuotaApplierV3(kv mvcc.KV, qs *v3quota.QuotaStore, app applierV3) applierV3 {
+	return &prefixQuotaApplierV3{app, kv, qs}
+}
+
+func (a *prefixQuotaApplierV3) Put(ctx context.Context, txn mvcc.TxnWrite, p *pb.PutRequest) (*pb.PutResponse, *traceutil.Trace, error) {
+	op := &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: p}}
+	if err := a.check(ctx, func(mvcc.ReadView) []*pb.RequestOp { return []*pb.RequestOp{op} }); err != nil {
+		return nil, nil, err
+	}
+	return a.applierV3.Put(ctx, txn, p)
+}
+
+func (a *prefixQuotaApplierV3) Txn(ctx context.Context, rt *pb.TxnRequest) (*pb.TxnResponse, *traceutil.Trace, error) {
+	if err := a.check(ctx, func(rv mvcc.ReadView) []*pb.RequestOp { return mvcctxn.TxnWrites(rv, rt) }); err != nil {
+		return nil, nil, err
+	}
+	return a.applierV3.Txn(ctx, rt)
+}
+
+func (a *prefixQuotaApplierV3) check(ctx context.Context, writes func(rv mvcc.ReadView) []*pb.RequestOp) error {
+	if a.qs.Empty() {
+		return nil
+	}
+	rv := a.kv.Read(mvcc.ConcurrentReadTxMode, traceutil.Get(ctx))
+	defer rv.End()
+	return a.qs.Check(ctx, rv, writes(rv))
+}
+
 func (a *applierV3backend) newHeader() *pb.ResponseHeader {
 	return &pb.ResponseHeader{
 		ClusterId: uint64(a.cluster.ID()),
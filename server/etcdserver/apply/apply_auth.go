@@ -70,9 +70,15 @@ func (aa *authApplierV3) Put(ctx context.Context, txn mvcc.TxnWrite, r *pb.PutRe
 		return nil, nil, err
 	}
 
+	if r.Lease != 0 {
+		if err := aa.as.IsLeaseAttachPermitted(&aa.authInfo, r.Key); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if err := aa.checkLeasePuts(lease.LeaseID(r.Lease)); err != nil {
 		// The specified lease is already attached with a key that cannot
-		// be written by this user. It means the user cannot revoke the
+		// be deleted by this user. It means the user cannot revoke the
 		// lease so attaching the lease to the newly written key should
 		// be forbidden.
 		return nil, nil, err
@@ -116,17 +122,51 @@ func (aa *authApplierV3) Txn(ctx context.Context, rt *pb.TxnRequest) (*pb.TxnRes
 }
 
 func (aa *authApplierV3) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
-	if err := aa.checkLeasePuts(lease.LeaseID(lc.ID)); err != nil {
-		return nil, err
+	// revoking a lease also revokes the leases granted under it, so the
+	// user must be able to delete the keys of the whole tree.
+	ids := []lease.LeaseID{lease.LeaseID(lc.ID)}
+	for i := 0; i < len(ids); i++ {
+		if err := aa.checkLeasePuts(ids[i]); err != nil {
+			return nil, err
+		}
+		if l := aa.lessor.Lookup(ids[i]); l != nil {
+			ids = append(ids, l.Children()...)
+		}
 	}
 	return aa.applierV3.LeaseRevoke(lc)
 }
 
+func (aa *authApplierV3) LeaseAttach(r *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	for _, key := range r.Keys {
+		if err := aa.as.IsPutPermitted(&aa.authInfo, key); err != nil {
+			return nil, err
+		}
+		if err := aa.as.IsLeaseAttachPermitted(&aa.authInfo, key); err != nil {
+			return nil, err
+		}
+	}
+	// as with Put, attaching keys to a lease the user cannot revoke is
+	// forbidden.
+	if err := aa.checkLeasePuts(lease.LeaseID(r.ID)); err != nil {
+		return nil, err
+	}
+	return aa.applierV3.LeaseAttach(r)
+}
+
+func (aa *authApplierV3) LeaseDetach(r *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	for _, key := range r.Keys {
+		if err := aa.as.IsPutPermitted(&aa.authInfo, key); err != nil {
+			return nil, err
+		}
+	}
+	return aa.applierV3.LeaseDetach(r)
+}
+
 func (aa *authApplierV3) checkLeasePuts(leaseID lease.LeaseID) error {
 	l := aa.lessor.Lookup(leaseID)
 	if l != nil {
 		for _, key := range l.Keys() {
-			if err := aa.as.IsPutPermitted(&aa.authInfo, []byte(key)); err != nil {
+			if err := aa.as.IsDeleteRangePermitted(&aa.authInfo, []byte(key), nil); err != nil {
 				return err
 			}
 		}
@@ -187,6 +227,8 @@ func needAdminPermission(r *pb.InternalRaftRequest) bool {
 		return true
 	case r.AuthRoleList != nil:
 		return true
+	case r.QuotaSet != nil:
+		return true
 	default:
 		return false
 	}
@@ -56,3 +56,11 @@ func (a *applierV3Corrupt) LeaseGrant(_ *pb.LeaseGrantRequest) (*pb.LeaseGrantRe
 func (a *applierV3Corrupt) LeaseRevoke(_ *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
 	return nil, errors.ErrCorrupt
 }
+
+func (a *applierV3Corrupt) LeaseAttach(_ *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	return nil, errors.ErrCorrupt
+}
+
+func (a *applierV3Corrupt) LeaseDetach(_ *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	return nil, errors.ErrCorrupt
+}
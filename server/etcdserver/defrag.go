@@ -0,0 +1,244 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// PeerDefragPath is the peer endpoint the leader uses to read the backend
+// size of a member with GET, and to have the member defragment its backend
+// with POST.
+const PeerDefragPath = "/members/defrag"
+
+type peerDefragStatus struct {
+	Size       int64 `json:"size"`
+	SizeInUse  int64 `json:"size-in-use"`
+	Defragging bool  `json:"defragging"`
+}
+
+type defragHandler struct {
+	lg     *zap.Logger
+	server *EtcdServer
+}
+
+func (s *EtcdServer) DefragHandler() http.Handler {
+	return &defragHandler{lg: s.Logger(), server: s}
+}
+
+func (h *defragHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != PeerDefragPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		h.server.startDefrag()
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	respBytes, err := json.Marshal(h.server.defragStatus())
+	if err != nil {
+		h.lg.Warn("failed to marshal defrag status", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("X-Etcd-Cluster-ID", h.server.Cluster().ID().String())
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(respBytes)
+}
+
+func (s *EtcdServer) defragStatus() peerDefragStatus {
+	be := s.Backend()
+	return peerDefragStatus{
+		Size:       be.Size(),
+		SizeInUse:  be.SizeInUse(),
+		Defragging: atomic.LoadInt32(&s.defragging) == 1 || be.DefragProgress().Phase != backend.DefragPhaseNone,
+	}
+}
+
+// startDefrag defragments the backend online in the background, unless a
+// defragmentation started this way is already running.
+func (s *EtcdServer) startDefrag() {
+	if !atomic.CompareAndSwapInt32(&s.defragging, 0, 1) {
+		return
+	}
+	s.GoAttach(func() {
+		defer atomic.StoreInt32(&s.defragging, 0)
+		lg := s.Logger()
+		lg.Info("starting scheduled defragmentation", zap.String("local-member-id", s.MemberId().String()))
+		if err := s.Backend().DefragOnline(); err != nil {
+			lg.Warn("failed to defragment", zap.Error(err))
+			return
+		}
+		lg.Info("finished scheduled defragmentation", zap.String("local-member-id", s.MemberId().String()))
+	})
+}
+
+// needsDefrag returns true if a backend of size bytes with sizeInUse bytes
+// in use is fragmented beyond the auto defrag policy.
+func (s *EtcdServer) needsDefrag(size, sizeInUse int64) bool {
+	freeable := size - sizeInUse
+	if size <= 0 || freeable <= 0 {
+		return false
+	}
+	if freeable < int64(s.Cfg.ExperimentalAutoDefragThresholdMegabytes)*1024*1024 {
+		return false
+	}
+	return float64(freeable)/float64(size) >= s.Cfg.ExperimentalAutoDefragRatio
+}
+
+// monitorDefrag lets the leader defragment the members whose backend is
+// fragmented beyond the auto defrag policy, one member at a time and
+// the leader after its followers.
+func (s *EtcdServer) monitorDefrag() {
+	if s.Cfg.ExperimentalAutoDefragRatio == 0 && s.Cfg.ExperimentalAutoDefragThresholdMegabytes == 0 {
+		return
+	}
+	t := s.Cfg.ExperimentalAutoDefragCheckTime
+	s.Logger().Info(
+		"enabled automatic defragmentation",
+		zap.String("local-member-id", s.MemberId().String()),
+		zap.Float64("ratio", s.Cfg.ExperimentalAutoDefragRatio),
+		zap.Uint("threshold-megabytes", s.Cfg.ExperimentalAutoDefragThresholdMegabytes),
+		zap.Duration("interval", t),
+	)
+	for {
+		select {
+		case <-time.After(t):
+		case <-s.stopping:
+			return
+		}
+		if !s.isLeader() {
+			continue
+		}
+		s.scheduleDefrag()
+	}
+}
+
+// scheduleDefrag starts the defragmentation of the most fragmented follower
+// that needs one, or of the leader if no follower does. It does nothing
+// while any member is defragmenting or cannot be reached.
+func (s *EtcdServer) scheduleDefrag() {
+	lg := s.Logger()
+	local := s.defragStatus()
+	if local.Defragging {
+		return
+	}
+
+	cc := &http.Client{Transport: s.peerRt}
+	var (
+		target    types.ID
+		targetURL string
+		freeable  int64
+	)
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.MemberId() {
+			continue
+		}
+		st, url, err := s.getPeerDefragStatus(cc, m.PeerURLs)
+		if err != nil {
+			lg.Warn(
+				"skipping automatic defragmentation; failed to get member defrag status",
+				zap.String("remote-peer-id", m.ID.String()),
+				zap.Error(err),
+			)
+			return
+		}
+		if st.Defragging {
+			return
+		}
+		if s.needsDefrag(st.Size, st.SizeInUse) && st.Size-st.SizeInUse > freeable {
+			target, targetURL, freeable = m.ID, url, st.Size-st.SizeInUse
+		}
+	}
+
+	if targetURL == "" {
+		if !s.needsDefrag(local.Size, local.SizeInUse) {
+			return
+		}
+		target, freeable = s.MemberId(), local.Size-local.SizeInUse
+	}
+	lg.Info(
+		"scheduling automatic defragmentation",
+		zap.String("target-member-id", target.String()),
+		zap.Int64("freeable-bytes", freeable),
+		zap.String("freeable", humanize.Bytes(uint64(freeable))),
+	)
+	if target == s.MemberId() {
+		s.startDefrag()
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	if _, err := peerDefrag(ctx, cc, http.MethodPost, targetURL); err != nil {
+		lg.Warn("failed to start automatic defragmentation", zap.String("target-member-id", target.String()), zap.Error(err))
+	}
+}
+
+// getPeerDefragStatus returns the defrag status of a peer from the first of
+// its urls that answers, along with that url.
+func (s *EtcdServer) getPeerDefragStatus(cc *http.Client, urls []string) (st *peerDefragStatus, url string, err error) {
+	err = fmt.Errorf("no peer url")
+	for _, url = range urls {
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		st, err = peerDefrag(ctx, cc, http.MethodGet, url)
+		cancel()
+		if err == nil {
+			return st, url, nil
+		}
+	}
+	return nil, "", err
+}
+
+func peerDefrag(ctx context.Context, cc *http.Client, method, url string) (*peerDefragStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url+PeerDefragPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response %d: %s", resp.StatusCode, string(b))
+	}
+	st := &peerDefragStatus{}
+	if err := json.Unmarshal(b, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/server/v3/config"
+)
+
+func TestNeedsDefrag(t *testing.T) {
+	const mb = 1024 * 1024
+	tests := []struct {
+		ratio     float64
+		threshold uint
+		size      int64
+		sizeInUse int64
+		want      bool
+	}{
+		{0.5, 0, 10 * mb, 4 * mb, true},
+		{0.5, 0, 10 * mb, 5 * mb, true},
+		{0.5, 0, 10 * mb, 6 * mb, false},
+		{0, 5, 10 * mb, 6 * mb, false},
+		{0, 5, 10 * mb, 5 * mb, true},
+		// both the ratio and the threshold must be met
+		{0.5, 8, 10 * mb, 4 * mb, false},
+		{0.5, 0, 0, 0, false},
+	}
+	for i, tt := range tests {
+		s := &EtcdServer{Cfg: config.ServerConfig{
+			ExperimentalAutoDefragRatio:              tt.ratio,
+			ExperimentalAutoDefragThresholdMegabytes: tt.threshold,
+		}}
+		if got := s.needsDefrag(tt.size, tt.sizeInUse); got != tt.want {
+			t.Errorf("#%d: needsDefrag(%d, %d) = %v, want %v", i, tt.size, tt.sizeInUse, got, tt.want)
+		}
+	}
+}
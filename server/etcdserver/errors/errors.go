@@ -42,6 +42,11 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrInvalidContinueToken        = errors.New("etcdserver: invalid continue token")
+	ErrPrefixQuotaExceeded         = errors.New("etcdserver: prefix quota exceeded")
+	ErrQuotaNotFound               = errors.New("etcdserver: quota not found")
+	ErrQuotaNotSupported           = errors.New("etcdserver: prefix quotas are not supported by the cluster version")
+	ErrRetentionNotSupported       = errors.New("etcdserver: compaction retention is not supported by the cluster version")
 )
 
 type DiscoveryError struct {
@@ -61,11 +61,13 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3quota"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	serverversion "go.etcd.io/etcd/server/v3/etcdserver/version"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/lease/leasehttp"
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
+	"go.etcd.io/etcd/server/v3/storage/archive"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
@@ -101,6 +103,11 @@ const (
 	// maxPendingRevokes is the maximum number of outstanding expired lease revocations.
 	maxPendingRevokes = 16
 
+	// keyExpiryCheckInterval is the interval at which the leader checks for expired keys.
+	keyExpiryCheckInterval = 500 * time.Millisecond
+	// maxExpiredKeysPerRequest is the maximum number of expired keys deleted by one proposal.
+	maxExpiredKeysPerRequest = 1000
+
 	recommendedMaxRequestBytes = 10 * 1024 * 1024
 
 	readyPercent = 0.9
@@ -264,6 +271,11 @@ type EtcdServer struct {
 	beHooks    *serverstorage.BackendHooks
 	authStore  auth.AuthStore
 	alarmStore *v3alarm.AlarmStore
+	quotaStore *v3quota.QuotaStore
+
+	// archiver copies WAL segments and snapshots before they are purged;
+	// nil if WAL archiving is disabled.
+	archiver *archive.Archiver
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
@@ -271,6 +283,11 @@ type EtcdServer struct {
 	SyncTicker *time.Ticker
 	// compactor is used to auto-compact the KV.
 	compactor v3compactor.Compactor
+	// retainer resolves the prefix retention of compactions.
+	retainer *v3compactor.Retainer
+	// defragging is 1 while a defragmentation scheduled by the leader runs;
+	// must use atomic operations to access.
+	defragging int32
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -346,6 +363,16 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 
 	srv.be = b.storage.backend.be
 	srv.beHooks = b.storage.backend.beHooks
+	if cfg.WALArchiveDir != "" {
+		srv.archiver, err = archive.New(cfg.Logger, archive.Config{
+			Dir:       cfg.WALArchiveDir,
+			MaxFiles:  cfg.WALArchiveMaxFiles,
+			Retention: cfg.WALArchiveRetention,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	minTTL := time.Duration((3*cfg.ElectionTicks)/2) * heartbeat
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
@@ -371,6 +398,12 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		Cluster:                 srv.cluster,
+	}
+	if cfg.ExperimentalValueCompression != "" {
+		if mvccStoreConfig.ValueCompressor, err = mvcc.ValueCompressorByName(cfg.ExperimentalValueCompression); err != nil {
+			return nil, err
+		}
 	}
 	srv.kv = mvcc.New(srv.Logger(), srv.be, srv.lessor, mvccStoreConfig)
 	srv.corruptionChecker = newCorruptionChecker(cfg.Logger, srv, srv.kv.HashStorage())
@@ -392,10 +425,17 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		}
 		srv.compactor.Run()
 	}
+	if len(cfg.CompactionPrefixRetention) != 0 {
+		srv.retainer = v3compactor.NewRetainer(cfg.Logger, cfg.CompactionPrefixRetention, srv.kv)
+		srv.retainer.Run()
+	}
 
 	if err = srv.restoreAlarms(); err != nil {
 		return nil, err
 	}
+	if err = srv.restoreQuotas(); err != nil {
+		return nil, err
+	}
 	srv.uberApply = srv.NewUberApplier()
 
 	if srv.Cfg.EnableLeaseCheckpoint {
@@ -422,6 +462,7 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 		ServerStats: sstats,
 		LeaderStats: lstats,
 		ErrorC:      srv.errorc,
+		Compression: cfg.ExperimentalPeerCompression,
 	}
 	if err = tr.Start(); err != nil {
 		return nil, err
@@ -528,6 +569,9 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(func() { s.adjustTicks() })
 	s.GoAttach(func() { s.publishV3(s.Cfg.ReqTimeout()) })
 	s.GoAttach(s.purgeFile)
+	if s.archiver != nil {
+		s.GoAttach(s.archiveFiles)
+	}
 	s.GoAttach(func() { monitorFileDescriptor(s.Logger(), s.stopping) })
 	s.GoAttach(s.monitorClusterVersions)
 	s.GoAttach(s.monitorStorageVersion)
@@ -535,6 +579,8 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorCompactHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorDefrag)
+	s.GoAttach(s.expireKeys)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -596,12 +642,18 @@ func (s *EtcdServer) purgeFile() {
 	lg := s.Logger()
 	var dberrc, serrc, werrc <-chan error
 	var dbdonec, sdonec, wdonec <-chan struct{}
+	purge := fileutil.PurgeFileWithDoneNotify
+	if s.archiver != nil {
+		purge = func(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
+			return fileutil.PurgeFileWithArchive(lg, dirname, suffix, max, interval, stop, s.archiver.Archive)
+		}
+	}
 	if s.Cfg.MaxSnapFiles > 0 {
-		dbdonec, dberrc = fileutil.PurgeFileWithDoneNotify(lg, s.Cfg.SnapDir(), "snap.db", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
-		sdonec, serrc = fileutil.PurgeFileWithDoneNotify(lg, s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
+		dbdonec, dberrc = purge(lg, s.Cfg.SnapDir(), "snap.db", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
+		sdonec, serrc = purge(lg, s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
 	}
 	if s.Cfg.MaxWALFiles > 0 {
-		wdonec, werrc = fileutil.PurgeFileWithDoneNotify(lg, s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.stopping)
+		wdonec, werrc = purge(lg, s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.stopping)
 	}
 
 	select {
@@ -625,6 +677,25 @@ func (s *EtcdServer) purgeFile() {
 	}
 }
 
+// archiveFiles periodically archives completed WAL segments and snapshot
+// files, so that the archive keeps up even when nothing is being purged.
+func (s *EtcdServer) archiveFiles() {
+	lg := s.Logger()
+	for {
+		if err := s.archiver.ArchiveCompleted(s.Cfg.WALDir(), s.Cfg.SnapDir()); err != nil {
+			lg.Warn("failed to archive files", zap.String("archive-dir", s.Cfg.WALArchiveDir), zap.Error(err))
+		}
+		if err := s.archiver.Prune(time.Now()); err != nil {
+			lg.Warn("failed to prune archived files", zap.String("archive-dir", s.Cfg.WALArchiveDir), zap.Error(err))
+		}
+		select {
+		case <-time.After(purgeFileInterval):
+		case <-s.stopping:
+			return
+		}
+	}
+}
+
 func (s *EtcdServer) Cluster() api.Cluster { return s.cluster }
 
 func (s *EtcdServer) ApplyWait() <-chan struct{} { return s.applyWait.Wait(s.getCommittedIndex()) }
@@ -648,6 +719,7 @@ type ServerPeerV2 interface {
 	ServerPeer
 	HashKVHandler() http.Handler
 	DowngradeEnabledHandler() http.Handler
+	DefragHandler() http.Handler
 }
 
 func (s *EtcdServer) DowngradeInfo() *serverversion.DowngradeInfo { return s.cluster.DowngradeInfo() }
@@ -896,6 +968,47 @@ func (s *EtcdServer) revokeExpiredLeases(leases []*lease.Lease) {
 	})
 }
 
+// expireKeys proposes the deletion of keys whose expire time has passed.
+// Only the leader proposes deletions; a key modified after its expired
+// revision was observed is left untouched when the proposal is applied.
+func (s *EtcdServer) expireKeys() {
+	lg := s.Logger()
+	wait := keyExpiryCheckInterval
+	for {
+		select {
+		case <-time.After(wait):
+		case <-s.stopping:
+			return
+		}
+		wait = keyExpiryCheckInterval
+
+		// members before v3.6 cannot apply the deletion of expired keys
+		if !s.isLeader() || !lease.IsExpirySupported(s.ClusterVersion()) {
+			continue
+		}
+		keys := s.KV().Expired(time.Now().Unix(), maxExpiredKeysPerRequest)
+		if len(keys) == 0 {
+			continue
+		}
+		r := &pb.KeyExpireRequest{Keys: make([]*pb.KeyExpire, len(keys))}
+		for i, k := range keys {
+			r.Keys[i] = &pb.KeyExpire{Key: k.Key, ModRevision: k.ModRevision}
+		}
+		ctx, cancel := context.WithTimeout(s.authStore.WithRoot(s.ctx), s.Cfg.ReqTimeout())
+		resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{KeyExpire: r})
+		cancel()
+		if err != nil {
+			lg.Warn("failed to delete expired keys", zap.Int("keys", len(keys)), zap.Error(err))
+			continue
+		}
+		keyExpired.Add(float64(resp.(*pb.KeyExpireResponse).Deleted))
+		if len(keys) == maxExpiredKeysPerRequest {
+			// more keys may have expired; check again right away
+			wait = 0
+		}
+	}
+}
+
 // Cleanup removes allocated objects by EtcdServer.NewServer in
 // situation that EtcdServer::Start was not called (that takes care of cleanup).
 func (s *EtcdServer) Cleanup() {
@@ -916,6 +1029,9 @@ func (s *EtcdServer) Cleanup() {
 	if s.compactor != nil {
 		s.compactor.Stop()
 	}
+	if s.retainer != nil {
+		s.retainer.Stop()
+	}
 }
 
 func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
@@ -1037,6 +1153,14 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 
 	lg.Info("restored alarm store")
 
+	lg.Info("restoring quota store")
+
+	if err := s.restoreQuotas(); err != nil {
+		lg.Panic("failed to restore quota store", zap.Error(err))
+	}
+
+	lg.Info("restored quota store")
+
 	if s.authStore != nil {
 		lg.Info("restoring auth store")
 
@@ -1091,7 +1215,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, toApply *toApply) {
 }
 
 func (s *EtcdServer) NewUberApplier() apply.UberApplier {
-	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
+	return apply.NewUberApplier(s.lg, s.be, s.KV(), s.alarmStore, s.quotaStore, s.authStore, s.lessor, s.cluster, s, s, s.consistIndex,
 		s.Cfg.WarningApplyDuration, s.Cfg.ExperimentalTxnModeWriteWithSharedBuffer, s.Cfg.QuotaBackendBytes)
 }
 
@@ -2396,6 +2520,16 @@ func (s *EtcdServer) restoreAlarms() error {
 	return nil
 }
 
+func (s *EtcdServer) restoreQuotas() error {
+	qs, err := v3quota.NewQuotaStore(s.lg, schema.NewQuotaBackend(s.lg, s.be))
+	if err != nil {
+		return err
+	}
+	s.quotaStore = qs
+	s.kv.SetUsageTracker(qs)
+	return nil
+}
+
 // GoAttach creates a goroutine on a given function and tracks it using
 // the etcdserver waitgroup.
 // The passed function should interrupt on s.StoppingNotify().
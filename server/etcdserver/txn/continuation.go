@@ -0,0 +1,104 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+)
+
+// continueTokenVersion prefixes every encoded continue token so that the
+// format can evolve without misreading tokens issued by older members.
+const continueTokenVersion byte = 1
+
+// continueToken is the decoded form of RangeRequest.ContinueToken and
+// RangeResponse.ContinueToken. It pins the revision of the first page
+// and records the key the next page starts from.
+type continueToken struct {
+	rev int64
+	key []byte
+}
+
+func (t continueToken) encode() []byte {
+	buf := make([]byte, 1+binary.MaxVarintLen64+len(t.key))
+	buf[0] = continueTokenVersion
+	n := binary.PutUvarint(buf[1:], uint64(t.rev))
+	copy(buf[1+n:], t.key)
+	return buf[:1+n+len(t.key)]
+}
+
+func decodeContinueToken(b []byte) (continueToken, error) {
+	if len(b) < 2 || b[0] != continueTokenVersion {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	rev, n := binary.Uvarint(b[1:])
+	if n <= 0 || rev == 0 || rev > uint64(1<<63-1) {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	key := b[1+n:]
+	if len(key) == 0 {
+		return continueToken{}, errors.ErrInvalidContinueToken
+	}
+	return continueToken{rev: int64(rev), key: key}, nil
+}
+
+// isRangePageable returns true if the range results are ordered by key,
+// which is the only order a continue token can resume from.
+func isRangePageable(r *pb.RangeRequest) bool {
+	if r.CountOnly || len(r.RangeEnd) == 0 || r.SortTarget != pb.RangeRequest_KEY {
+		return false
+	}
+	return r.SortOrder == pb.RangeRequest_NONE || r.SortOrder == pb.RangeRequest_ASCEND
+}
+
+// rangeContinuation returns the start key and revision of the range request,
+// taking its continue token into account. It fails with
+// ErrInvalidContinueToken if the token cannot belong to a previous page of
+// the same request.
+func rangeContinuation(r *pb.RangeRequest) (key []byte, rev int64, err error) {
+	if len(r.ContinueToken) == 0 {
+		return r.Key, r.Revision, nil
+	}
+	if !isRangePageable(r) {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	t, err := decodeContinueToken(r.ContinueToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Revision != 0 && r.Revision != t.rev {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	// the token must stay within [key, range_end), otherwise it could be
+	// used to read keys that the request was not authorized for.
+	if bytes.Compare(t.key, r.Key) <= 0 {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	if end := mkGteRange(r.RangeEnd); len(end) != 0 && bytes.Compare(t.key, end) >= 0 {
+		return nil, 0, errors.ErrInvalidContinueToken
+	}
+	return t.key, t.rev, nil
+}
+
+// nextContinueToken returns the token resuming the range right after lastKey
+// at the given revision.
+func nextContinueToken(lastKey []byte, rev int64) []byte {
+	next := make([]byte, len(lastKey)+1)
+	copy(next, lastKey)
+	return continueToken{rev: rev, key: next}.encode()
+}
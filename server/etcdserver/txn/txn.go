@@ -82,7 +82,7 @@ func Put(ctx context.Context, lg *zap.Logger, lessor lease.Lessor, kv mvcc.KV, t
 		}
 	}
 
-	resp.Header.Revision = txnWrite.Put(p.Key, val, leaseID)
+	resp.Header.Revision = txnWrite.PutWithExpiry(p.Key, val, leaseID, p.ExpireTime)
 	trace.AddField(traceutil.Field{Key: "response_revision", Value: resp.Header.Revision})
 	return resp, trace, nil
 }
@@ -125,6 +125,11 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 		defer txnRead.End()
 	}
 
+	key, rev, err := rangeContinuation(r)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := r.Limit
 	if r.SortOrder != pb.RangeRequest_NONE ||
 		r.MinModRevision != 0 || r.MaxModRevision != 0 ||
@@ -139,11 +144,11 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 
 	ro := mvcc.RangeOptions{
 		Limit: limit,
-		Rev:   r.Revision,
+		Rev:   rev,
 		Count: r.CountOnly,
 	}
 
-	rr, err := txnRead.Range(ctx, r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txnRead.Range(ctx, key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +209,12 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if isRangePageable(r) {
+			if rev <= 0 {
+				rev = rr.Rev
+			}
+			resp.ContinueToken = nextContinueToken(rr.KVs[len(rr.KVs)-1].Key, rev)
+		}
 	}
 	trace.Step("filter and sort the key-value pairs")
 	resp.Header.Revision = rr.Rev
@@ -219,6 +230,36 @@ func Range(ctx context.Context, lg *zap.Logger, kv mvcc.KV, txnRead mvcc.TxnRead
 	return resp, nil
 }
 
+// KeyHistory lists the changes to the requested keys from the KV history.
+func KeyHistory(ctx context.Context, kv mvcc.KV, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	trace := traceutil.Get(ctx)
+
+	txnRead := kv.Read(mvcc.ConcurrentReadTxMode, trace)
+	defer txnRead.End()
+
+	ho := mvcc.HistoryOptions{
+		StartRev: r.StartRevision,
+		EndRev:   r.EndRevision,
+		Limit:    r.Limit,
+	}
+	hr, err := txnRead.History(ctx, r.Key, mkGteRange(r.RangeEnd), ho)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.KeyHistoryResponse{
+		Header:       &pb.ResponseHeader{Revision: hr.Rev},
+		Events:       make([]*mvccpb.Event, len(hr.Events)),
+		More:         hr.More,
+		NextRevision: hr.NextRev,
+	}
+	for i := range hr.Events {
+		resp.Events[i] = &hr.Events[i]
+	}
+	trace.Step("assemble the response")
+	return resp, nil
+}
+
 func Txn(ctx context.Context, lg *zap.Logger, rt *pb.TxnRequest, txnModeWriteWithSharedBuffer bool, kv mvcc.KV, lessor lease.Lessor) (*pb.TxnResponse, *traceutil.Trace, error) {
 	trace := traceutil.Get(ctx)
 	if trace.IsEmpty() {
@@ -412,12 +453,16 @@ func checkRequestRange(rv mvcc.ReadView, reqOp *pb.RequestOp) error {
 		return nil
 	}
 	req := tv.RequestRange
+	_, rev, err := rangeContinuation(req)
+	if err != nil {
+		return err
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRev():
 		return mvcc.ErrCompacted
 	}
 	return nil
@@ -534,6 +579,34 @@ func compareToPath(rv mvcc.ReadView, rt *pb.TxnRequest) []bool {
 	return txnPath
 }
 
+// TxnWrites returns the put and delete range requests the txn applies when
+// executed on top of rv, including those of nested txns, in the order they
+// are applied.
+func TxnWrites(rv mvcc.ReadView, rt *pb.TxnRequest) []*pb.RequestOp {
+	ops := rt.Success
+	if !applyCompares(rv, rt.Compare) {
+		ops = rt.Failure
+	}
+	var writes []*pb.RequestOp
+	for _, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			if tv.RequestPut != nil {
+				writes = append(writes, op)
+			}
+		case *pb.RequestOp_RequestDeleteRange:
+			if tv.RequestDeleteRange != nil {
+				writes = append(writes, op)
+			}
+		case *pb.RequestOp_RequestTxn:
+			if tv.RequestTxn != nil {
+				writes = append(writes, TxnWrites(rv, tv.RequestTxn)...)
+			}
+		}
+	}
+	return writes
+}
+
 func applyCompares(rv mvcc.ReadView, cmps []*pb.Compare) bool {
 	for _, c := range cmps {
 		if !applyCompare(rv, c) {
@@ -643,6 +716,26 @@ func IsTxnReadonly(r *pb.TxnRequest) bool {
 	return true
 }
 
+// IsTxnWithExpiry returns true if a put of the txn, or of its nested txns,
+// sets a ttl or an expire time.
+func IsTxnWithExpiry(r *pb.TxnRequest) bool {
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, u := range reqs {
+			switch tv := u.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				if tv.RequestPut.Ttl != 0 || tv.RequestPut.ExpireTime != 0 {
+					return true
+				}
+			case *pb.RequestOp_RequestTxn:
+				if IsTxnWithExpiry(tv.RequestTxn) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func CheckTxnAuth(as auth.AuthStore, ai *auth.AuthInfo, rt *pb.TxnRequest) error {
 	for _, c := range rt.Compare {
 		if err := as.IsRangePermitted(ai, c.Key, c.RangeEnd); err != nil {
@@ -676,6 +769,12 @@ func checkTxnReqsPermission(as auth.AuthStore, ai *auth.AuthInfo, reqs []*pb.Req
 				return err
 			}
 
+			if tv.RequestPut.Lease != 0 {
+				if err := as.IsLeaseAttachPermitted(ai, tv.RequestPut.Key); err != nil {
+					return err
+				}
+			}
+
 		case *pb.RequestOp_RequestDeleteRange:
 			if tv.RequestDeleteRange == nil {
 				continue
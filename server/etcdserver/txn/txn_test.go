@@ -22,6 +22,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/lease"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
@@ -94,3 +95,101 @@ func TestWriteTxnPanic(t *testing.T) {
 
 	assert.Panics(t, func() { Txn(ctx, zaptest.NewLogger(t), txn, false, s, &lease.FakeLessor{}) }, "Expected panic in Txn with writes")
 }
+
+func TestRangeContinueToken(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		s.Put([]byte(k), []byte("v1"), lease.NoLease)
+	}
+	rev := s.Rev()
+	// writes after the first page must not be visible to later pages
+	s.Put([]byte("c"), []byte("v2"), lease.NoLease)
+	s.Put([]byte("bb"), []byte("v2"), lease.NoLease)
+
+	req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, Revision: rev}
+	var keys []string
+	for {
+		resp, err := Range(context.TODO(), zaptest.NewLogger(t), s, nil, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, kv := range resp.Kvs {
+			assert.Equal(t, "v1", string(kv.Value))
+			keys = append(keys, string(kv.Key))
+		}
+		assert.Equal(t, resp.More, len(resp.ContinueToken) != 0)
+		if len(resp.ContinueToken) == 0 {
+			break
+		}
+		req = &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, ContinueToken: resp.ContinueToken}
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+}
+
+func TestRangeInvalidContinueToken(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+	s := mvcc.NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer s.Close()
+
+	for _, k := range []string{"a", "b", "c"} {
+		s.Put([]byte(k), []byte("v"), lease.NoLease)
+	}
+	resp, err := Range(context.TODO(), zaptest.NewLogger(t), s, nil, &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := resp.ContinueToken
+
+	tests := []struct {
+		name string
+		req  *pb.RangeRequest
+	}{
+		{"garbage", &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), ContinueToken: []byte("garbage")}},
+		{"different revision", &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), Revision: 1, ContinueToken: token}},
+		{"key after token", &pb.RangeRequest{Key: []byte("b"), RangeEnd: []byte("c"), ContinueToken: token}},
+		{"range end before token", &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("a\x00"), ContinueToken: token}},
+		{"single key", &pb.RangeRequest{Key: []byte("a"), ContinueToken: token}},
+		{"sorted by value", &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), SortTarget: pb.RangeRequest_VALUE, ContinueToken: token}},
+		{"descending", &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("c"), SortOrder: pb.RangeRequest_DESCEND, ContinueToken: token}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Range(context.TODO(), zaptest.NewLogger(t), s, nil, tc.req)
+			assert.Equal(t, errors.ErrInvalidContinueToken, err)
+		})
+	}
+}
+
+func TestIsTxnWithExpiry(t *testing.T) {
+	put := func(ttl, expireTime int64) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{
+			RequestPut: &pb.PutRequest{Key: []byte("foo"), Ttl: ttl, ExpireTime: expireTime},
+		}}
+	}
+	nested := func(ops ...*pb.RequestOp) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{
+			RequestTxn: &pb.TxnRequest{Failure: ops},
+		}}
+	}
+	tests := []struct {
+		name string
+		txn  *pb.TxnRequest
+		want bool
+	}{
+		{"no expiry", &pb.TxnRequest{Success: []*pb.RequestOp{put(0, 0)}}, false},
+		{"ttl", &pb.TxnRequest{Success: []*pb.RequestOp{put(0, 0), put(10, 0)}}, true},
+		{"expire time on failure", &pb.TxnRequest{Failure: []*pb.RequestOp{put(0, 100)}}, true},
+		{"nested", &pb.TxnRequest{Success: []*pb.RequestOp{nested(put(10, 0))}}, true},
+		{"nested without expiry", &pb.TxnRequest{Success: []*pb.RequestOp{nested(put(0, 0))}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTxnWithExpiry(tt.txn))
+		})
+	}
+}
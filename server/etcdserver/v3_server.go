@@ -27,6 +27,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3quota"
 	apply2 "go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	"go.etcd.io/etcd/server/v3/etcdserver/txn"
@@ -60,6 +61,7 @@ type RaftKV interface {
 	DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error)
 	Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error)
 	Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error)
+	KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error)
 }
 
 type Lessor interface {
@@ -77,6 +79,12 @@ type Lessor interface {
 
 	// LeaseLeases lists all leases.
 	LeaseLeases(ctx context.Context, r *pb.LeaseLeasesRequest) (*pb.LeaseLeasesResponse, error)
+
+	// LeaseAttach sends LeaseAttach request to raft and toApply it after committed.
+	LeaseAttach(ctx context.Context, r *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error)
+
+	// LeaseDetach sends LeaseDetach request to raft and toApply it after committed.
+	LeaseDetach(ctx context.Context, r *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error)
 }
 
 type Authenticator interface {
@@ -139,7 +147,40 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	return resp, err
 }
 
+func (s *EtcdServer) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	trace := traceutil.New("key_history",
+		s.Logger(),
+		traceutil.Field{Key: "range_begin", Value: string(r.Key)},
+		traceutil.Field{Key: "range_end", Value: string(r.RangeEnd)},
+	)
+	ctx = context.WithValue(ctx, traceutil.TraceKey, trace)
+	defer trace.LogIfLong(traceThreshold)
+
+	if !r.Serializable {
+		err := s.linearizableReadNotify(ctx)
+		trace.Step("agreement among raft nodes before linearized reading")
+		if err != nil {
+			return nil, err
+		}
+	}
+	chk := func(ai *auth.AuthInfo) error {
+		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
+	}
+
+	var resp *pb.KeyHistoryResponse
+	var err error
+	get := func() { resp, err = txn.KeyHistory(ctx, s.KV(), r) }
+	if serr := s.doSerialize(ctx, chk, get); serr != nil {
+		return nil, serr
+	}
+	return resp, err
+}
+
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if (r.Ttl != 0 || r.ExpireTime != 0) && !lease.IsExpirySupported(s.ClusterVersion()) {
+		return nil, lease.ErrExpiryNotSupported
+	}
+	setPutExpireTime(r, time.Now())
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
@@ -190,6 +231,10 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		return resp, err
 	}
 
+	if txn.IsTxnWithExpiry(r) && !lease.IsExpirySupported(s.ClusterVersion()) {
+		return nil, lease.ErrExpiryNotSupported
+	}
+	setTxnExpireTime(r, time.Now())
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Txn: r})
 	if err != nil {
@@ -198,8 +243,47 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 	return resp.(*pb.TxnResponse), nil
 }
 
+// setPutExpireTime converts the ttl of the put into an absolute expire time,
+// so that every member applies the same deadline.
+func setPutExpireTime(r *pb.PutRequest, now time.Time) {
+	if r.Ttl > 0 {
+		r.ExpireTime = now.Add(time.Duration(r.Ttl) * time.Second).Unix()
+		r.Ttl = 0
+	}
+}
+
+func setTxnExpireTime(r *pb.TxnRequest, now time.Time) {
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range reqs {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				setPutExpireTime(tv.RequestPut, now)
+			case *pb.RequestOp_RequestTxn:
+				setTxnExpireTime(tv.RequestTxn, now)
+			}
+		}
+	}
+}
+
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	startTime := time.Now()
+	if !mvcc.IsRetentionSupported(s.ClusterVersion()) {
+		if len(r.Retention) != 0 {
+			return nil, errors.ErrRetentionNotSupported
+		}
+		if s.retainer != nil {
+			s.Logger().Warn(
+				"compacting without prefix retention, as the cluster version does not support it",
+				zap.Int64("revision", r.Revision),
+			)
+		}
+	} else if s.retainer != nil {
+		r = &pb.CompactionRequest{
+			Revision:  r.Revision,
+			Physical:  r.Physical,
+			Retention: append(s.retainer.Retention(r.Revision), r.Retention...),
+		}
+	}
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
 	trace := traceutil.TODO()
 	if result != nil && result.Trace != nil {
@@ -243,7 +327,35 @@ func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.
 	return resp, nil
 }
 
+// CompactEstimate estimates the impact of compacting at the requested revision
+// with the retention of the configured prefix retention rules.
+func (s *EtcdServer) CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest) (*pb.CompactEstimateResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	var retention []mvcc.Retention
+	if s.retainer != nil && mvcc.IsRetentionSupported(s.ClusterVersion()) {
+		for _, cr := range s.retainer.Retention(r.Revision) {
+			retention = append(retention, mvcc.Retention{Prefix: cr.Prefix, Rev: cr.Revision})
+		}
+	}
+	est, err := s.KV().EstimateCompact(r.Revision, retention)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompactEstimateResponse{
+		Header:    &pb.ResponseHeader{Revision: s.KV().Rev()},
+		Keys:      est.Keys,
+		Revisions: est.Revisions,
+		Bytes:     est.Bytes,
+		Watchers:  est.Watchers,
+	}, nil
+}
+
 func (s *EtcdServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	if r.Parent != 0 && !lease.IsParentSupported(s.ClusterVersion()) {
+		return nil, lease.ErrParentNotSupported
+	}
 	// no id given? choose one
 	for r.ID == int64(lease.NoLease) {
 		// only use positive int64 id's
@@ -276,6 +388,28 @@ func (s *EtcdServer) LeaseRevoke(ctx context.Context, r *pb.LeaseRevokeRequest)
 	return resp.(*pb.LeaseRevokeResponse), nil
 }
 
+func (s *EtcdServer) LeaseAttach(ctx context.Context, r *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	if !lease.IsAttachSupported(s.ClusterVersion()) {
+		return nil, lease.ErrAttachNotSupported
+	}
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseAttach: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.LeaseAttachResponse), nil
+}
+
+func (s *EtcdServer) LeaseDetach(ctx context.Context, r *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	if !lease.IsAttachSupported(s.ClusterVersion()) {
+		return nil, lease.ErrAttachNotSupported
+	}
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseDetach: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.LeaseDetachResponse), nil
+}
+
 func (s *EtcdServer) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
 	if s.isLeader() {
 		if err := s.waitAppliedIndex(); err != nil {
@@ -328,7 +462,10 @@ func (s *EtcdServer) LeaseTimeToLive(ctx context.Context, r *pb.LeaseTimeToLiveR
 			return nil, lease.ErrLeaseNotFound
 		}
 		// TODO: fill out ResponseHeader
-		resp := &pb.LeaseTimeToLiveResponse{Header: &pb.ResponseHeader{}, ID: r.ID, TTL: int64(le.Remaining().Seconds()), GrantedTTL: le.TTL()}
+		resp := &pb.LeaseTimeToLiveResponse{Header: &pb.ResponseHeader{}, ID: r.ID, TTL: int64(le.Remaining().Seconds()), GrantedTTL: le.TTL(), Parent: int64(le.Parent())}
+		for _, id := range s.lessor.Descendants(le.ID) {
+			resp.Children = append(resp.Children, int64(id))
+		}
 		if r.Keys {
 			ks := le.Keys()
 			kbs := make([][]byte, len(ks))
@@ -414,6 +551,35 @@ func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmRe
 	return resp.(*pb.AlarmResponse), nil
 }
 
+func (s *EtcdServer) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	if !v3quota.IsSupported(s.ClusterVersion()) {
+		return nil, errors.ErrQuotaNotSupported
+	}
+	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{QuotaSet: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.QuotaSetResponse), nil
+}
+
+func (s *EtcdServer) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	status := s.quotaStore.Status(r.Prefix)
+	if status == nil {
+		return nil, errors.ErrQuotaNotFound
+	}
+	return &pb.QuotaGetResponse{Status: status}, nil
+}
+
+func (s *EtcdServer) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.QuotaListResponse{Statuses: s.quotaStore.Statuses()}, nil
+}
+
 func (s *EtcdServer) AuthEnable(ctx context.Context, r *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{AuthEnable: r})
 	if err != nil {
@@ -666,6 +832,11 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	r.Header = &pb.RequestHeader{
 		ID: s.reqIDGen.Next(),
 	}
+	// the proposal time lets a restore replay the WAL up to a point in time;
+	// members of older clusters do not know the field
+	if cv := s.ClusterVersion(); cv != nil && !version.LessThan(*cv, version.V3_6) {
+		r.Header.Time = time.Now().UnixNano()
+	}
 
 	// check authinfo if it is not InternalAuthenticateRequest
 	if r.Authenticate == nil {
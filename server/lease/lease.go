@@ -16,6 +16,7 @@ package lease
 
 import (
 	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -33,10 +34,14 @@ type Lease struct {
 	// expiry is time when lease should expire. no expiration when expiry.IsZero() is true
 	expiry time.Time
 
-	// mu protects concurrent accesses to itemSet
-	mu      sync.RWMutex
-	itemSet map[LeaseItem]struct{}
-	revokec chan struct{}
+	// parent is the lease this lease was granted under, if any
+	parent LeaseID
+
+	// mu protects concurrent accesses to itemSet and children
+	mu       sync.RWMutex
+	itemSet  map[LeaseItem]struct{}
+	children map[LeaseID]struct{}
+	revokec  chan struct{}
 }
 
 func (l *Lease) expired() bool {
@@ -44,7 +49,7 @@ func (l *Lease) expired() bool {
 }
 
 func (l *Lease) persistTo(b backend.Backend) {
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL, Parent: int64(l.parent)}
 	tx := b.BatchTx()
 	tx.LockInsideApply()
 	defer tx.Unlock()
@@ -90,6 +95,40 @@ func (l *Lease) Keys() []string {
 	return keys
 }
 
+// Parent returns the ID of the lease this lease was granted under, or
+// NoLease if the lease has no parent.
+func (l *Lease) Parent() LeaseID {
+	return l.parent
+}
+
+// Children returns the IDs of the leases granted under the lease, in
+// ascending order.
+func (l *Lease) Children() []LeaseID {
+	l.mu.RLock()
+	ids := make([]LeaseID, 0, len(l.children))
+	for id := range l.children {
+		ids = append(ids, id)
+	}
+	l.mu.RUnlock()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (l *Lease) addChild(id LeaseID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.children == nil {
+		l.children = make(map[LeaseID]struct{})
+	}
+	l.children[id] = struct{}{}
+}
+
+func (l *Lease) removeChild(id LeaseID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.children, id)
+}
+
 // Remaining returns the remaining time of the lease.
 func (l *Lease) Remaining() time.Duration {
 	l.expiryMu.RLock()
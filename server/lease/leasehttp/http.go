@@ -115,8 +115,12 @@ func (h *leaseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				ID:         lreq.LeaseTimeToLiveRequest.ID,
 				TTL:        int64(l.Remaining().Seconds()),
 				GrantedTTL: l.TTL(),
+				Parent:     int64(l.Parent()),
 			},
 		}
+		for _, id := range h.l.Descendants(l.ID) {
+			resp.LeaseTimeToLiveResponse.Children = append(resp.LeaseTimeToLiveResponse.Children, int64(id))
+		}
 		if lreq.LeaseTimeToLiveRequest.Keys {
 			ks := l.Keys()
 			kbs := make([][]byte, len(ks))
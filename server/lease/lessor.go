@@ -61,6 +61,12 @@ var (
 	ErrLeaseNotFound    = errors.New("lease not found")
 	ErrLeaseExists      = errors.New("lease already exists")
 	ErrLeaseTTLTooLarge = errors.New("too large lease TTL")
+
+	ErrParentLeaseNotFound = errors.New("parent lease not found")
+
+	ErrAttachNotSupported = errors.New("lease attach and detach are not supported by the cluster version")
+	ErrExpiryNotSupported = errors.New("key expiry is not supported by the cluster version")
+	ErrParentNotSupported = errors.New("parent leases are not supported by the cluster version")
 )
 
 // TxnDelete is a TxnWrite that only permits deletes. Defined here
@@ -90,9 +96,13 @@ type Lessor interface {
 
 	// Grant grants a lease that expires at least after TTL seconds.
 	Grant(id LeaseID, ttl int64) (*Lease, error)
-	// Revoke revokes a lease with given ID. The item attached to the
-	// given lease will be removed. If the ID does not exist, an error
-	// will be returned.
+	// GrantChild grants a lease under the given parent lease. The child is
+	// revoked together with its parent. If the parent does not exist, an
+	// error will be returned.
+	GrantChild(id, parent LeaseID, ttl int64) (*Lease, error)
+	// Revoke revokes a lease with given ID, along with all the leases
+	// granted under it. The items attached to the revoked leases will be
+	// removed. If the ID does not exist, an error will be returned.
 	Revoke(id LeaseID) error
 
 	// Checkpoint applies the remainingTTL of a lease. The remainingTTL is used in Promote to set
@@ -126,6 +136,10 @@ type Lessor interface {
 	// Lookup gives the lease at a given lease id, if any
 	Lookup(id LeaseID) *Lease
 
+	// Descendants returns the IDs of the leases granted under the given
+	// lease, directly or indirectly; they are revoked along with it.
+	Descendants(id LeaseID) []LeaseID
+
 	// Leases lists all leases.
 	Leases() []*Lease
 
@@ -269,6 +283,17 @@ func (le *lessor) SetCheckpointer(cp Checkpointer) {
 }
 
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
+	return le.grant(id, NoLease, ttl)
+}
+
+func (le *lessor) GrantChild(id, parent LeaseID, ttl int64) (*Lease, error) {
+	if parent == NoLease {
+		return nil, ErrParentLeaseNotFound
+	}
+	return le.grant(id, parent, ttl)
+}
+
+func (le *lessor) grant(id, parent LeaseID, ttl int64) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
 	}
@@ -282,6 +307,7 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	l := &Lease{
 		ID:      id,
 		ttl:     ttl,
+		parent:  parent,
 		itemSet: make(map[LeaseItem]struct{}),
 		revokec: make(chan struct{}),
 	}
@@ -296,6 +322,13 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	if _, ok := le.leaseMap[id]; ok {
 		return nil, ErrLeaseExists
 	}
+	if parent != NoLease {
+		p := le.leaseMap[parent]
+		if p == nil {
+			return nil, ErrParentLeaseNotFound
+		}
+		p.addChild(id)
+	}
 
 	if le.isPrimary() {
 		l.refresh(0)
@@ -327,12 +360,24 @@ func (le *lessor) Revoke(id LeaseID) error {
 		return ErrLeaseNotFound
 	}
 
+	// the leases granted under the lease are revoked along with it
+	ls := le.unsafeLeaseTree(l)
+
 	// We shouldn't delete the lease inside the transaction lock, otherwise
 	// it may lead to deadlock with Grant or Checkpoint operations, which
 	// acquire the le.mu firstly and then the batchTx lock.
-	delete(le.leaseMap, id)
+	for _, rl := range ls {
+		delete(le.leaseMap, rl.ID)
+	}
+	if p := le.leaseMap[l.parent]; p != nil {
+		p.removeChild(id)
+	}
 
-	defer close(l.revokec)
+	defer func() {
+		for _, rl := range ls {
+			close(rl.revokec)
+		}
+	}()
 	// unlock before doing external work
 	le.mu.Unlock()
 
@@ -344,7 +389,10 @@ func (le *lessor) Revoke(id LeaseID) error {
 
 	// sort keys so deletes are in same order among all members,
 	// otherwise the backend hashes will be different
-	keys := l.Keys()
+	var keys []string
+	for _, rl := range ls {
+		keys = append(keys, rl.Keys()...)
+	}
 	sort.StringSlice(keys).Sort()
 	for _, key := range keys {
 		txn.DeleteRange([]byte(key), nil)
@@ -353,14 +401,30 @@ func (le *lessor) Revoke(id LeaseID) error {
 	// lease deletion needs to be in the same backend transaction with the
 	// kv deletion. Or we might end up with not executing the revoke or not
 	// deleting the keys if etcdserver fails in between.
-	schema.UnsafeDeleteLease(le.b.BatchTx(), &leasepb.Lease{ID: int64(l.ID)})
+	for _, rl := range ls {
+		schema.UnsafeDeleteLease(le.b.BatchTx(), &leasepb.Lease{ID: int64(rl.ID)})
+	}
 
 	txn.End()
 
-	leaseRevoked.Inc()
+	leaseRevoked.Add(float64(len(ls)))
 	return nil
 }
 
+// unsafeLeaseTree returns the lease followed by all the leases granted
+// under it, directly or indirectly.
+func (le *lessor) unsafeLeaseTree(l *Lease) []*Lease {
+	ls := []*Lease{l}
+	for i := 0; i < len(ls); i++ {
+		for _, id := range ls[i].Children() {
+			if c := le.leaseMap[id]; c != nil {
+				ls = append(ls, c)
+			}
+		}
+	}
+	return ls
+}
+
 func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
 	le.mu.Lock()
 	defer le.mu.Unlock()
@@ -384,6 +448,27 @@ func (le *lessor) shouldPersistCheckpoints() bool {
 	return le.checkpointPersist || (cv != nil && greaterOrEqual(*cv, version.V3_6))
 }
 
+// IsAttachSupported returns true if the cluster version cv supports attaching
+// keys to and detaching them from leases without changing their values. Such
+// lease changes are stored in a format etcd before v3.6 cannot read.
+func IsAttachSupported(cv *semver.Version) bool {
+	return cv != nil && greaterOrEqual(*cv, version.V3_6)
+}
+
+// IsExpirySupported returns true if the cluster version cv supports keys
+// with an expire time. Members before v3.6 drop the expire time of puts and
+// cannot apply the deletion of expired keys.
+func IsExpirySupported(cv *semver.Version) bool {
+	return cv != nil && greaterOrEqual(*cv, version.V3_6)
+}
+
+// IsParentSupported returns true if the cluster version cv supports granting
+// leases with a parent. Members before v3.6 grant them as plain leases, which
+// are not revoked with their parent.
+func IsParentSupported(cv *semver.Version) bool {
+	return cv != nil && greaterOrEqual(*cv, version.V3_6)
+}
+
 func greaterOrEqual(first, second semver.Version) bool {
 	return !version.LessThan(first, second)
 }
@@ -448,6 +533,20 @@ func (le *lessor) Lookup(id LeaseID) *Lease {
 	return le.leaseMap[id]
 }
 
+func (le *lessor) Descendants(id LeaseID) []LeaseID {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	l := le.leaseMap[id]
+	if l == nil {
+		return nil
+	}
+	var ids []LeaseID
+	for _, c := range le.unsafeLeaseTree(l)[1:] {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
 func (le *lessor) unsafeLeases() []*Lease {
 	leases := make([]*Lease, 0, len(le.leaseMap))
 	for _, l := range le.leaseMap {
@@ -813,6 +912,15 @@ func (le *lessor) initAndRecover() {
 			remainingTTL: lpb.RemainingTTL,
 		}
 	}
+	for _, lpb := range lpbs {
+		if lpb.Parent == 0 {
+			continue
+		}
+		if p := le.leaseMap[LeaseID(lpb.Parent)]; p != nil {
+			le.leaseMap[LeaseID(lpb.ID)].parent = p.ID
+			p.addChild(LeaseID(lpb.ID))
+		}
+	}
 	le.leaseExpiredNotifier.Init()
 	heap.Init(&le.leaseCheckpointHeap)
 
@@ -829,6 +937,8 @@ func (fl *FakeLessor) SetCheckpointer(cp Checkpointer) {}
 
 func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) { return nil, nil }
 
+func (fl *FakeLessor) GrantChild(id, parent LeaseID, ttl int64) (*Lease, error) { return nil, nil }
+
 func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
 func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
@@ -846,6 +956,8 @@ func (fl *FakeLessor) Renew(id LeaseID) (int64, error) { return 10, nil }
 
 func (fl *FakeLessor) Lookup(id LeaseID) *Lease { return nil }
 
+func (fl *FakeLessor) Descendants(id LeaseID) []LeaseID { return nil }
+
 func (fl *FakeLessor) Leases() []*Lease { return nil }
 
 func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
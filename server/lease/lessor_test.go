@@ -208,6 +208,88 @@ func TestLessorRevoke(t *testing.T) {
 	}
 }
 
+func TestLessorRevokeChildren(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	var fd *fakeDeleter
+	le.SetRangeDeleter(func() TxnDelete {
+		fd = newFakeDeleter(be)
+		return fd
+	})
+
+	if _, err := le.GrantChild(2, 1, 100); err != ErrParentLeaseNotFound {
+		t.Fatalf("err = %v, want %v", err, ErrParentLeaseNotFound)
+	}
+
+	// 1 -> 2 -> 3, 1 -> 4; 5 is unrelated
+	for _, g := range []struct{ id, parent LeaseID }{{1, NoLease}, {2, 1}, {3, 2}, {4, 1}, {5, NoLease}} {
+		var (
+			l   *Lease
+			err error
+		)
+		if g.parent == NoLease {
+			l, err = le.Grant(g.id, 100)
+		} else {
+			l, err = le.GrantChild(g.id, g.parent, 100)
+		}
+		if err != nil {
+			t.Fatalf("could not grant lease %x (%v)", g.id, err)
+		}
+		if err = le.Attach(l.ID, []LeaseItem{{fmt.Sprintf("foo%d", g.id)}}); err != nil {
+			t.Fatalf("failed to attach items to the lease: %v", err)
+		}
+	}
+
+	if children := le.Lookup(1).Children(); !reflect.DeepEqual(children, []LeaseID{2, 4}) {
+		t.Errorf("children = %v, want [2 4]", children)
+	}
+	if parent := le.Lookup(3).Parent(); parent != 2 {
+		t.Errorf("parent = %x, want 2", parent)
+	}
+	if ids := le.Descendants(1); !reflect.DeepEqual(ids, []LeaseID{2, 4, 3}) {
+		t.Errorf("descendants = %v, want [2 4 3]", ids)
+	}
+
+	// revoking a child detaches it from its parent
+	if err := le.Revoke(4); err != nil {
+		t.Fatal("failed to revoke lease:", err)
+	}
+	if children := le.Lookup(1).Children(); !reflect.DeepEqual(children, []LeaseID{2}) {
+		t.Errorf("children = %v, want [2]", children)
+	}
+
+	if err := le.Revoke(1); err != nil {
+		t.Fatal("failed to revoke lease:", err)
+	}
+	for _, id := range []LeaseID{1, 2, 3} {
+		if le.Lookup(id) != nil {
+			t.Errorf("got revoked lease %x", id)
+		}
+	}
+	if le.Lookup(5) == nil {
+		t.Errorf("lease 5 should not be revoked")
+	}
+
+	wdeleted := []string{"foo1_", "foo2_", "foo3_"}
+	if !reflect.DeepEqual(fd.deleted, wdeleted) {
+		t.Errorf("deleted= %v, want %v", fd.deleted, wdeleted)
+	}
+
+	tx := be.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	for _, id := range []LeaseID{1, 2, 3} {
+		if lpb := schema.MustUnsafeGetLease(tx, int64(id)); lpb != nil {
+			t.Errorf("lpb = %d, want nil", lpb)
+		}
+	}
+}
+
 func renew(t *testing.T, le *lessor, id LeaseID) int64 {
 	ch := make(chan int64, 1)
 	errch := make(chan error, 1)
@@ -426,6 +508,32 @@ func TestLessorRecover(t *testing.T) {
 	}
 }
 
+func TestLessorRecoverChildren(t *testing.T) {
+	lg := zap.NewNop()
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	if _, err := le.Grant(1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := le.GrantChild(2, 1, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a new lessor with the same backend
+	nle := newLessor(lg, be, clusterLatest(), LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	if children := nle.Lookup(1).Children(); !reflect.DeepEqual(children, []LeaseID{2}) {
+		t.Errorf("children = %v, want [2]", children)
+	}
+	if parent := nle.Lookup(2).Parent(); parent != 1 {
+		t.Errorf("parent = %x, want 1", parent)
+	}
+}
+
 func TestLessorExpire(t *testing.T) {
 	lg := zap.NewNop()
 	dir, be := NewTestBackend(t)
@@ -669,6 +777,28 @@ func TestLessorCheckpointPersistenceAfterRestart(t *testing.T) {
 	}
 }
 
+func TestIsSupported(t *testing.T) {
+	tests := []struct {
+		cluster cluster
+		want    bool
+	}{
+		{clusterNil(), false},
+		{clusterV3_5(), false},
+		{clusterLatest(), true},
+	}
+	for i, tt := range tests {
+		if got := IsAttachSupported(tt.cluster.Version()); got != tt.want {
+			t.Errorf("#%d: attach supported = %t, want %t", i, got, tt.want)
+		}
+		if got := IsExpirySupported(tt.cluster.Version()); got != tt.want {
+			t.Errorf("#%d: expiry supported = %t, want %t", i, got, tt.want)
+		}
+		if got := IsParentSupported(tt.cluster.Version()); got != tt.want {
+			t.Errorf("#%d: parent supported = %t, want %t", i, got, tt.want)
+		}
+	}
+}
+
 type fakeDeleter struct {
 	deleted []string
 	tx      backend.BatchTx
@@ -44,6 +44,10 @@ func (s *es2ec) Resign(ctx context.Context, r *v3electionpb.ResignRequest, opts
 	return s.es.Resign(ctx, r)
 }
 
+func (s *es2ec) Transfer(ctx context.Context, r *v3electionpb.TransferRequest, opts ...grpc.CallOption) (*v3electionpb.TransferResponse, error) {
+	return s.es.Transfer(ctx, r)
+}
+
 func (s *es2ec) Observe(ctx context.Context, in *v3electionpb.LeaderRequest, opts ...grpc.CallOption) (v3electionpb.Election_ObserveClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.es.Observe(in, &es2ecServerStream{ss})
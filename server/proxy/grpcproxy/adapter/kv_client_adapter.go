@@ -47,3 +47,7 @@ func (s *kvs2kvc) Txn(ctx context.Context, in *pb.TxnRequest, opts ...grpc.CallO
 func (s *kvs2kvc) Compact(ctx context.Context, in *pb.CompactionRequest, opts ...grpc.CallOption) (*pb.CompactionResponse, error) {
 	return s.kvs.Compact(ctx, in)
 }
+
+func (s *kvs2kvc) KeyHistory(ctx context.Context, in *pb.KeyHistoryRequest, opts ...grpc.CallOption) (*pb.KeyHistoryResponse, error) {
+	return s.kvs.KeyHistory(ctx, in)
+}
@@ -38,6 +38,14 @@ func (c *ls2lc) LeaseRevoke(ctx context.Context, in *pb.LeaseRevokeRequest, opts
 	return c.leaseServer.LeaseRevoke(ctx, in)
 }
 
+func (c *ls2lc) LeaseAttach(ctx context.Context, in *pb.LeaseAttachRequest, opts ...grpc.CallOption) (*pb.LeaseAttachResponse, error) {
+	return c.leaseServer.LeaseAttach(ctx, in)
+}
+
+func (c *ls2lc) LeaseDetach(ctx context.Context, in *pb.LeaseDetachRequest, opts ...grpc.CallOption) (*pb.LeaseDetachResponse, error) {
+	return c.leaseServer.LeaseDetach(ctx, in)
+}
+
 func (c *ls2lc) LeaseKeepAlive(ctx context.Context, opts ...grpc.CallOption) (pb.Lease_LeaseKeepAliveClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return c.leaseServer.LeaseKeepAlive(&ls2lcServerStream{ss})
@@ -56,6 +56,22 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest, opts ...grpc.CallOption) (*pb.QuotaSetResponse, error) {
+	return s.mts.QuotaSet(ctx, r)
+}
+
+func (s *mts2mtc) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest, opts ...grpc.CallOption) (*pb.QuotaGetResponse, error) {
+	return s.mts.QuotaGet(ctx, r)
+}
+
+func (s *mts2mtc) QuotaList(ctx context.Context, r *pb.QuotaListRequest, opts ...grpc.CallOption) (*pb.QuotaListResponse, error) {
+	return s.mts.QuotaList(ctx, r)
+}
+
+func (s *mts2mtc) CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest, opts ...grpc.CallOption) (*pb.CompactEstimateResponse, error) {
+	return s.mts.CompactEstimate(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
@@ -63,6 +79,13 @@ func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...
 	return &ss2scClientStream{cs}, nil
 }
 
+func (s *mts2mtc) SnapshotIncremental(ctx context.Context, in *pb.SnapshotIncrementalRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotIncrementalClient, error) {
+	cs := newPipeStream(ctx, func(ss chanServerStream) error {
+		return s.mts.SnapshotIncremental(in, &ss2scServerStream{ss})
+	})
+	return &ss2scClientStream{cs}, nil
+}
+
 // ss2scClientStream implements Maintenance_SnapshotClient
 type ss2scClientStream struct{ chanClientStream }
 
@@ -62,3 +62,7 @@ func (ep *electionProxy) Observe(req *v3electionpb.LeaderRequest, s v3electionpb
 func (ep *electionProxy) Resign(ctx context.Context, req *v3electionpb.ResignRequest) (*v3electionpb.ResignResponse, error) {
 	return ep.electionClient.Resign(ctx, req)
 }
+
+func (ep *electionProxy) Transfer(ctx context.Context, req *v3electionpb.TransferRequest) (*v3electionpb.TransferResponse, error) {
+	return ep.electionClient.Transfer(ctx, req)
+}
@@ -139,6 +139,20 @@ func (p *kvProxy) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.Com
 	return (*pb.CompactionResponse)(resp), err
 }
 
+func (p *kvProxy) KeyHistory(ctx context.Context, r *pb.KeyHistoryRequest) (*pb.KeyHistoryResponse, error) {
+	opts := []clientv3.OpOption{
+		clientv3.WithRange(string(r.RangeEnd)),
+		clientv3.WithMinModRev(r.StartRevision),
+		clientv3.WithMaxModRev(r.EndRevision),
+		clientv3.WithLimit(r.Limit),
+	}
+	if r.Serializable {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	resp, err := p.kv.History(ctx, string(r.Key), opts...)
+	return (*pb.KeyHistoryResponse)(resp), err
+}
+
 func requestOpToOp(union *pb.RequestOp) clientv3.Op {
 	switch tv := union.Request.(type) {
 	case *pb.RequestOp_RequestRange:
@@ -176,6 +190,9 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	opts = append(opts, clientv3.WithMinCreateRev(r.MinCreateRevision))
 	opts = append(opts, clientv3.WithMaxModRev(r.MaxModRevision))
 	opts = append(opts, clientv3.WithMinModRev(r.MinModRevision))
+	if len(r.ContinueToken) != 0 {
+		opts = append(opts, clientv3.WithContinue(r.ContinueToken))
+	}
 	if r.CountOnly {
 		opts = append(opts, clientv3.WithCountOnly())
 	}
@@ -91,6 +91,32 @@ func (lp *leaseProxy) LeaseRevoke(ctx context.Context, rr *pb.LeaseRevokeRequest
 	return (*pb.LeaseRevokeResponse)(r), nil
 }
 
+func (lp *leaseProxy) LeaseAttach(ctx context.Context, rr *pb.LeaseAttachRequest) (*pb.LeaseAttachResponse, error) {
+	r, err := lp.lessor.Attach(ctx, clientv3.LeaseID(rr.ID), keysToStrings(rr.Keys)...)
+	if err != nil {
+		return nil, err
+	}
+	lp.leader.gotLeader()
+	return (*pb.LeaseAttachResponse)(r), nil
+}
+
+func (lp *leaseProxy) LeaseDetach(ctx context.Context, rr *pb.LeaseDetachRequest) (*pb.LeaseDetachResponse, error) {
+	r, err := lp.lessor.Detach(ctx, keysToStrings(rr.Keys)...)
+	if err != nil {
+		return nil, err
+	}
+	lp.leader.gotLeader()
+	return (*pb.LeaseDetachResponse)(r), nil
+}
+
+func keysToStrings(keys [][]byte) []string {
+	ks := make([]string, len(keys))
+	for i, k := range keys {
+		ks[i] = string(k)
+	}
+	return ks
+}
+
 func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiveRequest) (*pb.LeaseTimeToLiveResponse, error) {
 	var (
 		r   *clientv3.LeaseTimeToLiveResponse
@@ -110,6 +136,10 @@ func (lp *leaseProxy) LeaseTimeToLive(ctx context.Context, rr *pb.LeaseTimeToLiv
 		TTL:        r.TTL,
 		GrantedTTL: r.GrantedTTL,
 		Keys:       r.Keys,
+		Parent:     int64(r.Parent),
+	}
+	for _, id := range r.Children {
+		rp.Children = append(rp.Children, int64(id))
 	}
 	return rp, err
 }
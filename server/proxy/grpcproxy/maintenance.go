@@ -46,7 +46,24 @@ func (mp *maintenanceProxy) Snapshot(sr *pb.SnapshotRequest, stream pb.Maintenan
 	if err != nil {
 		return err
 	}
+	return forwardSnapshot(sc, stream)
+}
+
+func (mp *maintenanceProxy) SnapshotIncremental(sr *pb.SnapshotIncrementalRequest, stream pb.Maintenance_SnapshotIncrementalServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	ctx = withClientAuthToken(ctx, stream.Context())
 
+	sc, err := mp.maintenanceClient.SnapshotIncremental(ctx, sr)
+	if err != nil {
+		return err
+	}
+	return forwardSnapshot(sc, stream)
+}
+
+// forwardSnapshot relays a snapshot stream from the backend member to the proxy client.
+func forwardSnapshot(sc pb.Maintenance_SnapshotClient, stream pb.Maintenance_SnapshotServer) error {
 	for {
 		rr, err := sc.Recv()
 		if err != nil {
@@ -85,3 +102,19 @@ func (mp *maintenanceProxy) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequ
 func (mp *maintenanceProxy) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	return mp.maintenanceClient.Downgrade(ctx, r)
 }
+
+func (mp *maintenanceProxy) QuotaSet(ctx context.Context, r *pb.QuotaSetRequest) (*pb.QuotaSetResponse, error) {
+	return mp.maintenanceClient.QuotaSet(ctx, r)
+}
+
+func (mp *maintenanceProxy) QuotaGet(ctx context.Context, r *pb.QuotaGetRequest) (*pb.QuotaGetResponse, error) {
+	return mp.maintenanceClient.QuotaGet(ctx, r)
+}
+
+func (mp *maintenanceProxy) QuotaList(ctx context.Context, r *pb.QuotaListRequest) (*pb.QuotaListResponse, error) {
+	return mp.maintenanceClient.QuotaList(ctx, r)
+}
+
+func (mp *maintenanceProxy) CompactEstimate(ctx context.Context, r *pb.CompactEstimateRequest) (*pb.CompactEstimateResponse, error) {
+	return mp.maintenanceClient.CompactEstimate(ctx, r)
+}
@@ -61,6 +61,8 @@ func newWatchBroadcast(lg *zap.Logger, wp *watchProxy, w *watcher, update func(*
 			clientv3.WithRev(wb.nextrev),
 			clientv3.WithPrevKV(),
 			clientv3.WithCreatedNotify(),
+			// the watchers of the broadcast filter them out unless requested
+			clientv3.WithLeaseEvents(),
 		}
 
 		cctx = withClientAuthToken(cctx, w.wps.stream.Context())
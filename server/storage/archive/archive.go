@@ -0,0 +1,413 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+const (
+	// ManifestName is the name of the manifest file in the archive directory.
+	ManifestName = "manifest.json"
+
+	// KindWAL is the kind of archived WAL segments.
+	KindWAL = "wal"
+	// KindSnap is the kind of archived raft snapshot files.
+	KindSnap = "snap"
+	// KindDB is the kind of archived backend snapshot files.
+	KindDB = "db"
+)
+
+var (
+	ErrNoDir       = errors.New("archive: no archive directory")
+	ErrUnknownKind = errors.New("archive: unknown file kind")
+	ErrCRCMismatch = errors.New("archive: crc mismatch")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+	kinds    = []string{KindWAL, KindSnap, KindDB}
+)
+
+// Config configures an Archiver.
+type Config struct {
+	// Dir is the archive directory.
+	Dir string
+	// MaxFiles is the maximum number of archived files of each kind to retain
+	// (0 is unlimited).
+	MaxFiles uint
+	// Retention is how long archived files are retained (0 is unlimited).
+	Retention time.Duration
+}
+
+// Entry describes a single archived file.
+type Entry struct {
+	Name       string    `json:"name"`
+	Kind       string    `json:"kind"`
+	Size       int64     `json:"size"`
+	CRC32C     uint32    `json:"crc32c"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// Manifest lists the archived files in the order they were archived.
+// Pruned holds, per kind, the name of the newest file removed by retention,
+// so that it is not archived again while still present in the data directory.
+type Manifest struct {
+	Entries []Entry           `json:"entries"`
+	Pruned  map[string]string `json:"pruned,omitempty"`
+}
+
+// Archiver copies WAL segments and snapshot files into an archive directory.
+// It is safe for concurrent use.
+type Archiver struct {
+	lg  *zap.Logger
+	cfg Config
+
+	mu       sync.Mutex
+	manifest Manifest
+	archived map[string]struct{}
+}
+
+// New returns an Archiver for cfg.Dir, creating the directory and loading its
+// manifest if one exists.
+func New(lg *zap.Logger, cfg Config) (*Archiver, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	if cfg.Dir == "" {
+		return nil, ErrNoDir
+	}
+	for _, kind := range kinds {
+		if err := fileutil.TouchDirAll(lg, filepath.Join(cfg.Dir, kind)); err != nil {
+			return nil, err
+		}
+	}
+	m, err := ReadManifest(cfg.Dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if m == nil {
+		m = &Manifest{}
+	}
+	if m.Pruned == nil {
+		m.Pruned = make(map[string]string)
+	}
+
+	a := &Archiver{lg: lg, cfg: cfg, manifest: *m, archived: make(map[string]struct{})}
+	for _, e := range m.Entries {
+		a.archived[e.Name] = struct{}{}
+	}
+	return a, nil
+}
+
+// ReadManifest loads the manifest of the archive in dir.
+func ReadManifest(dir string) (*Manifest, error) {
+	b, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("archive: malformed manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Path returns the path of the archived file described by e.
+func Path(dir string, e Entry) string {
+	return filepath.Join(dir, e.Kind, e.Name)
+}
+
+// Verify checks the size and CRC-32C of every file listed in the manifest
+// of the archive in dir.
+func Verify(dir string) error {
+	m, err := ReadManifest(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range m.Entries {
+		f, err := os.Open(Path(dir, e))
+		if err != nil {
+			return err
+		}
+		h := crc32.New(crcTable)
+		n, err := io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		if n != e.Size || h.Sum32() != e.CRC32C {
+			return fmt.Errorf("%w: %s", ErrCRCMismatch, e.Name)
+		}
+	}
+	return nil
+}
+
+func kindOf(name string) string {
+	switch {
+	case strings.HasSuffix(name, ".wal"):
+		return KindWAL
+	case strings.HasSuffix(name, ".snap.db"):
+		return KindDB
+	case strings.HasSuffix(name, ".snap"):
+		return KindSnap
+	}
+	return ""
+}
+
+// Archive copies the file at path into the archive, unless it has already
+// been archived or pruned. The file must no longer be written to.
+func (a *Archiver) Archive(path string) error {
+	name := filepath.Base(path)
+	kind := kindOf(name)
+	if kind == "" {
+		return fmt.Errorf("%w: %q", ErrUnknownKind, name)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.archived[name]; ok {
+		return nil
+	}
+	// file names of a kind sort in the order the files were created
+	if pruned := a.manifest.Pruned[kind]; pruned != "" && name <= pruned {
+		return nil
+	}
+
+	size, crc, err := copyFile(path, filepath.Join(a.cfg.Dir, kind, name))
+	if err != nil {
+		return err
+	}
+	e := Entry{Name: name, Kind: kind, Size: size, CRC32C: crc, ArchivedAt: time.Now().UTC()}
+	a.manifest.Entries = append(a.manifest.Entries, e)
+	if err = a.saveManifest(); err != nil {
+		a.manifest.Entries = a.manifest.Entries[:len(a.manifest.Entries)-1]
+		return err
+	}
+	a.archived[name] = struct{}{}
+
+	a.lg.Info(
+		"archived file",
+		zap.String("path", path),
+		zap.String("archive-dir", a.cfg.Dir),
+		zap.Int64("size", size),
+		zap.Uint32("crc32c", crc),
+	)
+	return nil
+}
+
+// ArchiveCompleted archives every WAL segment in walDir except the one being
+// written, and every snapshot file in snapDir except the newest raft snapshot,
+// which may still be being written.
+func (a *Archiver) ArchiveCompleted(walDir, snapDir string) error {
+	wals, err := namesWithSuffix(walDir, ".wal")
+	if err != nil {
+		return err
+	}
+	snaps, err := namesWithSuffix(snapDir, ".snap")
+	if err != nil {
+		return err
+	}
+	dbs, err := namesWithSuffix(snapDir, ".snap.db")
+	if err != nil {
+		return err
+	}
+
+	var paths []string
+	for i := 0; i < len(wals)-1; i++ {
+		paths = append(paths, filepath.Join(walDir, wals[i]))
+	}
+	for i := 0; i < len(snaps)-1; i++ {
+		paths = append(paths, filepath.Join(snapDir, snaps[i]))
+	}
+	// backend snapshots are renamed into place once complete
+	for _, name := range dbs {
+		paths = append(paths, filepath.Join(snapDir, name))
+	}
+
+	for _, p := range paths {
+		if err = a.Archive(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Prune removes the oldest archived files of each kind that exceed the
+// configured MaxFiles or are older than the configured Retention.
+func (a *Archiver) Prune(now time.Time) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	counts := make(map[string]uint)
+	for _, e := range a.manifest.Entries {
+		counts[e.Kind]++
+	}
+
+	var (
+		kept   []Entry
+		pruned []Entry
+	)
+	// entries are in archive order, so the oldest of each kind come first
+	for _, e := range a.manifest.Entries {
+		tooMany := a.cfg.MaxFiles > 0 && counts[e.Kind] > a.cfg.MaxFiles
+		tooOld := a.cfg.Retention > 0 && now.Sub(e.ArchivedAt) > a.cfg.Retention
+		if tooMany || tooOld {
+			counts[e.Kind]--
+			pruned = append(pruned, e)
+			continue
+		}
+		kept = append(kept, e)
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	prev := a.manifest
+	a.manifest = Manifest{Entries: kept, Pruned: make(map[string]string)}
+	for k, v := range prev.Pruned {
+		a.manifest.Pruned[k] = v
+	}
+	for _, e := range pruned {
+		if e.Name > a.manifest.Pruned[e.Kind] {
+			a.manifest.Pruned[e.Kind] = e.Name
+		}
+	}
+	// drop files from the manifest before removing them, so the manifest
+	// never lists a missing file
+	if err := a.saveManifest(); err != nil {
+		a.manifest = prev
+		return err
+	}
+	for _, e := range pruned {
+		delete(a.archived, e.Name)
+		if err := os.Remove(Path(a.cfg.Dir, e)); err != nil && !os.IsNotExist(err) {
+			a.lg.Warn("failed to remove archived file", zap.String("name", e.Name), zap.Error(err))
+			continue
+		}
+		a.lg.Info("pruned archived file", zap.String("name", e.Name), zap.String("archive-dir", a.cfg.Dir))
+	}
+	return nil
+}
+
+// Manifest returns a copy of the current manifest.
+func (a *Archiver) Manifest() Manifest {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	m := Manifest{Entries: append([]Entry(nil), a.manifest.Entries...), Pruned: make(map[string]string)}
+	for k, v := range a.manifest.Pruned {
+		m.Pruned[k] = v
+	}
+	return m
+}
+
+func (a *Archiver) saveManifest() error {
+	b, err := json.MarshalIndent(a.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(a.cfg.Dir, ManifestName), b)
+}
+
+func namesWithSuffix(dir, suffix string) ([]string, error) {
+	names, err := fileutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var matched []string
+	for _, name := range names {
+		if strings.HasSuffix(name, suffix) {
+			matched = append(matched, name)
+		}
+	}
+	sort.Strings(matched)
+	return matched, nil
+}
+
+// copyFile copies src to dst through a temporary file and returns the size
+// and CRC-32C of the copied data.
+func copyFile(src, dst string) (int64, uint32, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return 0, 0, err
+	}
+	h := crc32.New(crcTable)
+	n, err := io.Copy(io.MultiWriter(out, h), in)
+	if err == nil {
+		err = fileutil.Fsync(out)
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, dst)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, 0, err
+	}
+	return n, h.Sum32(), syncDir(filepath.Dir(dst))
+}
+
+func writeFileAtomic(path string, b []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if err == nil {
+		err = fileutil.Fsync(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+func syncDir(dir string) error {
+	d, err := fileutil.OpenDir(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return fileutil.Fsync(d)
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func writeTestFiles(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("data-"+name), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func walName(seq uint64) string { return fmt.Sprintf("%016x-%016x.wal", seq, seq*10) }
+
+func entryNames(m Manifest) []string {
+	var names []string
+	for _, e := range m.Entries {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+func TestArchiveCompleted(t *testing.T) {
+	walDir, snapDir, archiveDir := t.TempDir(), t.TempDir(), t.TempDir()
+	writeTestFiles(t, walDir, walName(0), walName(1), walName(2), "0.tmp")
+	writeTestFiles(t, snapDir, "0000000000000001-0000000000000010.snap", "0000000000000001-0000000000000020.snap", "0000000000000030.snap.db", "db")
+
+	a, err := New(zaptest.NewLogger(t), Config{Dir: archiveDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = a.ArchiveCompleted(walDir, snapDir); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{walName(0), walName(1), "0000000000000001-0000000000000010.snap", "0000000000000030.snap.db"}
+	if got := entryNames(a.Manifest()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("archived = %v, want %v", got, want)
+	}
+
+	// archiving again is a no-op
+	if err = a.Archive(filepath.Join(walDir, walName(0))); err != nil {
+		t.Fatal(err)
+	}
+	if got := entryNames(a.Manifest()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("archived = %v, want %v", got, want)
+	}
+
+	if err = Verify(archiveDir); err != nil {
+		t.Fatal(err)
+	}
+	// the manifest survives a restart
+	a2, err := New(zaptest.NewLogger(t), Config{Dir: archiveDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := entryNames(a2.Manifest()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("reloaded = %v, want %v", got, want)
+	}
+}
+
+func TestArchiveUnknownKind(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFiles(t, dir, "foo")
+	a, err := New(zaptest.NewLogger(t), Config{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = a.Archive(filepath.Join(dir, "foo")); !errors.Is(err, ErrUnknownKind) {
+		t.Fatalf("err = %v, want %v", err, ErrUnknownKind)
+	}
+}
+
+func TestVerifyCRCMismatch(t *testing.T) {
+	walDir, archiveDir := t.TempDir(), t.TempDir()
+	writeTestFiles(t, walDir, walName(0))
+	a, err := New(zaptest.NewLogger(t), Config{Dir: archiveDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = a.Archive(filepath.Join(walDir, walName(0))); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(archiveDir, KindWAL, walName(0)), []byte("data-corrupt"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(archiveDir); !errors.Is(err, ErrCRCMismatch) {
+		t.Fatalf("err = %v, want %v", err, ErrCRCMismatch)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	walDir, archiveDir := t.TempDir(), t.TempDir()
+	writeTestFiles(t, walDir, walName(0), walName(1), walName(2), walName(3))
+
+	a, err := New(zaptest.NewLogger(t), Config{Dir: archiveDir, MaxFiles: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = a.ArchiveCompleted(walDir, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if err = a.Prune(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{walName(1), walName(2)}
+	if got := entryNames(a.Manifest()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("archived = %v, want %v", got, want)
+	}
+	if _, err = os.Stat(filepath.Join(archiveDir, KindWAL, walName(0))); !os.IsNotExist(err) {
+		t.Fatalf("expected pruned file to be removed, got %v", err)
+	}
+
+	// pruned files still in the WAL directory are not archived again
+	if err = a.ArchiveCompleted(walDir, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if got := entryNames(a.Manifest()); !reflect.DeepEqual(got, want) {
+		t.Fatalf("archived = %v, want %v", got, want)
+	}
+
+	// retention by age
+	a.cfg.Retention = time.Hour
+	if err = a.Prune(time.Now().Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if got := entryNames(a.Manifest()); len(got) != 0 {
+		t.Fatalf("archived = %v, want none", got)
+	}
+}
@@ -66,6 +66,11 @@ type Backend interface {
 	// OpenReadTxN returns the number of currently open read transactions in the backend.
 	OpenReadTxN() int64
 	Defrag() error
+	// DefragOnline defragments the backend like Defrag, but copies it in the
+	// background and only blocks transactions while swapping in the copy.
+	DefragOnline() error
+	// DefragProgress returns the progress of an ongoing online defragmentation.
+	DefragProgress() DefragProgress
 	ForceCommit()
 	Close() error
 
@@ -112,6 +117,12 @@ type backend struct {
 	batchTx       *batchTxBuffered
 
 	readTx *readTx
+
+	// defragMu serializes defragmentations.
+	defragMu sync.Mutex
+	// defragProgress is the progress of an ongoing online defragmentation.
+	defragProgress defragProgress
+
 	// txReadBufferCache mirrors "txReadBuffer" within "readTx" -- readTx.baseReadTx.buf.
 	// When creating "concurrentReadTx":
 	// - if the cache is up-to-date, "readTx.baseReadTx.buf" copy can be skipped
@@ -446,11 +457,14 @@ func (b *backend) Defrag() error {
 }
 
 func (b *backend) defrag() error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
 	now := time.Now()
 	isDefragActive.Set(1)
 	defer isDefragActive.Set(0)
 
-	// TODO: make this non-blocking?
+	// See DefragOnline for a defragmentation that does not block.
 	// lock batchTx to ensure nobody is using previous tx, and then
 	// close previous ongoing tx.
 	b.batchTx.LockOutsideApply()
@@ -468,24 +482,7 @@ func (b *backend) defrag() error {
 
 	b.batchTx.tx = nil
 
-	// Create a temporary file to ensure we start with a clean slate.
-	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
-	dir := filepath.Dir(b.db.Path())
-	temp, err := os.CreateTemp(dir, "db.tmp.*")
-	if err != nil {
-		return err
-	}
-	options := bolt.Options{}
-	if boltOpenOptions != nil {
-		options = *boltOpenOptions
-	}
-	options.OpenFile = func(_ string, _ int, _ os.FileMode) (file *os.File, err error) {
-		return temp, nil
-	}
-	// Don't load tmp db into memory regardless of opening options
-	options.Mlock = false
-	tdbp := temp.Name()
-	tmpdb, err := bolt.Open(tdbp, 0600, &options)
+	tmpdb, err := b.openDefragDB()
 	if err != nil {
 		return err
 	}
@@ -505,14 +502,67 @@ func (b *backend) defrag() error {
 	// gofail: var defragBeforeCopy struct{}
 	err = defragdb(b.db, tmpdb, defragLimit)
 	if err != nil {
-		tmpdb.Close()
-		if rmErr := os.RemoveAll(tmpdb.Path()); rmErr != nil {
-			b.lg.Error("failed to remove db.tmp after defragmentation completed", zap.Error(rmErr))
-		}
+		b.removeDefragDB(tmpdb)
 		return err
 	}
 
-	err = b.db.Close()
+	b.unsafeReplaceDB(tmpdb)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	if b.lg != nil {
+		b.lg.Info(
+			"finished defragmenting directory",
+			zap.String("path", dbp),
+			zap.Int64("current-db-size-bytes-diff", size2-size1),
+			zap.Int64("current-db-size-bytes", size2),
+			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+			zap.Duration("took", took),
+		)
+	}
+	return nil
+}
+
+// openDefragDB creates the temporary database that a defragmentation copies
+// the backend into.
+func (b *backend) openDefragDB() (*bolt.DB, error) {
+	// Create a temporary file to ensure we start with a clean slate.
+	// Snapshotter.cleanupSnapdir cleans up any of these that are found during startup.
+	dir := filepath.Dir(b.db.Path())
+	temp, err := os.CreateTemp(dir, "db.tmp.*")
+	if err != nil {
+		return nil, err
+	}
+	options := bolt.Options{}
+	if boltOpenOptions != nil {
+		options = *boltOpenOptions
+	}
+	options.OpenFile = func(_ string, _ int, _ os.FileMode) (file *os.File, err error) {
+		return temp, nil
+	}
+	// Don't load tmp db into memory regardless of opening options
+	options.Mlock = false
+	return bolt.Open(temp.Name(), 0600, &options)
+}
+
+func (b *backend) removeDefragDB(tmpdb *bolt.DB) {
+	tmpdb.Close()
+	if rmErr := os.RemoveAll(tmpdb.Path()); rmErr != nil {
+		b.lg.Error("failed to remove db.tmp after defragmentation completed", zap.Error(rmErr))
+	}
+}
+
+// unsafeReplaceDB replaces the backend database with the defragmented tmpdb.
+// It must be called holding the locks on the batchTx, the backend and the
+// readTx, after the batchTx is committed and stopped.
+func (b *backend) unsafeReplaceDB(tmpdb *bolt.DB) {
+	dbp, tdbp := b.db.Path(), tmpdb.Path()
+	err := b.db.Close()
 	if err != nil {
 		b.lg.Fatal("failed to close database", zap.Error(err))
 	}
@@ -539,28 +589,22 @@ func (b *backend) defrag() error {
 	db := b.readTx.tx.DB()
 	atomic.StoreInt64(&b.size, size)
 	atomic.StoreInt64(&b.sizeInUse, size-(int64(db.Stats().FreePageN)*int64(db.Info().PageSize)))
+}
 
-	took := time.Since(now)
-	defragSec.Observe(took.Seconds())
-
-	size2, sizeInUse2 := b.Size(), b.SizeInUse()
-	if b.lg != nil {
-		b.lg.Info(
-			"finished defragmenting directory",
-			zap.String("path", dbp),
-			zap.Int64("current-db-size-bytes-diff", size2-size1),
-			zap.Int64("current-db-size-bytes", size2),
-			zap.String("current-db-size", humanize.Bytes(uint64(size2))),
-			zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
-			zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
-			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
-			zap.Duration("took", took),
-		)
+func defragdb(odb, tmpdb *bolt.DB, limit int) error {
+	// open a tx on old db for read
+	tx, err := odb.Begin(false)
+	if err != nil {
+		return err
 	}
-	return nil
+	defer tx.Rollback()
+	return defragTx(tx, tmpdb, limit, nil)
 }
 
-func defragdb(odb, tmpdb *bolt.DB, limit int) error {
+// defragTx copies every bucket visible to tx into tmpdb, committing to tmpdb
+// every limit keys. If copied is non-nil, it is atomically incremented by the
+// number of keys copied.
+func defragTx(tx *bolt.Tx, tmpdb *bolt.DB, limit int, copied *int64) (err error) {
 	// open a tx on tmpdb for writes
 	tmptx, err := tmpdb.Begin(true)
 	if err != nil {
@@ -572,13 +616,6 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 		}
 	}()
 
-	// open a tx on old db for read
-	tx, err := odb.Begin(false)
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback()
-
 	c := tx.Cursor()
 
 	count := 0
@@ -610,6 +647,9 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 
 				count = 0
 			}
+			if copied != nil {
+				atomic.AddInt64(copied, 1)
+			}
 			return tmpb.Put(k, v)
 		}); err != nil {
 			return err
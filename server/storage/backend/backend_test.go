@@ -193,6 +193,82 @@ func TestBackendDefrag(t *testing.T) {
 	b.ForceCommit()
 }
 
+func TestBackendDefragOnline(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, b)
+
+	want := make(map[string]string)
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	for i := 0; i < 3*backend.DefragLimitForTest(); i++ {
+		k := fmt.Sprintf("foo_%d", i)
+		tx.UnsafePut(schema.Test, []byte(k), []byte("bar"))
+		want[k] = "bar"
+	}
+	tx.Unlock()
+	b.ForceCommit()
+
+	tx.Lock()
+	for i := 0; i < backend.DefragLimitForTest(); i++ {
+		k := fmt.Sprintf("foo_%d", i)
+		tx.UnsafeDelete(schema.Test, []byte(k))
+		delete(want, k)
+	}
+	tx.Unlock()
+	b.ForceCommit()
+	size := b.Size()
+
+	// write while defragmenting
+	donec, writec := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(writec)
+		for i := 0; ; i++ {
+			select {
+			case <-donec:
+				return
+			default:
+			}
+			k := fmt.Sprintf("foo_%d", i%(3*backend.DefragLimitForTest()))
+			tx.Lock()
+			if i%3 == 0 {
+				tx.UnsafeDelete(schema.Test, []byte(k))
+				delete(want, k)
+			} else {
+				v := fmt.Sprintf("baz_%d", i)
+				tx.UnsafePut(schema.Test, []byte(k), []byte(v))
+				want[k] = v
+			}
+			tx.Unlock()
+		}
+	}()
+	err := b.DefragOnline()
+	close(donec)
+	<-writec
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := b.DefragProgress(); p != (backend.DefragProgress{}) {
+		t.Errorf("progress = %+v, want none", p)
+	}
+	if nsize := b.Size(); nsize >= size {
+		t.Errorf("new size = %v, want < %d", nsize, size)
+	}
+
+	b.ForceCommit()
+	got := make(map[string]string)
+	rtx := b.ReadTx()
+	rtx.RLock()
+	rtx.UnsafeForEach(schema.Test, func(k, v []byte) error {
+		got[string(k)] = string(v)
+		return nil
+	})
+	rtx.RUnlock()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("len(kvs) = %d, want %d", len(got), len(want))
+	}
+}
+
 // TestBackendWriteback ensures writes are stored to the read txn on write txn unlock.
 func TestBackendWriteback(t *testing.T) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
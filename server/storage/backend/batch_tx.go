@@ -64,6 +64,9 @@ type batchTx struct {
 	backend *backend
 
 	pending int
+
+	// defragLog records the writes made during an online defragmentation.
+	defragLog *defragLog
 }
 
 // Lock is supposed to be called only by the unit test.
@@ -123,6 +126,9 @@ func (t *batchTx) UnsafeCreateBucket(bucket Bucket) {
 		)
 	}
 	t.pending++
+	if t.defragLog != nil {
+		t.defragLog.record(defragOpCreateBucket, bucket.Name(), nil, nil)
+	}
 }
 
 func (t *batchTx) UnsafeDeleteBucket(bucket Bucket) {
@@ -135,6 +141,9 @@ func (t *batchTx) UnsafeDeleteBucket(bucket Bucket) {
 		)
 	}
 	t.pending++
+	if t.defragLog != nil {
+		t.defragLog.record(defragOpDeleteBucket, bucket.Name(), nil, nil)
+	}
 }
 
 // UnsafePut must be called holding the lock on the tx.
@@ -169,6 +178,9 @@ func (t *batchTx) unsafePut(bucketType Bucket, key []byte, value []byte, seq boo
 		)
 	}
 	t.pending++
+	if t.defragLog != nil {
+		t.defragLog.record(defragOpPut, bucketType.Name(), key, value)
+	}
 }
 
 // UnsafeRange must be called holding the lock on the tx.
@@ -225,6 +237,9 @@ func (t *batchTx) UnsafeDelete(bucketType Bucket, key []byte) {
 		)
 	}
 	t.pending++
+	if t.defragLog != nil {
+		t.defragLog.record(defragOpDelete, bucketType.Name(), key, nil)
+	}
 }
 
 // UnsafeForEach must be called holding the lock on the tx.
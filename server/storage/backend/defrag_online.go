@@ -0,0 +1,325 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// defragCatchUpRounds is the maximum number of times an online
+	// defragmentation replays the writes made meanwhile before swapping in
+	// the copy.
+	defragCatchUpRounds = 10
+	// defragCatchUpLimit is the number of writes left to replay below which
+	// an online defragmentation stops catching up and swaps in the copy.
+	defragCatchUpLimit = 1000
+
+	errDefragClosed = errors.New("backend: closed during defragmentation")
+)
+
+// DefragPhase is a phase of an online defragmentation.
+type DefragPhase string
+
+const (
+	// DefragPhaseNone means that no online defragmentation is running.
+	DefragPhaseNone DefragPhase = ""
+	// DefragPhaseCopying copies a snapshot of the backend into a new file.
+	DefragPhaseCopying DefragPhase = "copying"
+	// DefragPhaseCatchingUp replays on the new file the writes made since
+	// the snapshot.
+	DefragPhaseCatchingUp DefragPhase = "catching-up"
+	// DefragPhaseSwapping replays the last writes and swaps in the new file
+	// while blocking transactions.
+	DefragPhaseSwapping DefragPhase = "swapping"
+)
+
+// DefragProgress is the progress of an online defragmentation.
+type DefragProgress struct {
+	Phase DefragPhase
+	// CopiedKeys is the number of keys of the snapshot copied so far.
+	CopiedKeys int64
+	// TotalKeys is the number of keys in the snapshot.
+	TotalKeys int64
+	// PendingWrites is the number of writes made since the snapshot that are
+	// yet to be replayed on the new file.
+	PendingWrites int64
+}
+
+type defragProgress struct {
+	mu        sync.Mutex
+	phase     DefragPhase
+	totalKeys int64
+
+	// copiedKeys and pendingWrites are used with atomic operations.
+	copiedKeys    int64
+	pendingWrites int64
+}
+
+func (p *defragProgress) setPhase(phase DefragPhase, totalKeys int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.phase = phase
+	if phase == DefragPhaseCopying || phase == DefragPhaseNone {
+		p.totalKeys = totalKeys
+		atomic.StoreInt64(&p.copiedKeys, 0)
+		atomic.StoreInt64(&p.pendingWrites, 0)
+	}
+}
+
+type defragOpType int
+
+const (
+	defragOpPut defragOpType = iota
+	defragOpDelete
+	defragOpCreateBucket
+	defragOpDeleteBucket
+)
+
+type defragOp struct {
+	typ    defragOpType
+	bucket []byte
+	key    []byte
+	value  []byte
+}
+
+// defragLog records the writes made to the backend while an online
+// defragmentation copies it. It is guarded by the batchTx lock.
+type defragLog struct {
+	ops []defragOp
+	// pending counts the recorded writes that are yet to be replayed.
+	pending *int64
+}
+
+func (l *defragLog) record(typ defragOpType, bucket, key, value []byte) {
+	// keys and values may point into the boltdb mmap, which is only valid
+	// until the current transaction commits.
+	op := defragOp{typ: typ, bucket: bucket}
+	if key != nil {
+		op.key = make([]byte, len(key))
+		copy(op.key, key)
+	}
+	if value != nil {
+		op.value = make([]byte, len(value))
+		copy(op.value, value)
+	}
+	l.ops = append(l.ops, op)
+	atomic.AddInt64(l.pending, 1)
+}
+
+// take returns the recorded writes and clears the log.
+func (l *defragLog) take() []defragOp {
+	ops := l.ops
+	l.ops = nil
+	return ops
+}
+
+// replay applies ops to tmpdb, committing every limit writes.
+func (l *defragLog) replay(tmpdb *bolt.DB, ops []defragOp, limit int) error {
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > limit {
+			n = limit
+		}
+		if err := tmpdb.Update(func(tx *bolt.Tx) error {
+			for _, op := range ops[:n] {
+				if err := applyDefragOp(tx, op); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		atomic.AddInt64(l.pending, -int64(n))
+		ops = ops[n:]
+	}
+	return nil
+}
+
+func applyDefragOp(tx *bolt.Tx, op defragOp) error {
+	switch op.typ {
+	case defragOpCreateBucket:
+		_, err := tx.CreateBucketIfNotExists(op.bucket)
+		return err
+	case defragOpDeleteBucket:
+		if err := tx.DeleteBucket(op.bucket); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		return nil
+	}
+	b := tx.Bucket(op.bucket)
+	if b == nil {
+		return fmt.Errorf("backend: cannot find bucket %s", string(op.bucket))
+	}
+	if op.typ == defragOpDelete {
+		return b.Delete(op.key)
+	}
+	return b.Put(op.key, op.value)
+}
+
+func (b *backend) DefragProgress() DefragProgress {
+	p := &b.defragProgress
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return DefragProgress{
+		Phase:         p.phase,
+		CopiedKeys:    atomic.LoadInt64(&p.copiedKeys),
+		TotalKeys:     p.totalKeys,
+		PendingWrites: atomic.LoadInt64(&p.pendingWrites),
+	}
+}
+
+func (b *backend) isClosed() bool {
+	select {
+	case <-b.stopc:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefragOnline copies a snapshot of the backend into a new file while
+// recording the writes made meanwhile, replays the recorded writes on the
+// copy, and only blocks transactions to replay the last writes and swap in
+// the copy. The backend file grows during the copy as the snapshot keeps the
+// pages it reads from being reused.
+func (b *backend) DefragOnline() (err error) {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
+	now := time.Now()
+	isDefragActive.Set(1)
+	defer isDefragActive.Set(0)
+	defer b.defragProgress.setPhase(DefragPhaseNone, 0)
+
+	b.mu.RLock()
+	dbp := b.db.Path()
+	tmpdb, err := b.openDefragDB()
+	b.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	// take the snapshot to copy and start recording the writes made after it
+	// while no write is in progress.
+	b.batchTx.LockOutsideApply()
+	if b.isClosed() {
+		b.batchTx.Unlock()
+		b.removeDefragDB(tmpdb)
+		return errDefragClosed
+	}
+	b.batchTx.commit(false)
+	tx := b.begin(false)
+	log := &defragLog{pending: &b.defragProgress.pendingWrites}
+	b.batchTx.defragLog = log
+	b.batchTx.Unlock()
+
+	defer func() {
+		if err != nil {
+			b.batchTx.LockOutsideApply()
+			b.batchTx.defragLog = nil
+			b.batchTx.Unlock()
+			b.removeDefragDB(tmpdb)
+		}
+	}()
+
+	var totalKeys int64
+	tx.ForEach(func(_ []byte, bucket *bolt.Bucket) error {
+		totalKeys += int64(bucket.Stats().KeyN)
+		return nil
+	})
+	size1, sizeInUse1 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"defragmenting online",
+		zap.String("path", dbp),
+		zap.Int64("keys", totalKeys),
+		zap.Int64("current-db-size-bytes", size1),
+		zap.String("current-db-size", humanize.Bytes(uint64(size1))),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse1),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse1))),
+	)
+
+	b.defragProgress.setPhase(DefragPhaseCopying, totalKeys)
+	err = defragTx(tx, tmpdb, defragLimit, &b.defragProgress.copiedKeys)
+	if rerr := tx.Rollback(); err == nil {
+		err = rerr
+	}
+	if err != nil {
+		return err
+	}
+
+	b.defragProgress.setPhase(DefragPhaseCatchingUp, 0)
+	for i := 0; i < defragCatchUpRounds; i++ {
+		b.batchTx.LockOutsideApply()
+		ops := log.take()
+		b.batchTx.Unlock()
+		if err = log.replay(tmpdb, ops, defragLimit); err != nil {
+			return err
+		}
+		if len(ops) <= defragCatchUpLimit {
+			break
+		}
+	}
+
+	b.defragProgress.setPhase(DefragPhaseSwapping, 0)
+	b.batchTx.LockOutsideApply()
+	defer b.batchTx.Unlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.readTx.Lock()
+	defer b.readTx.Unlock()
+	if b.isClosed() {
+		return errDefragClosed
+	}
+
+	// the pre-commit hooks write through the batchTx, so their writes are
+	// recorded as well.
+	b.batchTx.unsafeCommit(true)
+	b.batchTx.tx = nil
+	b.batchTx.defragLog = nil
+	if err = log.replay(tmpdb, log.take(), defragLimit); err != nil {
+		b.batchTx.tx = b.unsafeBegin(true)
+		b.readTx.tx = b.unsafeBegin(false)
+		return err
+	}
+	b.unsafeReplaceDB(tmpdb)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+
+	size2, sizeInUse2 := b.Size(), b.SizeInUse()
+	b.lg.Info(
+		"finished defragmenting online",
+		zap.String("path", dbp),
+		zap.Int64("current-db-size-bytes-diff", size2-size1),
+		zap.Int64("current-db-size-bytes", size2),
+		zap.String("current-db-size", humanize.Bytes(uint64(size2))),
+		zap.Int64("current-db-size-in-use-bytes-diff", sizeInUse2-sizeInUse1),
+		zap.Int64("current-db-size-in-use-bytes", sizeInUse2),
+		zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse2))),
+		zap.Duration("took", took),
+	)
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+const (
+	// compressedValueMarker starts every compressed value. A marshaled
+	// mvccpb.KeyValue never starts with it, since protobuf field tags are
+	// never zero.
+	compressedValueMarker = 0x00
+	// compressedValueHeaderSize is the size of the marker and the
+	// compressor ID preceding the compressed value.
+	compressedValueHeaderSize = 2
+	// minCompressValueSize is the size below which values are stored raw,
+	// as they rarely get smaller.
+	minCompressValueSize = 256
+)
+
+// ValueCompressor compresses the values written to the key bucket.
+// Compressed values are prefixed with the compressor ID, so a store reads
+// them back regardless of the compressor it is configured with, as long as
+// the compressor is registered.
+type ValueCompressor interface {
+	// ID identifies the compressor in stored values. It must be non-zero and
+	// must never change once values were written with it.
+	ID() byte
+	// Name is the name used to select the compressor in configuration.
+	Name() string
+	Compress(src []byte) ([]byte, error)
+	Decompress(src []byte) ([]byte, error)
+}
+
+var (
+	valueCompressorsMu     sync.RWMutex
+	valueCompressorsByID   = map[byte]ValueCompressor{}
+	valueCompressorsByName = map[string]ValueCompressor{}
+)
+
+func init() {
+	RegisterValueCompressor(flateCompressor{})
+	RegisterValueCompressor(snappyCompressor{})
+	RegisterValueCompressor(zstdCompressor{})
+}
+
+// RegisterValueCompressor makes the compressor available for writing by
+// name and for reading by ID. It panics if the ID or name is taken.
+func RegisterValueCompressor(c ValueCompressor) {
+	valueCompressorsMu.Lock()
+	defer valueCompressorsMu.Unlock()
+	if c.ID() == 0 {
+		panic("mvcc: value compressor ID must be non-zero")
+	}
+	if _, ok := valueCompressorsByID[c.ID()]; ok {
+		panic(fmt.Sprintf("mvcc: value compressor ID %d registered twice", c.ID()))
+	}
+	if _, ok := valueCompressorsByName[c.Name()]; ok {
+		panic(fmt.Sprintf("mvcc: value compressor %q registered twice", c.Name()))
+	}
+	valueCompressorsByID[c.ID()] = c
+	valueCompressorsByName[c.Name()] = c
+}
+
+// ValueCompressorByName returns the registered compressor with the given name.
+func ValueCompressorByName(name string) (ValueCompressor, error) {
+	valueCompressorsMu.RLock()
+	defer valueCompressorsMu.RUnlock()
+	c, ok := valueCompressorsByName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown value compressor %q", name)
+	}
+	return c, nil
+}
+
+// encodeValue compresses the marshaled key-value with c, unless c is nil or
+// compressing does not make it smaller.
+func encodeValue(c ValueCompressor, d []byte) ([]byte, error) {
+	if c == nil || len(d) < minCompressValueSize {
+		return d, nil
+	}
+	cd, err := c.Compress(d)
+	if err != nil {
+		return nil, err
+	}
+	if len(cd)+compressedValueHeaderSize >= len(d) {
+		return d, nil
+	}
+	v := make([]byte, compressedValueHeaderSize+len(cd))
+	v[0] = compressedValueMarker
+	v[1] = c.ID()
+	copy(v[compressedValueHeaderSize:], cd)
+	return v, nil
+}
+
+// DecodeValue returns the marshaled key-value stored as v in the key bucket,
+// decompressing it if needed.
+func DecodeValue(v []byte) ([]byte, error) {
+	if len(v) == 0 || v[0] != compressedValueMarker {
+		return v, nil
+	}
+	if len(v) < compressedValueHeaderSize {
+		return nil, fmt.Errorf("compressed value too short")
+	}
+	valueCompressorsMu.RLock()
+	c, ok := valueCompressorsByID[v[1]]
+	valueCompressorsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown value compressor ID %d", v[1])
+	}
+	return c.Decompress(v[compressedValueHeaderSize:])
+}
+
+// flateCompressor is the built-in compressor, using DEFLATE at the fastest
+// level.
+type flateCompressor struct{}
+
+func (flateCompressor) ID() byte     { return 1 }
+func (flateCompressor) Name() string { return "flate" }
+
+func (flateCompressor) Compress(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(src); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (flateCompressor) Decompress(src []byte) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(src))
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// snappyCompressor compresses with Snappy, trading ratio for speed.
+type snappyCompressor struct{}
+
+func (snappyCompressor) ID() byte     { return 2 }
+func (snappyCompressor) Name() string { return "snappy" }
+
+func (snappyCompressor) Compress(src []byte) ([]byte, error) {
+	return s2.EncodeSnappy(nil, src), nil
+}
+
+func (snappyCompressor) Decompress(src []byte) ([]byte, error) {
+	return s2.Decode(nil, src)
+}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// zstdCodec returns the shared zstd encoder and decoder, creating them on
+// first use. Both are safe for concurrent use.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdOnce.Do(func() {
+		if zstdEncoder, zstdErr = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1)); zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdEncoder, zstdDecoder, zstdErr
+}
+
+// zstdCompressor compresses with Zstandard at the default level.
+type zstdCompressor struct{}
+
+func (zstdCompressor) ID() byte     { return 3 }
+func (zstdCompressor) Name() string { return "zstd" }
+
+func (zstdCompressor) Compress(src []byte) ([]byte, error) {
+	enc, _, err := zstdCodec()
+	if err != nil {
+		return nil, err
+	}
+	return enc.EncodeAll(src, nil), nil
+}
+
+func (zstdCompressor) Decompress(src []byte) ([]byte, error) {
+	_, dec, err := zstdCodec()
+	if err != nil {
+		return nil, err
+	}
+	return dec.DecodeAll(src, nil)
+}
+
+// encodeValue encodes the marshaled key-value d for the key bucket with the
+// configured compressor, if the cluster version supports compressed values.
+// It records in the backend that compressed values may exist before writing
+// the first one.
+func (s *store) encodeValue(tx backend.BatchTx, d []byte) []byte {
+	if !s.valueCompressionSupported() {
+		return d
+	}
+	v, err := encodeValue(s.cfg.ValueCompressor, d)
+	if err != nil {
+		s.lg.Warn("failed to compress value, storing it raw", zap.Error(err))
+		return d
+	}
+	if len(v) != len(d) && !s.valueCompressed {
+		schema.UnsafeSetValueCompressed(tx)
+		s.valueCompressed = true
+	}
+	return v
+}
+
+// valueCompressionSupported returns true if a compressor is configured and
+// all members of the cluster can read the compressed values, including in
+// the snapshots sent to them.
+func (s *store) valueCompressionSupported() bool {
+	if s.cfg.ValueCompressor == nil || s.cfg.Cluster == nil {
+		return false
+	}
+	cv := s.cfg.Cluster.Version()
+	return cv != nil && !version.LessThan(*cv, version.V3_6)
+}
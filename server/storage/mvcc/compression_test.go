@@ -0,0 +1,181 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestEncodeValue(t *testing.T) {
+	large := bytes.Repeat([]byte("compressible"), 100)
+	random := make([]byte, minCompressValueSize)
+	rand.New(rand.NewSource(1)).Read(random)
+	for _, name := range []string{"flate", "snappy", "zstd"} {
+		c, err := ValueCompressorByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tests := []struct {
+			name       string
+			c          ValueCompressor
+			d          []byte
+			compressed bool
+		}{
+			{"no compressor", nil, large, false},
+			{"small", c, []byte("small"), false},
+			{"large", c, large, true},
+			{"incompressible", c, random, false},
+		}
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				v, err := encodeValue(tt.c, tt.d)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tt.compressed, v[0] == compressedValueMarker)
+				if tt.compressed {
+					assert.Equal(t, c.ID(), v[1])
+					assert.Less(t, len(v), len(tt.d))
+				}
+				d, err := DecodeValue(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tt.d, d)
+			})
+		}
+	}
+
+	if _, err := DecodeValue([]byte{compressedValueMarker, 0xff}); err == nil {
+		t.Error("expected error decoding value with unknown compressor")
+	}
+}
+
+type fakeCluster struct {
+	version *semver.Version
+}
+
+func (c fakeCluster) Version() *semver.Version { return c.version }
+
+func TestStoreValueCompression(t *testing.T) {
+	c, err := ValueCompressorByName("zstd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := bytes.Repeat([]byte("compressible"), 100)
+
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{ValueCompressor: c, Cluster: fakeCluster{&version.V3_6}})
+	s.Put([]byte("foo"), value, lease.NoLease)
+	s.Put([]byte("bar"), []byte("small"), lease.NoLease)
+	assert.True(t, storedValueCompressed(t, b, 2))
+	assert.False(t, storedValueCompressed(t, b, 3))
+
+	rb, _ := betesting.NewDefaultTmpBackend(t)
+	rs := NewStore(zaptest.NewLogger(t), rb, &lease.FakeLessor{}, StoreConfig{})
+	rs.Put([]byte("foo"), value, lease.NoLease)
+	rs.Put([]byte("bar"), []byte("small"), lease.NoLease)
+	rh, _, err := rs.hashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _, err := s.hashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, rh, h, "hash must not depend on compression")
+	cleanup(rs, rb, "")
+
+	s.Commit()
+	tx := b.ReadTx()
+	tx.RLock()
+	assert.True(t, schema.UnsafeReadValueCompressed(tx))
+	tx.RUnlock()
+
+	// a store without compressor still reads the compressed values
+	s.Close()
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, "")
+	r, err := s.Range(context.TODO(), []byte("foo"), nil, RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.KVs) != 1 || !bytes.Equal(r.KVs[0].Value, value) {
+		t.Errorf("range = %+v, want value %q", r.KVs, value)
+	}
+}
+
+// TestStoreValueCompressionClusterVersion ensures values are stored raw
+// until all members can read compressed values.
+func TestStoreValueCompressionClusterVersion(t *testing.T) {
+	c, err := ValueCompressorByName("snappy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := bytes.Repeat([]byte("compressible"), 100)
+
+	cl := &fakeCluster{}
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{ValueCompressor: c, Cluster: cl})
+	defer cleanup(s, b, "")
+
+	s.Put([]byte("foo"), value, lease.NoLease)
+	cl.version = &version.V3_5
+	s.Put([]byte("foo"), value, lease.NoLease)
+	s.Commit()
+	assert.False(t, storedValueCompressed(t, b, 2))
+	assert.False(t, storedValueCompressed(t, b, 3))
+	tx := b.ReadTx()
+	tx.RLock()
+	assert.False(t, schema.UnsafeReadValueCompressed(tx))
+	tx.RUnlock()
+
+	cl.version = &version.V3_6
+	s.Put([]byte("foo"), value, lease.NoLease)
+	s.Commit()
+	assert.True(t, storedValueCompressed(t, b, 4))
+	tx.RLock()
+	assert.True(t, schema.UnsafeReadValueCompressed(tx))
+	tx.RUnlock()
+}
+
+// storedValueCompressed returns true if the value stored in the key bucket
+// at the given main revision is compressed.
+func storedValueCompressed(t *testing.T, b backend.Backend, main int64) bool {
+	b.ForceCommit()
+	tx := b.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	ibytes := newRevBytes()
+	revToBytes(revision{main: main}, ibytes)
+	_, vs := tx.UnsafeRange(schema.Key, ibytes, nil, 0)
+	if len(vs) != 1 {
+		t.Fatalf("expected one value at revision %d, got %d", main, len(vs))
+	}
+	return vs[0][0] == compressedValueMarker
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"sync"
+
+	"github.com/google/btree"
+)
+
+// ExpiredKey is a key whose expire time has passed.
+type ExpiredKey struct {
+	Key []byte
+	// ModRevision is the revision of the modification that set the expire time.
+	ModRevision int64
+}
+
+type expiryItem struct {
+	key        string
+	expireTime int64
+	modRev     int64
+}
+
+// expiryIndex tracks the keys with an expire time, ordered by expire time.
+type expiryIndex struct {
+	mu    sync.Mutex
+	tree  *btree.BTreeG[*expiryItem]
+	items map[string]*expiryItem
+}
+
+func newExpiryIndex() *expiryIndex {
+	return &expiryIndex{
+		tree: btree.NewG(32, func(a, b *expiryItem) bool {
+			if a.expireTime != b.expireTime {
+				return a.expireTime < b.expireTime
+			}
+			return a.key < b.key
+		}),
+		items: make(map[string]*expiryItem),
+	}
+}
+
+// Set records the expire time of the key as of modRev. A zero
+// expireTime removes the key from the index.
+func (ei *expiryIndex) Set(key string, expireTime, modRev int64) {
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	ei.unsafeRemove(key)
+	if expireTime == 0 {
+		return
+	}
+	item := &expiryItem{key: key, expireTime: expireTime, modRev: modRev}
+	ei.items[key] = item
+	ei.tree.ReplaceOrInsert(item)
+}
+
+func (ei *expiryIndex) Remove(key string) {
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	ei.unsafeRemove(key)
+}
+
+func (ei *expiryIndex) unsafeRemove(key string) {
+	if item, ok := ei.items[key]; ok {
+		ei.tree.Delete(item)
+		delete(ei.items, key)
+	}
+}
+
+// Expired returns up to limit keys whose expire time is at or before now,
+// earliest first.
+func (ei *expiryIndex) Expired(now int64, limit int) []ExpiredKey {
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	var keys []ExpiredKey
+	ei.tree.Ascend(func(item *expiryItem) bool {
+		if item.expireTime > now || len(keys) >= limit {
+			return false
+		}
+		keys = append(keys, ExpiredKey{Key: []byte(item.key), ModRevision: item.modRev})
+		return true
+	})
+	return keys
+}
+
+func (ei *expiryIndex) Len() int {
+	ei.mu.Lock()
+	defer ei.mu.Unlock()
+	return len(ei.items)
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestExpiryIndex(t *testing.T) {
+	ei := newExpiryIndex()
+	ei.Set("c", 30, 3)
+	ei.Set("a", 10, 1)
+	ei.Set("b", 10, 2)
+	ei.Set("d", 40, 4)
+
+	wexpired := []ExpiredKey{{Key: []byte("a"), ModRevision: 1}, {Key: []byte("b"), ModRevision: 2}, {Key: []byte("c"), ModRevision: 3}}
+	if got := ei.Expired(30, 10); !reflect.DeepEqual(got, wexpired) {
+		t.Errorf("expired = %v, want %v", got, wexpired)
+	}
+	if got := ei.Expired(30, 2); !reflect.DeepEqual(got, wexpired[:2]) {
+		t.Errorf("expired with limit = %v, want %v", got, wexpired[:2])
+	}
+	if got := ei.Expired(5, 10); len(got) != 0 {
+		t.Errorf("expired before any expire time = %v, want none", got)
+	}
+
+	// moving the expire time of a key replaces its previous entry
+	ei.Set("a", 50, 5)
+	ei.Remove("b")
+	ei.Set("c", 0, 6)
+	wexpired = []ExpiredKey{{Key: []byte("d"), ModRevision: 4}}
+	if got := ei.Expired(40, 10); !reflect.DeepEqual(got, wexpired) {
+		t.Errorf("expired = %v, want %v", got, wexpired)
+	}
+	if ei.Len() != 2 {
+		t.Errorf("len = %d, want 2", ei.Len())
+	}
+}
+
+func TestStoreExpiredRestore(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+
+	put := func(key string, expireTime int64) int64 {
+		txn := s.Write(traceutil.TODO())
+		defer txn.End()
+		return txn.PutWithExpiry([]byte(key), []byte("bar"), lease.NoLease, expireTime)
+	}
+	put("foo", 10)
+	put("bar", 20)
+	rev := put("baz", 15)
+	put("qux", 5)
+	// a plain put clears the expire time
+	s.Put([]byte("qux"), []byte("bar"), lease.NoLease)
+	put("quux", 10)
+	s.DeleteRange([]byte("quux"), nil)
+
+	wexpired := []ExpiredKey{{Key: []byte("foo"), ModRevision: 2}, {Key: []byte("baz"), ModRevision: rev}}
+	if got := s.Expired(15, 10); !reflect.DeepEqual(got, wexpired) {
+		t.Fatalf("expired = %v, want %v", got, wexpired)
+	}
+	s.Close()
+
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer s.Close()
+	if got := s.Expired(15, 10); !reflect.DeepEqual(got, wexpired) {
+		t.Errorf("expired after restore = %v, want %v", got, wexpired)
+	}
+	r, err := s.Range(context.TODO(), []byte("bar"), nil, RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.KVs) != 1 || r.KVs[0].ExpireTime != 20 {
+		t.Errorf("range = %v, want expire time 20", r.KVs)
+	}
+}
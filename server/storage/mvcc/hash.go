@@ -71,6 +71,11 @@ func (h *kvHasher) WriteKeyValue(k, v []byte) {
 			return
 		}
 	}
+	// hash the decoded value, so members agree regardless of their value
+	// compression; a value that fails to decode is hashed as stored.
+	if d, err := DecodeValue(v); err == nil {
+		v = d
+	}
 	h.hash.Write(k)
 	h.hash.Write(v)
 }
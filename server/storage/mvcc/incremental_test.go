@@ -0,0 +1,109 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestStoreSaveIncremental(t *testing.T) {
+	oldChunk := restoreChunkKeys
+	restoreChunkKeys = 2
+	defer func() { restoreChunkKeys = oldChunk }()
+
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, tmpPath)
+
+	btx := b.BatchTx()
+	btx.LockOutsideApply()
+	schema.UnsafeUpdateConsistentIndex(btx, 10, 1)
+	btx.Unlock()
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	baseRev := s.Put([]byte("baz"), []byte("bar"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("bar2"), lease.NoLease)
+	s.Put([]byte("qux"), []byte("bar"), lease.NoLease)
+	_, lastRev := s.DeleteRange([]byte("baz"), nil)
+
+	path := filepath.Join(t.TempDir(), "incremental.db")
+	rev, err := s.SaveIncremental(baseRev, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev != lastRev {
+		t.Errorf("revision = %d, want %d", rev, lastRev)
+	}
+
+	ib := backend.NewDefaultBackend(zaptest.NewLogger(t), path)
+	defer ib.Close()
+	tx := ib.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	var revs []revision
+	tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		revs = append(revs, bytesToRev(k))
+		return nil
+	})
+	wrevs := []revision{{main: baseRev + 1}, {main: baseRev + 2}, {main: baseRev + 3}, {main: baseRev + 4}}
+	if len(revs) != len(wrevs) {
+		t.Fatalf("revisions = %v, want %v", revs, wrevs)
+	}
+	for i := range wrevs {
+		if revs[i] != wrevs[i] {
+			t.Errorf("#%d: revision = %v, want %v", i, revs[i], wrevs[i])
+		}
+	}
+	_, vs := tx.UnsafeRange(schema.Meta, schema.MetaIncrementalBaseRevName, nil, 0)
+	if len(vs) != 1 {
+		t.Errorf("incremental base revision is not recorded")
+	}
+	_, vs = tx.UnsafeRange(schema.Meta, schema.MetaConsistentIndexKeyName, nil, 0)
+	if len(vs) != 1 {
+		t.Errorf("meta bucket is not copied")
+	}
+}
+
+func TestStoreSaveIncrementalInvalidRevision(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, tmpPath)
+
+	for i := 0; i < 3; i++ {
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	}
+	if _, err := s.SaveIncremental(10, filepath.Join(t.TempDir(), "future.db")); err != ErrFutureRev {
+		t.Errorf("error = %v, want %v", err, ErrFutureRev)
+	}
+	if _, err := s.Compact(traceutil.TODO(), 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SaveIncremental(2, filepath.Join(t.TempDir(), "compacted.db")); err != ErrCompacted {
+		t.Errorf("error = %v, want %v", err, ErrCompacted)
+	}
+	if _, err := s.SaveIncremental(3, filepath.Join(t.TempDir(), "valid.db")); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
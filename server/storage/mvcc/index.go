@@ -15,6 +15,7 @@
 package mvcc
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/google/btree"
@@ -26,10 +27,15 @@ type index interface {
 	Range(key, end []byte, atRev int64) ([][]byte, []revision)
 	Revisions(key, end []byte, atRev int64, limit int) ([]revision, int)
 	CountRevisions(key, end []byte, atRev int64) int
+	History(key, end []byte, startRev, endRev, limit int64) []revision
 	Put(key []byte, rev revision)
+	// SetLease records a revision of an existing key that only changed
+	// its lease. Unlike Put, it does not count towards the key's version.
+	SetLease(key []byte, rev revision)
 	Tombstone(key []byte, rev revision) error
-	Compact(rev int64) map[revision]struct{}
-	Keep(rev int64) map[revision]struct{}
+	Compact(rev int64, retention []Retention) map[revision]struct{}
+	Keep(rev int64, retention []Retention) map[revision]struct{}
+	CountCompacted(rev int64, retention []Retention) int
 	Equal(b index) bool
 
 	Insert(ki *keyIndex)
@@ -65,6 +71,16 @@ func (ti *treeIndex) Put(key []byte, rev revision) {
 	okeyi.put(ti.lg, rev.main, rev.sub)
 }
 
+func (ti *treeIndex) SetLease(key []byte, rev revision) {
+	ti.Lock()
+	defer ti.Unlock()
+	keyi, ok := ti.tree.Get(&keyIndex{key: key})
+	if !ok {
+		ti.lg.Panic("'SetLease' got an unexpected missing key", zap.String("key", string(key)))
+	}
+	keyi.setLease(ti.lg, rev.main, rev.sub)
+}
+
 func (ti *treeIndex) Get(key []byte, atRev int64) (modified, created revision, ver int64, err error) {
 	ti.RLock()
 	defer ti.RUnlock()
@@ -155,6 +171,57 @@ func (ti *treeIndex) CountRevisions(key, end []byte, atRev int64) int {
 	return total
 }
 
+// History returns the revisions, tombstones included, of the keys from
+// key(included) to end(excluded) with a main revision in [startRev, endRev].
+// The returned slice is sorted in the order of revision.
+// If limit > 0, the slice is cut after the first limit revisions, the rest
+// of the main revision they end in and, if they all share one main revision,
+// the first revision after it; this is enough for the caller to split a
+// page without listing the whole history of the range.
+func (ti *treeIndex) History(key, end []byte, startRev, endRev, limit int64) (revs []revision) {
+	ti.RLock()
+	defer ti.RUnlock()
+
+	if end == nil {
+		keyi := ti.keyIndex(&keyIndex{key: key})
+		if keyi == nil {
+			return nil
+		}
+		return truncateHistory(keyi.history(startRev, endRev, limit), limit)
+	}
+	threshold := 2 * (limit + 1)
+	ti.unsafeVisit(key, end, func(ki *keyIndex) bool {
+		revs = append(revs, ki.history(startRev, endRev, limit)...)
+		if limit > 0 && int64(len(revs)) > threshold {
+			// merge what was collected so far to keep memory bounded
+			revs = truncateHistory(sortRevisions(revs), limit)
+			threshold = 2 * (int64(len(revs)) + 1)
+		}
+		return true
+	})
+	return truncateHistory(sortRevisions(revs), limit)
+}
+
+func sortRevisions(revs []revision) []revision {
+	sort.Slice(revs, func(i, j int) bool { return revs[j].GreaterThan(revs[i]) })
+	return revs
+}
+
+// truncateHistory cuts the sorted revs as described in History.
+func truncateHistory(revs []revision, limit int64) []revision {
+	n := int(limit) + 1
+	if limit <= 0 || len(revs) <= n {
+		return revs
+	}
+	for n < len(revs) && revs[n].main == revs[n-1].main {
+		n++
+	}
+	if n < len(revs) && revs[0].main == revs[n-1].main {
+		n++
+	}
+	return revs[:n]
+}
+
 func (ti *treeIndex) Range(key, end []byte, atRev int64) (keys [][]byte, revs []revision) {
 	ti.RLock()
 	defer ti.RUnlock()
@@ -189,9 +256,11 @@ func (ti *treeIndex) Tombstone(key []byte, rev revision) error {
 	return ki.tombstone(ti.lg, rev.main, rev.sub)
 }
 
-func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
+// Compact compacts the index to the given rev, except for the keys under a
+// retained prefix which are compacted to the retained revision.
+func (ti *treeIndex) Compact(rev int64, retention []Retention) map[revision]struct{} {
 	available := make(map[revision]struct{})
-	ti.lg.Info("compact tree index", zap.Int64("revision", rev))
+	ti.lg.Info("compact tree index", zap.Int64("revision", rev), zap.Int("retained-prefixes", len(retention)))
 	ti.Lock()
 	clone := ti.tree.Clone()
 	ti.Unlock()
@@ -200,7 +269,9 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 		// Lock is needed here to prevent modification to the keyIndex while
 		// compaction is going on or revision added to empty before deletion
 		ti.Lock()
-		keyi.compact(ti.lg, rev, available)
+		atRev := retentionRev(retention, keyi.key, rev)
+		keyi.compact(ti.lg, atRev, available)
+		keyi.retain(atRev, rev, available)
 		if keyi.isEmpty() {
 			_, ok := ti.tree.Delete(keyi)
 			if !ok {
@@ -214,17 +285,33 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 }
 
 // Keep finds all revisions to be kept for a Compaction at the given rev.
-func (ti *treeIndex) Keep(rev int64) map[revision]struct{} {
+func (ti *treeIndex) Keep(rev int64, retention []Retention) map[revision]struct{} {
 	available := make(map[revision]struct{})
 	ti.RLock()
 	defer ti.RUnlock()
 	ti.tree.Ascend(func(keyi *keyIndex) bool {
-		keyi.keep(rev, available)
+		atRev := retentionRev(retention, keyi.key, rev)
+		keyi.keep(atRev, available)
+		keyi.retain(atRev, rev, available)
 		return true
 	})
 	return available
 }
 
+// CountCompacted counts the keys that a Compaction at the given rev would
+// remove from the index.
+func (ti *treeIndex) CountCompacted(rev int64, retention []Retention) (n int) {
+	ti.RLock()
+	defer ti.RUnlock()
+	ti.tree.Ascend(func(keyi *keyIndex) bool {
+		if keyi.compactedAt(retentionRev(retention, keyi.key, rev)) {
+			n++
+		}
+		return true
+	})
+	return n
+}
+
 func (ti *treeIndex) Equal(bi index) bool {
 	b := bi.(*treeIndex)
 
@@ -120,6 +120,71 @@ func TestIndexRange(t *testing.T) {
 	}
 }
 
+func TestIndexHistory(t *testing.T) {
+	ti := newTreeIndex(zaptest.NewLogger(t))
+	ti.Put([]byte("foo"), revision{main: 1})
+	ti.Put([]byte("foo1"), revision{main: 2})
+	ti.Put([]byte("foo"), revision{main: 3})
+	ti.Put([]byte("foo1"), revision{main: 4})
+	ti.Put([]byte("foo2"), revision{main: 4, sub: 1})
+	if err := ti.Tombstone([]byte("foo"), revision{main: 5}); err != nil {
+		t.Fatal(err)
+	}
+	ti.Put([]byte("foo2"), revision{main: 6})
+	ti.Put([]byte("foo1"), revision{main: 7})
+
+	allRevs := []revision{{main: 1}, {main: 2}, {main: 3}, {main: 4}, {main: 4, sub: 1}, {main: 5}, {main: 6}, {main: 7}}
+	tests := []struct {
+		key, end         []byte
+		startRev, endRev int64
+		limit            int64
+		wrevs            []revision
+	}{
+		// single key
+		{
+			[]byte("foo"), nil, 1, 7, 0, []revision{{main: 1}, {main: 3}, {main: 5}},
+		},
+		// single key, cut after the revision following the page
+		{
+			[]byte("foo"), nil, 1, 7, 1, []revision{{main: 1}, {main: 3}},
+		},
+		// range keys
+		{
+			[]byte("foo"), []byte("fop"), 1, 7, 0, allRevs,
+		},
+		// range keys in a window of revisions
+		{
+			[]byte("foo1"), []byte("fop"), 3, 6, 0, []revision{{main: 4}, {main: 4, sub: 1}, {main: 6}},
+		},
+		// range keys, merged and cut while visiting the keys
+		{
+			[]byte("foo"), []byte("fop"), 1, 7, 1, allRevs[:2],
+		},
+		// range keys, cut after the revision following the page
+		{
+			[]byte("foo"), []byte("fop"), 1, 7, 2, allRevs[:3],
+		},
+		// range keys, cut after the rest of the main revision
+		{
+			[]byte("foo"), []byte("fop"), 1, 7, 3, allRevs[:5],
+		},
+		// range keys, page within one main revision keeps the next revision
+		{
+			[]byte("foo"), []byte("fop"), 4, 7, 1, allRevs[3:6],
+		},
+		// range keys, limit larger than the history
+		{
+			[]byte("foo"), []byte("fop"), 1, 7, 10, allRevs,
+		},
+	}
+	for i, tt := range tests {
+		revs := ti.History(tt.key, tt.end, tt.startRev, tt.endRev, tt.limit)
+		if !reflect.DeepEqual(revs, tt.wrevs) {
+			t.Errorf("#%d: revs = %+v, want %+v", i, revs, tt.wrevs)
+		}
+	}
+}
+
 func TestIndexTombstone(t *testing.T) {
 	ti := newTreeIndex(zaptest.NewLogger(t))
 	ti.Put([]byte("foo"), revision{main: 1})
@@ -265,8 +330,8 @@ func TestIndexCompactAndKeep(t *testing.T) {
 		}
 	}
 	for i := int64(1); i < maxRev; i++ {
-		am := ti.Compact(i)
-		keep := ti.Keep(i)
+		am := ti.Compact(i, nil)
+		keep := ti.Keep(i, nil)
 		if !(reflect.DeepEqual(am, keep)) {
 			t.Errorf("#%d: compact keep %v != Keep keep %v", i, am, keep)
 		}
@@ -297,8 +362,8 @@ func TestIndexCompactAndKeep(t *testing.T) {
 				ti.Put(tt.key, tt.rev)
 			}
 		}
-		am := ti.Compact(i)
-		keep := ti.Keep(i)
+		am := ti.Compact(i, nil)
+		keep := ti.Keep(i, nil)
 		if !(reflect.DeepEqual(am, keep)) {
 			t.Errorf("#%d: compact keep %v != Keep keep %v", i, am, keep)
 		}
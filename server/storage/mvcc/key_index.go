@@ -103,6 +103,13 @@ func (ki *keyIndex) put(lg *zap.Logger, main int64, sub int64) {
 	ki.modified = rev
 }
 
+// setLease puts a revision that only changed the lease of the key, which
+// does not count towards its version.
+func (ki *keyIndex) setLease(lg *zap.Logger, main int64, sub int64) {
+	ki.put(lg, main, sub)
+	ki.generations[len(ki.generations)-1].ver--
+}
+
 func (ki *keyIndex) restore(lg *zap.Logger, created, modified revision, ver int64) {
 	if len(ki.generations) != 0 {
 		lg.Panic(
@@ -201,6 +208,29 @@ func (ki *keyIndex) since(lg *zap.Logger, rev int64) []revision {
 	return revs
 }
 
+// history returns the revisions of the key, tombstones included, with a main
+// revision in [startRev, endRev] in ascending order.
+// If limit > 0, it stops after the first limit revisions, the rest of the
+// main revision they end in and the first revision after it.
+func (ki *keyIndex) history(startRev, endRev, limit int64) []revision {
+	var revs []revision
+	for _, g := range ki.generations {
+		for _, r := range g.revs {
+			if r.main < startRev {
+				continue
+			}
+			if r.main > endRev {
+				return revs
+			}
+			revs = append(revs, r)
+			if n := len(revs); limit > 0 && n > int(limit)+1 && revs[n-1].main != revs[n-2].main {
+				return revs
+			}
+		}
+	}
+	return revs
+}
+
 // compact compacts a keyIndex by removing the versions with smaller or equal
 // revision than the given atRev except the largest one (If the largest one is
 // a tombstone, it will not be kept).
@@ -248,6 +278,23 @@ func (ki *keyIndex) keep(atRev int64, available map[revision]struct{}) {
 	}
 }
 
+// retain adds the revisions in (atRev, rev] to available, so that a key
+// compacted to a retained revision atRev keeps its history up to rev.
+func (ki *keyIndex) retain(atRev, rev int64, available map[revision]struct{}) {
+	if atRev >= rev {
+		return
+	}
+	for _, r := range ki.history(atRev+1, rev, 0) {
+		available[r] = struct{}{}
+	}
+}
+
+// compactedAt returns true if compacting at atRev would remove the keyIndex,
+// which is when the key was last deleted at or before atRev.
+func (ki *keyIndex) compactedAt(atRev int64) bool {
+	return ki.generations[len(ki.generations)-1].isEmpty() && ki.modified.main <= atRev
+}
+
 func (ki *keyIndex) doCompact(atRev int64, available map[revision]struct{}) (genIdx int, revIndex int) {
 	// walk until reaching the first revision smaller or equal to "atRev",
 	// and add the revision to the available map
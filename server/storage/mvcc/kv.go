@@ -35,6 +35,19 @@ type RangeResult struct {
 	Count int
 }
 
+type HistoryOptions struct {
+	StartRev int64
+	EndRev   int64
+	Limit    int64
+}
+
+type HistoryResult struct {
+	Events  []mvccpb.Event
+	Rev     int64
+	More    bool
+	NextRev int64
+}
+
 type ReadView interface {
 	// FirstRev returns the first KV revision at the time of opening the txn.
 	// After a compaction, the first revision increases to the compaction
@@ -53,6 +66,17 @@ type ReadView interface {
 	// Limit limits the number of keys returned.
 	// If the required rev is compacted, ErrCompacted will be returned.
 	Range(ctx context.Context, key, end []byte, ro RangeOptions) (r *RangeResult, err error)
+
+	// History lists the changes, deletions included, to the keys in the range
+	// with a revision in [StartRev, EndRev], ordered by revision.
+	// The returned rev is the current revision of the KV when the operation is executed.
+	// If StartRev <= 0, the history starts at the compaction revision.
+	// If EndRev <= 0, the history ends at the current revision.
+	// `key` and `end` select the keys as in Range.
+	// Limit limits the number of events returned without splitting the events
+	// of one revision; NextRev is the StartRev of the next page if More is set.
+	// If StartRev is compacted, ErrCompacted will be returned.
+	History(ctx context.Context, key, end []byte, ho HistoryOptions) (r *HistoryResult, err error)
 }
 
 // TxnRead represents a read-only transaction with operations that will not
@@ -79,6 +103,19 @@ type WriteView interface {
 	// A put also increases the rev of the store, and generates one event in the event history.
 	// The returned rev is the current revision of the KV when the operation is executed.
 	Put(key, value []byte, lease lease.LeaseID) (rev int64)
+
+	// PutWithExpiry is Put that also sets the unix time, in seconds, after which
+	// the key expires. An expireTime of 0 indicates the key does not expire.
+	PutWithExpiry(key, value []byte, lease lease.LeaseID, expireTime int64) (rev int64)
+
+	// SetLease attaches an existing key to the given lease, or detaches it if
+	// lease is NoLease, without changing its value. The key is rewritten at a
+	// new revision with its version and expire time unchanged, which increases
+	// the rev of the store and generates one lease event in the event history.
+	// The number of keys changed will be returned; a missing key or a key
+	// already attached to the lease is left untouched.
+	// The returned rev is the current revision of the KV when the operation is executed.
+	SetLease(key []byte, lease lease.LeaseID) (n, rev int64)
 }
 
 // TxnWrite represents a transaction that can modify the store.
@@ -96,6 +133,12 @@ func (trw *txnReadWrite) DeleteRange(key, end []byte) (n, rev int64) { panic("un
 func (trw *txnReadWrite) Put(key, value []byte, lease lease.LeaseID) (rev int64) {
 	panic("unexpected Put")
 }
+func (trw *txnReadWrite) PutWithExpiry(key, value []byte, lease lease.LeaseID, expireTime int64) (rev int64) {
+	panic("unexpected PutWithExpiry")
+}
+func (trw *txnReadWrite) SetLease(key []byte, lease lease.LeaseID) (n, rev int64) {
+	panic("unexpected SetLease")
+}
 func (trw *txnReadWrite) Changes() []mvccpb.KeyValue { return nil }
 
 func NewReadOnlyTxnWrite(txn TxnRead) TxnWrite { return &txnReadWrite{txn} }
@@ -109,6 +152,29 @@ const (
 	SharedBufReadTxMode = ReadTxMode(2)
 )
 
+// CompactEstimate is the impact of compacting the store at a revision.
+type CompactEstimate struct {
+	// Keys is the number of keys whose history would be removed entirely.
+	Keys int64
+	// Revisions is the number of key revisions that would be removed.
+	Revisions int64
+	// Bytes is the size of the removed key revisions in the backend.
+	Bytes int64
+	// Watchers is the number of watchers that would be cancelled because
+	// their start revision would be compacted.
+	Watchers int64
+}
+
+// UsageTracker tracks the storage used by keys.
+type UsageTracker interface {
+	// Tracks returns true if the usage of key is tracked.
+	Tracks(key []byte) bool
+	// UnsafeTrack adds the given change in key count and size to the usage
+	// of key. It is called with the backend transaction of the write txn
+	// locked, so that the usage is committed along with the write.
+	UnsafeTrack(tx backend.BatchTx, key []byte, keys, bytes int64)
+}
+
 type KV interface {
 	ReadView
 	WriteView
@@ -125,6 +191,27 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error)
 
+	// CompactWithRetention is like Compact, but keeps the revisions of the keys
+	// under each retained prefix from its retained revision on.
+	CompactWithRetention(trace *traceutil.Trace, rev int64, retention []Retention) (<-chan struct{}, error)
+
+	// EstimateCompact estimates the impact of CompactWithRetention at rev
+	// without compacting the store.
+	EstimateCompact(rev int64, retention []Retention) (CompactEstimate, error)
+
+	// Expired returns up to limit keys whose expire time, in unix seconds,
+	// is at or before now, earliest first.
+	Expired(now int64, limit int) []ExpiredKey
+
+	// SaveIncremental writes the key revisions after baseRev, along with the
+	// contents of all other buckets, to a new backend file at path.
+	// It returns the revision of the increment.
+	SaveIncremental(baseRev int64, path string) (rev int64, err error)
+
+	// SetUsageTracker sets the tracker notified of the change in usage of
+	// the keys written from then on. A nil tracker disables tracking.
+	SetUsageTracker(t UsageTracker)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
@@ -256,6 +256,102 @@ func testKVRangeLimit(t *testing.T, f rangeFunc) {
 	}
 }
 
+func TestKVHistory(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease) // rev 2
+	txn := s.Write(traceutil.TODO())
+	txn.Put([]byte("foo1"), []byte("bar1"), lease.NoLease) // rev 3
+	txn.Put([]byte("foo2"), []byte("bar2"), lease.NoLease) // rev 3
+	txn.End()
+	s.Put([]byte("foo"), []byte("baz"), lease.NoLease) // rev 4
+	s.DeleteRange([]byte("foo"), nil)                  // rev 5
+	txn = s.Write(traceutil.TODO())
+	txn.SetLease([]byte("foo1"), 1) // rev 6
+	txn.End()
+
+	type event struct {
+		typ mvccpb.Event_EventType
+		key string
+		rev int64
+	}
+	tests := []struct {
+		key, end []byte
+		ho       HistoryOptions
+
+		wevs     []event
+		wmore    bool
+		wnextRev int64
+	}{
+		// single key, deletion included
+		{
+			[]byte("foo"), nil, HistoryOptions{},
+			[]event{{mvccpb.PUT, "foo", 2}, {mvccpb.PUT, "foo", 4}, {mvccpb.DELETE, "foo", 5}}, false, 0,
+		},
+		// range ordered by revision
+		{
+			[]byte("foo"), []byte("foo3"), HistoryOptions{StartRev: 3, EndRev: 4},
+			[]event{{mvccpb.PUT, "foo1", 3}, {mvccpb.PUT, "foo2", 3}, {mvccpb.PUT, "foo", 4}}, false, 0,
+		},
+		// lease changes
+		{
+			[]byte("foo1"), nil, HistoryOptions{StartRev: 4},
+			[]event{{mvccpb.LEASE, "foo1", 6}}, false, 0,
+		},
+		// limit does not split a revision
+		{
+			[]byte("foo"), []byte("foo3"), HistoryOptions{Limit: 2},
+			[]event{{mvccpb.PUT, "foo", 2}}, true, 3,
+		},
+		// a revision larger than the limit is returned whole
+		{
+			[]byte("foo"), []byte("foo3"), HistoryOptions{StartRev: 3, Limit: 1},
+			[]event{{mvccpb.PUT, "foo1", 3}, {mvccpb.PUT, "foo2", 3}}, true, 4,
+		},
+	}
+	for i, tt := range tests {
+		r, err := s.History(context.TODO(), tt.key, tt.end, tt.ho)
+		if err != nil {
+			t.Fatalf("#%d: history error (%v)", i, err)
+		}
+		if r.Rev != 6 {
+			t.Errorf("#%d: rev = %d, want 6", i, r.Rev)
+		}
+		var evs []event
+		for _, ev := range r.Events {
+			evs = append(evs, event{ev.Type, string(ev.Kv.Key), ev.Kv.ModRevision})
+		}
+		if !reflect.DeepEqual(evs, tt.wevs) {
+			t.Errorf("#%d: events = %v, want %v", i, evs, tt.wevs)
+		}
+		if r.More != tt.wmore || r.NextRev != tt.wnextRev {
+			t.Errorf("#%d: more, next rev = %v, %d, want %v, %d", i, r.More, r.NextRev, tt.wmore, tt.wnextRev)
+		}
+	}
+
+	if _, err := s.History(context.TODO(), []byte("foo"), nil, HistoryOptions{EndRev: 7}); err != ErrFutureRev {
+		t.Errorf("error = %v, want %v", err, ErrFutureRev)
+	}
+
+	ch, err := s.Compact(traceutil.TODO(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-ch
+	if _, err = s.History(context.TODO(), []byte("foo"), nil, HistoryOptions{StartRev: 3}); err != ErrCompacted {
+		t.Errorf("error = %v, want %v", err, ErrCompacted)
+	}
+	r, err := s.History(context.TODO(), []byte("foo"), nil, HistoryOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Events) != 2 || r.Events[0].Kv.ModRevision != 4 || r.Events[1].Type != mvccpb.DELETE {
+		t.Errorf("events after compaction = %v, want the put at 4 and the delete at 5", r.Events)
+	}
+}
+
 func TestKVPutMultipleTimes(t *testing.T)    { testKVPutMultipleTimes(t, normalPutFunc) }
 func TestKVTxnPutMultipleTimes(t *testing.T) { testKVPutMultipleTimes(t, txnPutFunc) }
 
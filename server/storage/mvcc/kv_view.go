@@ -41,6 +41,12 @@ func (rv *readView) Range(ctx context.Context, key, end []byte, ro RangeOptions)
 	return tr.Range(ctx, key, end, ro)
 }
 
+func (rv *readView) History(ctx context.Context, key, end []byte, ho HistoryOptions) (r *HistoryResult, err error) {
+	tr := rv.kv.Read(ConcurrentReadTxMode, traceutil.TODO())
+	defer tr.End()
+	return tr.History(ctx, key, end, ho)
+}
+
 type writeView struct{ kv KV }
 
 func (wv *writeView) DeleteRange(key, end []byte) (n, rev int64) {
@@ -54,3 +60,15 @@ func (wv *writeView) Put(key, value []byte, lease lease.LeaseID) (rev int64) {
 	defer tw.End()
 	return tw.Put(key, value, lease)
 }
+
+func (wv *writeView) PutWithExpiry(key, value []byte, lease lease.LeaseID, expireTime int64) (rev int64) {
+	tw := wv.kv.Write(traceutil.TODO())
+	defer tw.End()
+	return tw.PutWithExpiry(key, value, lease, expireTime)
+}
+
+func (wv *writeView) SetLease(key []byte, lease lease.LeaseID) (n, rev int64) {
+	tw := wv.kv.Write(traceutil.TODO())
+	defer tw.End()
+	return tw.SetLease(key, lease)
+}
@@ -22,6 +22,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/coreos/go-semver/semver"
+
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/schedule"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
@@ -44,6 +46,7 @@ const (
 	markedRevBytesLen      = revBytesLen + 1
 	markBytePosition       = markedRevBytesLen - 1
 	markTombstone     byte = 't'
+	markLease         byte = 'l'
 )
 
 var restoreChunkKeys = 10000 // non-const for testing
@@ -53,6 +56,16 @@ var minimumBatchInterval = 10 * time.Millisecond
 type StoreConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
+	// ValueCompressor compresses the values written to the key bucket once
+	// the version of Cluster supports it; nil stores them raw.
+	ValueCompressor ValueCompressor
+	Cluster         Cluster
+}
+
+// Cluster reports the cluster version the storage format adapts to.
+type Cluster interface {
+	// Version is the cluster-wide minimum major.minor version.
+	Version() *semver.Version
 }
 
 type store struct {
@@ -66,8 +79,15 @@ type store struct {
 
 	b       backend.Backend
 	kvindex index
+	// expiry indexes the keys with an expire time.
+	expiry *expiryIndex
 
 	le lease.Lessor
+	// usage is notified of the change in usage of the keys written.
+	usage UsageTracker
+	// valueCompressed is true once the backend records that it may hold
+	// compressed values. It is guarded by the batch tx lock.
+	valueCompressed bool
 
 	// revMuLock protects currentRev and compactMainRev.
 	// Locked at end of write txn and released after write txn unlock lock.
@@ -77,6 +97,8 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// retention is the retention of the last compaction.
+	retention []Retention
 
 	fifoSched schedule.Scheduler
 
@@ -102,6 +124,7 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg StoreConfi
 		cfg:     cfg,
 		b:       b,
 		kvindex: newTreeIndex(lg),
+		expiry:  newExpiryIndex(),
 
 		le: le,
 
@@ -187,7 +210,7 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	if rev == 0 {
 		rev = currentRev
 	}
-	keep := s.kvindex.Keep(rev)
+	keep := s.kvindex.Keep(rev, s.retention)
 
 	tx := s.b.ReadTx()
 	tx.RLock()
@@ -198,23 +221,41 @@ func (s *store) hashByRev(rev int64) (hash KeyValueHash, currentRev int64, err e
 	return hash, currentRev, err
 }
 
-func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
+func (s *store) Expired(now int64, limit int) []ExpiredKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.expiry.Expired(now, limit)
+}
+
+// compactRev returns the oldest revision that can be read from key(included)
+// to end(excluded), which is older than the last compaction for ranges under
+// a retained prefix.
+func (s *store) compactRev(key, end []byte) int64 {
+	return rangeRetentionRev(s.retention, key, end, s.compactMainRev)
+}
+
+func (s *store) updateCompactRev(rev int64, retention []Retention) (<-chan struct{}, int64, []Retention, error) {
 	s.revMu.Lock()
 	if rev <= s.compactMainRev {
 		ch := make(chan struct{})
 		f := schedule.NewJob("kvstore_updateCompactRev_compactBarrier", func(ctx context.Context) { s.compactBarrier(ctx, ch) })
 		s.fifoSched.Schedule(f)
 		s.revMu.Unlock()
-		return ch, 0, ErrCompacted
+		return ch, 0, nil, ErrCompacted
 	}
 	if rev > s.currentRev {
 		s.revMu.Unlock()
-		return nil, 0, ErrFutureRev
+		return nil, 0, nil, ErrFutureRev
 	}
-	compactMainRev := s.compactMainRev
+	compactMainRev, prevRetention := s.compactMainRev, s.retention
+	retention = clampRetention(retention, prevRetention, compactMainRev, rev)
 	s.compactMainRev = rev
+	s.retention = retention
 
 	SetScheduledCompact(s.b.BatchTx(), rev)
+	if len(retention) != 0 || len(prevRetention) != 0 {
+		SetCompactRetention(s.b.BatchTx(), retention)
+	}
 	// ensure that desired compaction is persisted
 	// gofail: var compactBeforeCommitScheduledCompact struct{}
 	s.b.ForceCommit()
@@ -222,17 +263,17 @@ func (s *store) updateCompactRev(rev int64) (<-chan struct{}, int64, error) {
 
 	s.revMu.Unlock()
 
-	return nil, compactMainRev, nil
+	return nil, compactMainRev, retention, nil
 }
 
-func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64) (<-chan struct{}, error) {
+func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64, retention []Retention) (<-chan struct{}, error) {
 	ch := make(chan struct{})
 	j := schedule.NewJob("kvstore_compact", func(ctx context.Context) {
 		if ctx.Err() != nil {
 			s.compactBarrier(ctx, ch)
 			return
 		}
-		hash, err := s.scheduleCompaction(rev, prevCompactRev)
+		hash, err := s.scheduleCompaction(rev, prevCompactRev, retention)
 		if err != nil {
 			s.lg.Warn("Failed compaction", zap.Error(err))
 			s.compactBarrier(context.TODO(), ch)
@@ -247,19 +288,23 @@ func (s *store) compact(trace *traceutil.Trace, rev, prevCompactRev int64) (<-ch
 	return ch, nil
 }
 
-func (s *store) compactLockfree(rev int64) (<-chan struct{}, error) {
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+func (s *store) compactLockfree(rev int64, retention []Retention) (<-chan struct{}, error) {
+	ch, prevCompactRev, retention, err := s.updateCompactRev(rev, retention)
 	if err != nil {
 		return ch, err
 	}
 
-	return s.compact(traceutil.TODO(), rev, prevCompactRev)
+	return s.compact(traceutil.TODO(), rev, prevCompactRev, retention)
 }
 
 func (s *store) Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error) {
+	return s.CompactWithRetention(trace, rev, nil)
+}
+
+func (s *store) CompactWithRetention(trace *traceutil.Trace, rev int64, retention []Retention) (<-chan struct{}, error) {
 	s.mu.Lock()
 
-	ch, prevCompactRev, err := s.updateCompactRev(rev)
+	ch, prevCompactRev, retention, err := s.updateCompactRev(rev, retention)
 	trace.Step("check and update compact revision")
 	if err != nil {
 		s.mu.Unlock()
@@ -267,7 +312,7 @@ func (s *store) Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, err
 	}
 	s.mu.Unlock()
 
-	return s.compact(trace, rev, prevCompactRev)
+	return s.compact(trace, rev, prevCompactRev, retention)
 }
 
 func (s *store) Commit() {
@@ -276,6 +321,12 @@ func (s *store) Commit() {
 	s.b.ForceCommit()
 }
 
+func (s *store) SetUsageTracker(t UsageTracker) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.usage = t
+}
+
 func (s *store) Restore(b backend.Backend) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -285,18 +336,25 @@ func (s *store) Restore(b backend.Backend) error {
 
 	s.b = b
 	s.kvindex = newTreeIndex(s.lg)
+	s.expiry = newExpiryIndex()
 
 	{
 		// During restore the metrics might report 'special' values
 		s.revMu.Lock()
 		s.currentRev = 1
 		s.compactMainRev = -1
+		s.retention = nil
 		s.revMu.Unlock()
 	}
 
 	s.fifoSched = schedule.NewFIFOScheduler(s.lg)
 	s.stopc = make(chan struct{})
 
+	tx := s.b.BatchTx()
+	tx.LockOutsideApply()
+	s.valueCompressed = false
+	tx.Unlock()
+
 	return s.restore()
 }
 
@@ -326,6 +384,16 @@ func (s *store) restore() error {
 		s.revMu.Unlock()
 	}
 	scheduledCompact, _ := UnsafeReadScheduledCompact(tx)
+	// the retention is of the scheduled compaction, which is either finished
+	// or resumed below with the same retention.
+	retention, err := UnsafeReadCompactRetention(tx)
+	if err != nil {
+		tx.Unlock()
+		return err
+	}
+	s.revMu.Lock()
+	s.retention = retention
+	s.revMu.Unlock()
 	// index keys concurrently as they're loaded in from tx
 	keysGauge.Set(0)
 	rkvc, revc := restoreIntoIndex(s.lg, s.kvindex)
@@ -336,7 +404,7 @@ func (s *store) restore() error {
 		}
 		// rkvc blocks if the total pending keys exceeds the restore
 		// chunk size to keep keys from consuming too much memory.
-		restoreChunk(s.lg, rkvc, keys, vals, keyToLease)
+		restoreChunk(s.lg, rkvc, keys, vals, keyToLease, s.expiry)
 		if len(keys) < restoreChunkKeys {
 			// partial set implies final set
 			break
@@ -385,7 +453,7 @@ func (s *store) restore() error {
 	s.lg.Info("kvstore restored", zap.Int64("current-rev", s.currentRev))
 
 	if scheduledCompact != 0 {
-		if _, err := s.compactLockfree(scheduledCompact); err != nil {
+		if _, err := s.compactLockfree(scheduledCompact, retention); err != nil {
 			s.lg.Warn("compaction encountered error", zap.Error(err))
 		}
 
@@ -440,7 +508,11 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 					}
 					continue
 				}
-				ki.put(lg, rev.main, rev.sub)
+				if isLeaseChange(rkv.key) {
+					ki.setLease(lg, rev.main, rev.sub)
+				} else {
+					ki.put(lg, rev.main, rev.sub)
+				}
 			} else if !isTombstone(rkv.key) {
 				ki.restore(lg, revision{rkv.kv.CreateRevision, 0}, rev, rkv.kv.Version)
 				idx.Insert(ki)
@@ -451,19 +523,27 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 	return rkvc, revc
 }
 
-func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID) {
+func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID, expiry *expiryIndex) {
 	for i, key := range keys {
 		rkv := revKeyValue{key: key}
-		if err := rkv.kv.Unmarshal(vals[i]); err != nil {
+		v, err := DecodeValue(vals[i])
+		if err != nil {
+			lg.Fatal("failed to decode stored value", zap.Error(err))
+		}
+		if err := rkv.kv.Unmarshal(v); err != nil {
 			lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 		}
 		rkv.kstr = string(rkv.kv.Key)
 		if isTombstone(key) {
 			delete(keyToLease, rkv.kstr)
-		} else if lid := lease.LeaseID(rkv.kv.Lease); lid != lease.NoLease {
-			keyToLease[rkv.kstr] = lid
+			expiry.Remove(rkv.kstr)
 		} else {
-			delete(keyToLease, rkv.kstr)
+			if lid := lease.LeaseID(rkv.kv.Lease); lid != lease.NoLease {
+				keyToLease[rkv.kstr] = lid
+			} else {
+				delete(keyToLease, rkv.kstr)
+			}
+			expiry.Set(rkv.kstr, rkv.kv.ExpireTime, rkv.kv.ModRevision)
 		}
 		kvc <- rkv
 	}
@@ -519,6 +599,24 @@ func isTombstone(b []byte) bool {
 	return len(b) == markedRevBytesLen && b[markBytePosition] == markTombstone
 }
 
+// appendMarkLease appends lease mark to normal revision bytes.
+func appendMarkLease(lg *zap.Logger, b []byte) []byte {
+	if len(b) != revBytesLen {
+		lg.Panic(
+			"cannot append lease mark to non-normal revision bytes",
+			zap.Int("expected-revision-bytes-size", revBytesLen),
+			zap.Int("given-revision-bytes-size", len(b)),
+		)
+	}
+	return append(b, markLease)
+}
+
+// isLeaseChange checks whether the revision bytes is a change of the lease
+// of a key that leaves its value unchanged.
+func isLeaseChange(b []byte) bool {
+	return len(b) == markedRevBytesLen && b[markBytePosition] == markLease
+}
+
 func (s *store) HashStorage() HashStorage {
 	return s.hashes
 }
@@ -24,9 +24,9 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
-func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyValueHash, error) {
+func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64, retention []Retention) (KeyValueHash, error) {
 	totalStart := time.Now()
-	keep := s.kvindex.Compact(compactMainRev)
+	keep := s.kvindex.Compact(compactMainRev, retention)
 	indexCompactionPauseMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
 
 	totalStart = time.Now()
@@ -91,3 +91,48 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 		}
 	}
 }
+
+func (s *store) EstimateCompact(rev int64, retention []Retention) (CompactEstimate, error) {
+	s.mu.RLock()
+	s.revMu.RLock()
+	compactRev, currentRev, prevRetention := s.compactMainRev, s.currentRev, s.retention
+	s.revMu.RUnlock()
+
+	if rev <= compactRev {
+		s.mu.RUnlock()
+		return CompactEstimate{}, ErrCompacted
+	} else if rev > currentRev {
+		s.mu.RUnlock()
+		return CompactEstimate{}, ErrFutureRev
+	}
+	retention = clampRetention(retention, prevRetention, compactRev, rev)
+	keep := s.kvindex.Keep(rev, retention)
+	est := CompactEstimate{Keys: int64(s.kvindex.CountCompacted(rev, retention))}
+
+	// a concurrent read tx does not hold up commits while the estimate
+	// ranges over the compacted revisions
+	tx := s.b.ConcurrentReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	s.mu.RUnlock()
+
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(rev+1))
+
+	batchNum := s.cfg.CompactionBatchLimit
+	last := make([]byte, 8+1+8)
+	for {
+		keys, values := tx.UnsafeRange(schema.Key, last, end, int64(batchNum))
+		for i := range keys {
+			if _, ok := keep[bytesToRev(keys[i])]; !ok {
+				est.Revisions++
+				est.Bytes += int64(len(keys[i]) + len(values[i]))
+			}
+		}
+		if len(keys) < batchNum {
+			return est, nil
+		}
+		kr := bytesToRev(keys[len(keys)-1])
+		revToBytes(revision{main: kr.main, sub: kr.sub + 1}, last)
+	}
+}
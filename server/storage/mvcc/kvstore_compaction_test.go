@@ -84,7 +84,7 @@ func TestScheduleCompaction(t *testing.T) {
 		}
 		tx.Unlock()
 
-		_, err := s.scheduleCompaction(tt.rev, 0)
+		_, err := s.scheduleCompaction(tt.rev, 0, nil)
 		if err != nil {
 			t.Error(err)
 		}
@@ -144,3 +144,165 @@ func TestCompactAllAndRestore(t *testing.T) {
 		t.Errorf("unexpect range error %v", err)
 	}
 }
+
+func TestCompactWithRetentionAndRestore(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s0 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer os.Remove(tmpPath)
+
+	s0.Put([]byte("/config/a"), []byte("1"), lease.NoLease) // 2
+	s0.Put([]byte("/status/a"), []byte("1"), lease.NoLease) // 3
+	s0.Put([]byte("/config/a"), []byte("2"), lease.NoLease) // 4
+	s0.Put([]byte("/status/a"), []byte("2"), lease.NoLease) // 5
+	s0.Put([]byte("/config/b"), []byte("1"), lease.NoLease) // 6
+	s0.DeleteRange([]byte("/config/b"), nil)                // 7
+	s0.Put([]byte("/config/a"), []byte("3"), lease.NoLease) // 8
+
+	retention := []Retention{{Prefix: []byte("/config/"), Rev: 3}}
+	done, err := s0.CompactWithRetention(traceutil.TODO(), 7, retention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for compaction to finish")
+	}
+	wantHash, _, err := s0.HashStorage().HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s0.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s1 := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	for i, s := range []*store{s0, s1} {
+		if i == 1 {
+			hash, _, err := s.HashStorage().HashByRev(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hash != wantHash {
+				t.Errorf("#%d: hash = %+v, want %+v", i, hash, wantHash)
+			}
+		}
+		r, err := s.Range(context.TODO(), []byte("/config/a"), nil, RangeOptions{Rev: 3})
+		if err != nil {
+			t.Fatalf("#%d: unexpected range error %v", i, err)
+		}
+		if len(r.KVs) != 1 || string(r.KVs[0].Value) != "1" {
+			t.Errorf("#%d: kvs = %+v, want value 1", i, r.KVs)
+		}
+		r, err = s.Range(context.TODO(), []byte("/config/"), []byte("/config0"), RangeOptions{Rev: 6})
+		if err != nil {
+			t.Fatalf("#%d: unexpected range error %v", i, err)
+		}
+		if len(r.KVs) != 2 {
+			t.Errorf("#%d: len(kvs) = %d, want 2", i, len(r.KVs))
+		}
+		if _, err = s.Range(context.TODO(), []byte("/config/a"), nil, RangeOptions{Rev: 2}); err != ErrCompacted {
+			t.Errorf("#%d: err = %v, want %v", i, err, ErrCompacted)
+		}
+		if _, err = s.Range(context.TODO(), []byte("/status/a"), nil, RangeOptions{Rev: 5}); err != ErrCompacted {
+			t.Errorf("#%d: err = %v, want %v", i, err, ErrCompacted)
+		}
+		if _, err = s.Range(context.TODO(), []byte("/"), []byte("0"), RangeOptions{Rev: 6}); err != ErrCompacted {
+			t.Errorf("#%d: err = %v, want %v", i, err, ErrCompacted)
+		}
+		h, err := s.History(context.TODO(), []byte("/config/a"), nil, HistoryOptions{})
+		if err != nil {
+			t.Fatalf("#%d: unexpected history error %v", i, err)
+		}
+		var revs []int64
+		for _, ev := range h.Events {
+			revs = append(revs, ev.Kv.ModRevision)
+		}
+		if wrevs := []int64{4, 8}; !reflect.DeepEqual(revs, wrevs) {
+			t.Errorf("#%d: history revisions = %v, want %v", i, revs, wrevs)
+		}
+	}
+
+	// compacting without retention drops the retained history
+	done, err = s1.Compact(traceutil.TODO(), 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if _, err = s1.Range(context.TODO(), []byte("/config/a"), nil, RangeOptions{Rev: 4}); err != ErrCompacted {
+		t.Errorf("err = %v, want %v", err, ErrCompacted)
+	}
+	s1.Close()
+}
+
+func TestEstimateCompact(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	// a small batch limit makes the estimate range over several batches
+	s := New(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{CompactionBatchLimit: 2})
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)  // 2
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease) // 3
+	s.Put([]byte("baz"), []byte("bar"), lease.NoLease)  // 4
+	s.DeleteRange([]byte("baz"), nil)                   // 5
+	s.Put([]byte("foo"), []byte("bar2"), lease.NoLease) // 6
+
+	ws := s.NewWatchStream()
+	defer ws.Close()
+	// the watchers stay unsynced as nothing syncs them in the test
+	s.(*watchableStore).mu.Lock()
+	for _, startRev := range []int64{2, 5} {
+		w := &watcher{key: []byte("foo"), minRev: startRev, ch: make(chan WatchResponse, 1)}
+		s.(*watchableStore).unsynced.add(w)
+	}
+	s.(*watchableStore).mu.Unlock()
+
+	tests := []struct {
+		rev       int64
+		retention []Retention
+		west      CompactEstimate
+	}{
+		// foo@2 is superseded
+		{3, nil, CompactEstimate{Revisions: 1, Watchers: 1}},
+		// foo@2, baz@4 and baz@5 are removed along with the deleted key baz
+		{5, nil, CompactEstimate{Keys: 1, Revisions: 3, Watchers: 1}},
+		// the retained history of baz is kept
+		{5, []Retention{{Prefix: []byte("b"), Rev: 3}}, CompactEstimate{Revisions: 1, Watchers: 1}},
+		{6, nil, CompactEstimate{Keys: 1, Revisions: 4, Watchers: 2}},
+	}
+	for i, tt := range tests {
+		est, err := s.EstimateCompact(tt.rev, tt.retention)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if est.Bytes <= 0 {
+			t.Errorf("#%d: bytes = %d, want > 0", i, est.Bytes)
+		}
+		est.Bytes = 0
+		if est != tt.west {
+			t.Errorf("#%d: estimate = %+v, want %+v", i, est, tt.west)
+		}
+	}
+
+	if _, err := s.EstimateCompact(7, nil); err != ErrFutureRev {
+		t.Errorf("err = %v, want %v", err, ErrFutureRev)
+	}
+	done, err := s.Compact(traceutil.TODO(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	// deletes are only visible to read transactions once committed
+	b.ForceCommit()
+	if _, err = s.EstimateCompact(3, nil); err != ErrCompacted {
+		t.Errorf("err = %v, want %v", err, ErrCompacted)
+	}
+	// the compacted revisions are no longer counted
+	est, err := s.EstimateCompact(5, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if est.Revisions != 2 {
+		t.Errorf("revisions = %d, want 2", est.Revisions)
+	}
+}
@@ -72,7 +72,7 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 	if rev <= 0 {
 		rev = curRev
 	}
-	if rev < tr.s.compactMainRev {
+	if rev < tr.s.compactRev(key, end) {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
 	if ro.Count {
@@ -101,6 +101,11 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 		}
 		revToBytes(revpair, revBytes)
 		_, vs := tr.tx.UnsafeRange(schema.Key, revBytes, nil, 0)
+		if len(vs) == 0 {
+			// a revision that only changed the lease of the key is stored
+			// with a lease mark.
+			_, vs = tr.tx.UnsafeRange(schema.Key, appendMarkLease(tr.s.lg, revBytes), nil, 0)
+		}
 		if len(vs) != 1 {
 			tr.s.lg.Fatal(
 				"range failed to find revision pair",
@@ -115,7 +120,14 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 				zap.Int("len-values", len(vs)),
 			)
 		}
-		if err := kvs[i].Unmarshal(vs[0]); err != nil {
+		v, err := DecodeValue(vs[0])
+		if err != nil {
+			tr.s.lg.Fatal(
+				"failed to decode stored value",
+				zap.Error(err),
+			)
+		}
+		if err := kvs[i].Unmarshal(v); err != nil {
 			tr.s.lg.Fatal(
 				"failed to unmarshal mvccpb.KeyValue",
 				zap.Error(err),
@@ -126,6 +138,75 @@ func (tr *storeTxnRead) rangeKeys(ctx context.Context, key, end []byte, curRev i
 	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
 }
 
+func (tr *storeTxnRead) History(ctx context.Context, key, end []byte, ho HistoryOptions) (r *HistoryResult, err error) {
+	return tr.history(ctx, key, end, tr.Rev(), ho)
+}
+
+func (tr *storeTxnRead) history(ctx context.Context, key, end []byte, curRev int64, ho HistoryOptions) (*HistoryResult, error) {
+	endRev := ho.EndRev
+	if endRev > curRev {
+		return &HistoryResult{Rev: curRev}, ErrFutureRev
+	}
+	if endRev <= 0 {
+		endRev = curRev
+	}
+	compactRev := tr.s.compactRev(key, end)
+	startRev := ho.StartRev
+	if startRev <= 0 {
+		startRev = compactRev
+	}
+	if startRev < compactRev {
+		return &HistoryResult{Rev: 0}, ErrCompacted
+	}
+	revs := tr.s.kvindex.History(key, end, startRev, endRev, ho.Limit)
+	tr.trace.Step("list history from in-memory index tree")
+
+	r := &HistoryResult{Rev: curRev}
+	if ho.Limit > 0 && len(revs) > int(ho.Limit) {
+		// never split the events of one revision across pages
+		n := int(ho.Limit)
+		for n > 0 && revs[n].main == revs[n-1].main {
+			n--
+		}
+		for n == 0 || (n < len(revs) && revs[n].main == revs[n-1].main) {
+			n++
+		}
+		if n < len(revs) {
+			r.More, r.NextRev = true, revs[n].main
+			revs = revs[:n]
+		}
+	}
+
+	r.Events = make([]mvccpb.Event, len(revs))
+	startBytes, endBytes := newRevBytes(), newRevBytes()
+	for i, rev := range revs {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("history: context cancelled: %w", ctx.Err())
+		default:
+		}
+		// the stored revision may carry a tombstone or lease mark, so look up
+		// everything between the revision and its next sub revision.
+		revToBytes(rev, startBytes)
+		revToBytes(revision{main: rev.main, sub: rev.sub + 1}, endBytes)
+		ks, vs := tr.tx.UnsafeRange(schema.Key, startBytes, endBytes, 0)
+		if len(vs) != 1 {
+			tr.s.lg.Fatal(
+				"history failed to find revision pair",
+				zap.Int64("revision-main", rev.main),
+				zap.Int64("revision-sub", rev.sub),
+				zap.Int64("revision-current", curRev),
+				zap.Binary("key", key),
+				zap.Binary("end", end),
+				zap.Int("len-values", len(vs)),
+			)
+		}
+		r.Events[i] = kvToEvent(tr.s.lg, ks[0], vs[0])
+	}
+	tr.trace.Step("list history from bolt db")
+	return r, nil
+}
+
 func (tr *storeTxnRead) End() {
 	tr.tx.RUnlock() // RUnlock signals the end of concurrentReadTx.
 	tr.s.mu.RUnlock()
@@ -162,6 +243,14 @@ func (tw *storeTxnWrite) Range(ctx context.Context, key, end []byte, ro RangeOpt
 	return tw.rangeKeys(ctx, key, end, rev, ro)
 }
 
+func (tw *storeTxnWrite) History(ctx context.Context, key, end []byte, ho HistoryOptions) (r *HistoryResult, err error) {
+	rev := tw.beginRev
+	if len(tw.changes) > 0 {
+		rev++
+	}
+	return tw.history(ctx, key, end, rev, ho)
+}
+
 func (tw *storeTxnWrite) DeleteRange(key, end []byte) (int64, int64) {
 	if n := tw.deleteRange(key, end); n != 0 || len(tw.changes) > 0 {
 		return n, tw.beginRev + 1
@@ -170,10 +259,22 @@ func (tw *storeTxnWrite) DeleteRange(key, end []byte) (int64, int64) {
 }
 
 func (tw *storeTxnWrite) Put(key, value []byte, lease lease.LeaseID) int64 {
-	tw.put(key, value, lease)
+	tw.put(key, value, lease, 0)
 	return tw.beginRev + 1
 }
 
+func (tw *storeTxnWrite) PutWithExpiry(key, value []byte, lease lease.LeaseID, expireTime int64) int64 {
+	tw.put(key, value, lease, expireTime)
+	return tw.beginRev + 1
+}
+
+func (tw *storeTxnWrite) SetLease(key []byte, lease lease.LeaseID) (int64, int64) {
+	if n := tw.setLease(key, lease); n != 0 || len(tw.changes) > 0 {
+		return n, tw.beginRev + 1
+	}
+	return 0, tw.beginRev
+}
+
 func (tw *storeTxnWrite) End() {
 	// only update index if the txn modifies the mvcc state.
 	if len(tw.changes) != 0 {
@@ -188,7 +289,7 @@ func (tw *storeTxnWrite) End() {
 	tw.s.mu.RUnlock()
 }
 
-func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
+func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID, expireTime int64) {
 	rev := tw.beginRev + 1
 	c := rev
 	oldLease := lease.NoLease
@@ -196,11 +297,17 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 	// if the key exists before, use its previous created and
 	// get its previous leaseID
 	_, created, ver, err := tw.s.kvindex.Get(key, rev)
-	if err == nil {
+	existed := err == nil
+	if existed {
 		c = created.main
 		oldLease = tw.s.le.GetLease(lease.LeaseItem{Key: string(key)})
 		tw.trace.Step("get key's previous created_revision and leaseID")
 	}
+	tracked := tw.s.usage != nil && tw.s.usage.Tracks(key)
+	var prevSize int64
+	if tracked && existed {
+		prevSize = tw.size(key)
+	}
 	ibytes := newRevBytes()
 	idxRev := revision{main: rev, sub: int64(len(tw.changes))}
 	revToBytes(idxRev, ibytes)
@@ -213,6 +320,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		ModRevision:    rev,
 		Version:        ver,
 		Lease:          int64(leaseID),
+		ExpireTime:     expireTime,
 	}
 
 	d, err := kv.Marshal()
@@ -224,11 +332,77 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 	}
 
 	tw.trace.Step("marshal mvccpb.KeyValue")
-	tw.tx.UnsafeSeqPut(schema.Key, ibytes, d)
+	tw.tx.UnsafeSeqPut(schema.Key, ibytes, tw.s.encodeValue(tw.tx, d))
 	tw.s.kvindex.Put(key, idxRev)
+	tw.s.expiry.Set(string(key), expireTime, rev)
+	tw.changes = append(tw.changes, kv)
+	tw.trace.Step("store kv pair into bolt db")
+	if tracked {
+		var keys int64
+		if !existed {
+			keys = 1
+		}
+		tw.s.usage.UnsafeTrack(tw.tx, key, keys, int64(len(key)+len(value))-prevSize)
+	}
+
+	tw.moveLease(key, oldLease, leaseID)
+}
+
+// size returns the size of the key and its current value in the txn.
+func (tw *storeTxnWrite) size(key []byte) int64 {
+	rrev := tw.beginRev
+	if len(tw.changes) > 0 {
+		rrev++
+	}
+	r, err := tw.rangeKeys(context.TODO(), key, nil, rrev, RangeOptions{})
+	if err != nil || len(r.KVs) == 0 {
+		return 0
+	}
+	return int64(len(key) + len(r.KVs[0].Value))
+}
+
+// setLease rewrites the key at a new revision attached to leaseID, keeping
+// its value, version, mod revision and expire time. It returns 0 if the key
+// does not exist or is already attached to leaseID.
+func (tw *storeTxnWrite) setLease(key []byte, leaseID lease.LeaseID) int64 {
+	rrev := tw.beginRev
+	if len(tw.changes) > 0 {
+		rrev++
+	}
+	r, err := tw.rangeKeys(context.TODO(), key, nil, rrev, RangeOptions{})
+	if err != nil || len(r.KVs) == 0 || r.KVs[0].Lease == int64(leaseID) {
+		return 0
+	}
+	kv := r.KVs[0]
+	oldLease := lease.LeaseID(kv.Lease)
+
+	rev := tw.beginRev + 1
+	ibytes := newRevBytes()
+	idxRev := revision{main: rev, sub: int64(len(tw.changes))}
+	revToBytes(idxRev, ibytes)
+	ibytes = appendMarkLease(tw.storeTxnRead.s.lg, ibytes)
+
+	kv.Lease = int64(leaseID)
+	d, err := kv.Marshal()
+	if err != nil {
+		tw.storeTxnRead.s.lg.Fatal(
+			"failed to marshal mvccpb.KeyValue",
+			zap.Error(err),
+		)
+	}
+
+	tw.tx.UnsafeSeqPut(schema.Key, ibytes, tw.s.encodeValue(tw.tx, d))
+	schema.UnsafeSetLeaseChanged(tw.tx)
+	tw.s.kvindex.SetLease(key, idxRev)
 	tw.changes = append(tw.changes, kv)
 	tw.trace.Step("store kv pair into bolt db")
 
+	tw.moveLease(key, oldLease, leaseID)
+	return 1
+}
+
+// moveLease detaches the key from oldLease and attaches it to leaseID.
+func (tw *storeTxnWrite) moveLease(key []byte, oldLease, leaseID lease.LeaseID) {
 	if oldLease == leaseID {
 		tw.trace.Step("attach lease to kv pair")
 		return
@@ -238,8 +412,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		if tw.s.le == nil {
 			panic("no lessor to detach lease")
 		}
-		err = tw.s.le.Detach(oldLease, []lease.LeaseItem{{Key: string(key)}})
-		if err != nil {
+		if err := tw.s.le.Detach(oldLease, []lease.LeaseItem{{Key: string(key)}}); err != nil {
 			tw.storeTxnRead.s.lg.Error(
 				"failed to detach old lease from a key",
 				zap.Error(err),
@@ -250,8 +423,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		if tw.s.le == nil {
 			panic("no lessor to attach lease")
 		}
-		err = tw.s.le.Attach(leaseID, []lease.LeaseItem{{Key: string(key)}})
-		if err != nil {
+		if err := tw.s.le.Attach(leaseID, []lease.LeaseItem{{Key: string(key)}}); err != nil {
 			panic("unexpected error from lease Attach")
 		}
 	}
@@ -274,6 +446,10 @@ func (tw *storeTxnWrite) deleteRange(key, end []byte) int64 {
 }
 
 func (tw *storeTxnWrite) delete(key []byte) {
+	if tw.s.usage != nil && tw.s.usage.Tracks(key) {
+		tw.s.usage.UnsafeTrack(tw.tx, key, -1, -tw.size(key))
+	}
+
 	ibytes := newRevBytes()
 	idxRev := revision{main: tw.beginRev + 1, sub: int64(len(tw.changes))}
 	revToBytes(idxRev, ibytes)
@@ -290,7 +466,7 @@ func (tw *storeTxnWrite) delete(key []byte) {
 		)
 	}
 
-	tw.tx.UnsafeSeqPut(schema.Key, ibytes, d)
+	tw.tx.UnsafeSeqPut(schema.Key, ibytes, tw.s.encodeValue(tw.tx, d))
 	err = tw.s.kvindex.Tombstone(key, idxRev)
 	if err != nil {
 		tw.storeTxnRead.s.lg.Fatal(
@@ -299,6 +475,7 @@ func (tw *storeTxnWrite) delete(key []byte) {
 			zap.Error(err),
 		)
 	}
+	tw.s.expiry.Remove(string(key))
 	tw.changes = append(tw.changes, kv)
 
 	item := lease.LeaseItem{Key: string(key)}
@@ -53,6 +53,19 @@ func (tw *metricsTxnWrite) Put(key, value []byte, lease lease.LeaseID) (rev int6
 	return tw.TxnWrite.Put(key, value, lease)
 }
 
+func (tw *metricsTxnWrite) PutWithExpiry(key, value []byte, lease lease.LeaseID, expireTime int64) (rev int64) {
+	tw.puts++
+	size := int64(len(key) + len(value))
+	tw.putSize += size
+	return tw.TxnWrite.PutWithExpiry(key, value, lease, expireTime)
+}
+
+func (tw *metricsTxnWrite) SetLease(key []byte, lease lease.LeaseID) (n, rev int64) {
+	tw.puts++
+	tw.putSize += int64(len(key))
+	return tw.TxnWrite.SetLease(key, lease)
+}
+
 func (tw *metricsTxnWrite) End() {
 	defer tw.TxnWrite.End()
 	if sum := tw.ranges + tw.puts + tw.deletes; sum > 1 {
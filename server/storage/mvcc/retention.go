@@ -0,0 +1,130 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/coreos/go-semver/semver"
+
+	"go.etcd.io/etcd/api/v3/version"
+)
+
+// Retention keeps the history of the keys under Prefix from revision Rev on
+// when the store is compacted to a later revision.
+type Retention struct {
+	Prefix []byte `json:"prefix"`
+	Rev    int64  `json:"rev"`
+}
+
+// IsRetentionSupported returns true if the cluster version cv supports
+// compacting with retention. Members before v3.6 ignore the retention and
+// compact the retained history, which makes their keyspace diverge.
+func IsRetentionSupported(cv *semver.Version) bool {
+	return cv != nil && !version.LessThan(*cv, version.V3_6)
+}
+
+// retentionRev returns the revision the given key is compacted to when the
+// store is compacted to rev. Overlapping prefixes retain the longest history.
+func retentionRev(retention []Retention, key []byte, rev int64) int64 {
+	for _, r := range retention {
+		if r.Rev < rev && bytes.HasPrefix(key, r.Prefix) {
+			rev = r.Rev
+		}
+	}
+	return rev
+}
+
+// rangeRetentionRev returns the oldest revision that can be read for every key
+// from key(included) to end(excluded) when the store is compacted to rev.
+// Ranges not entirely covered by one retained prefix can be read from rev on.
+func rangeRetentionRev(retention []Retention, key, end []byte, rev int64) int64 {
+	for _, r := range retention {
+		if r.Rev < rev && coversRange(r.Prefix, key, end) {
+			rev = r.Rev
+		}
+	}
+	return rev
+}
+
+func coversRange(prefix, key, end []byte) bool {
+	if !bytes.HasPrefix(key, prefix) {
+		return false
+	}
+	if end == nil {
+		return true
+	}
+	pend := prefixEnd(prefix)
+	if len(pend) == 0 {
+		return true
+	}
+	if len(end) == 1 && end[0] == 0 {
+		// end of "\x00" ranges to the end of the keyspace
+		return false
+	}
+	return bytes.Compare(end, pend) <= 0
+}
+
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// prefix is all 0xff, range to the end of the keyspace
+	return []byte{}
+}
+
+// clampRetention raises the retained revisions that are older than what the
+// previous compaction kept, as their history is already gone.
+func clampRetention(retention, prev []Retention, prevRev, rev int64) []Retention {
+	var rs []Retention
+	for _, r := range retention {
+		if r.Rev >= rev {
+			continue
+		}
+		end := prefixEnd(r.Prefix)
+		if len(end) == 0 {
+			end = []byte{0}
+		}
+		if kept := rangeRetentionRev(prev, r.Prefix, end, prevRev); r.Rev < kept {
+			r.Rev = kept
+		}
+		if r.Rev < rev {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+func encodeRetention(retention []Retention) []byte {
+	b, err := json.Marshal(retention)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func decodeRetention(b []byte) ([]Retention, error) {
+	var retention []Retention
+	if err := json.Unmarshal(b, &retention); err != nil {
+		return nil, err
+	}
+	return retention, nil
+}
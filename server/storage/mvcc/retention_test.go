@@ -0,0 +1,88 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+
+	"go.etcd.io/etcd/api/v3/version"
+)
+
+func TestRangeRetentionRev(t *testing.T) {
+	retention := []Retention{
+		{Prefix: []byte("/config/"), Rev: 5},
+		{Prefix: []byte("/config/db/"), Rev: 2},
+	}
+	tests := []struct {
+		key, end []byte
+		wrev     int64
+	}{
+		{[]byte("/config/a"), nil, 5},
+		{[]byte("/config/db/a"), nil, 2},
+		{[]byte("/config/"), []byte("/config0"), 5},
+		{[]byte("/config/db/"), []byte("/config/db0"), 2},
+		{[]byte("/config/"), []byte("/config1"), 10},
+		{[]byte("/config/"), []byte{0}, 10},
+		{[]byte("/status/a"), nil, 10},
+	}
+	for i, tt := range tests {
+		if rev := rangeRetentionRev(retention, tt.key, tt.end, 10); rev != tt.wrev {
+			t.Errorf("#%d: rev = %d, want %d", i, rev, tt.wrev)
+		}
+	}
+	if rev := retentionRev(retention, []byte("/config/db/a"), 10); rev != 2 {
+		t.Errorf("rev = %d, want 2", rev)
+	}
+}
+
+func TestClampRetention(t *testing.T) {
+	prev := []Retention{{Prefix: []byte("/config/"), Rev: 5}}
+	retention := []Retention{
+		// history before 5 is already compacted
+		{Prefix: []byte("/config/db/"), Rev: 3},
+		{Prefix: []byte("/config/"), Rev: 7},
+		// history before the previous compaction is already compacted
+		{Prefix: []byte("/status/"), Rev: 8},
+		// not older than the compaction
+		{Prefix: []byte("/audit/"), Rev: 20},
+	}
+	wretention := []Retention{
+		{Prefix: []byte("/config/db/"), Rev: 5},
+		{Prefix: []byte("/config/"), Rev: 7},
+		{Prefix: []byte("/status/"), Rev: 10},
+	}
+	if rs := clampRetention(retention, prev, 10, 15); !reflect.DeepEqual(rs, wretention) {
+		t.Errorf("retention = %+v, want %+v", rs, wretention)
+	}
+}
+
+func TestIsRetentionSupported(t *testing.T) {
+	tests := []struct {
+		cv   *semver.Version
+		want bool
+	}{
+		{nil, false},
+		{&version.V3_5, false},
+		{&version.V3_6, true},
+	}
+	for i, tt := range tests {
+		if got := IsRetentionSupported(tt.cv); got != tt.want {
+			t.Errorf("#%d: supported = %t, want %t", i, got, tt.want)
+		}
+	}
+}
@@ -58,3 +58,28 @@ func UnsafeSetFinishedCompact(tx backend.BatchTx, value int64) {
 	revToBytes(revision{main: value}, rbytes)
 	tx.UnsafePut(schema.Meta, schema.FinishedCompactKeyName, rbytes)
 }
+
+// UnsafeReadCompactRetention reads the retention of the last scheduled compaction.
+func UnsafeReadCompactRetention(tx backend.ReadTx) ([]Retention, error) {
+	_, vs := tx.UnsafeRange(schema.Meta, schema.CompactRetentionKeyName, nil, 0)
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	return decodeRetention(vs[0])
+}
+
+func SetCompactRetention(tx backend.BatchTx, retention []Retention) {
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	UnsafeSetCompactRetention(tx, retention)
+}
+
+// UnsafeSetCompactRetention persists the retention of the scheduled compaction,
+// so that an interrupted compaction resumes with it.
+func UnsafeSetCompactRetention(tx backend.BatchTx, retention []Retention) {
+	if len(retention) == 0 {
+		tx.UnsafeDelete(schema.Meta, schema.CompactRetentionKeyName)
+		return
+	}
+	tx.UnsafePut(schema.Meta, schema.CompactRetentionKeyName, encodeRetention(retention))
+}
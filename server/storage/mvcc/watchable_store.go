@@ -103,6 +103,30 @@ func (s *watchableStore) Close() error {
 	return s.store.Close()
 }
 
+// EstimateCompact also counts the unsynced and victim watchers whose start
+// revision would be compacted, as synced watchers are past any compaction.
+func (s *watchableStore) EstimateCompact(rev int64, retention []Retention) (CompactEstimate, error) {
+	est, err := s.store.EstimateCompact(rev, retention)
+	if err != nil {
+		return est, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for w := range s.unsynced.watchers {
+		if w.minRev < rev {
+			est.Watchers++
+		}
+	}
+	for _, wb := range s.victims {
+		for w := range wb {
+			if w.minRev < rev {
+				est.Watchers++
+			}
+		}
+	}
+	return est, nil
+}
+
 func (s *watchableStore) NewWatchStream() WatchStream {
 	watchStreamGauge.Inc()
 	return &watchStream{
@@ -413,26 +437,41 @@ func (s *watchableStore) syncWatchers() int {
 // kvsToEvents gets all events for the watchers from all key-value pairs
 func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []mvccpb.Event) {
 	for i, v := range vals {
-		var kv mvccpb.KeyValue
-		if err := kv.Unmarshal(v); err != nil {
-			lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
-		}
-
-		if !wg.contains(string(kv.Key)) {
+		ev := kvToEvent(lg, revs[i], v)
+		if !wg.contains(string(ev.Kv.Key)) {
 			continue
 		}
-
-		ty := mvccpb.PUT
-		if isTombstone(revs[i]) {
-			ty = mvccpb.DELETE
-			// patch in mod revision so watchers won't skip
-			kv.ModRevision = bytesToRev(revs[i]).main
-		}
-		evs = append(evs, mvccpb.Event{Kv: &kv, Type: ty})
+		evs = append(evs, ev)
 	}
 	return evs
 }
 
+// kvToEvent converts the key-value pair stored under the given revision
+// bytes to the event that wrote it.
+func kvToEvent(lg *zap.Logger, rev, val []byte) mvccpb.Event {
+	val, err := DecodeValue(val)
+	if err != nil {
+		lg.Panic("failed to decode stored value", zap.Error(err))
+	}
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(val); err != nil {
+		lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
+	}
+
+	ty := mvccpb.PUT
+	if isTombstone(rev) {
+		ty = mvccpb.DELETE
+		// patch in mod revision so watchers won't skip
+		kv.ModRevision = bytesToRev(rev).main
+	} else if isLeaseChange(rev) {
+		ty = mvccpb.LEASE
+		// the kv keeps its mod revision, patch in the revision of the
+		// change so watchers won't skip
+		kv.ModRevision = bytesToRev(rev).main
+	}
+	return mvccpb.Event{Kv: &kv, Type: ty}
+}
+
 // notify notifies the fact that given event at the given rev just happened to
 // watchers that watch on the key of the event.
 func (s *watchableStore) notify(rev int64, evs []mvccpb.Event) {
@@ -16,6 +16,7 @@ package mvcc
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -29,6 +30,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/traceutil"
 	"go.etcd.io/etcd/server/v3/lease"
 	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
 func TestWatch(t *testing.T) {
@@ -301,6 +303,95 @@ func TestWatchFutureRev(t *testing.T) {
 	}
 }
 
+func TestWatchSetLease(t *testing.T) {
+	b, tmpPath := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+
+	defer func() {
+		b.Close()
+		s.Close()
+		os.Remove(tmpPath)
+	}()
+
+	testKey := []byte("foo")
+	testValue := []byte("bar")
+	s.Put(testKey, testValue, lease.NoLease)
+
+	w := s.NewWatchStream()
+	w.Watch(0, testKey, nil, 0)
+
+	if n, rev := s.SetLease(testKey, 1); n != 1 || rev != 3 {
+		t.Fatalf("SetLease = (%d, %d), want (1, 3)", n, rev)
+	}
+	if n, rev := s.SetLease(testKey, 1); n != 0 || rev != 3 {
+		t.Fatalf("SetLease on same lease = (%d, %d), want (0, 3)", n, rev)
+	}
+	if n, _ := s.SetLease([]byte("missing"), 1); n != 0 {
+		t.Fatalf("SetLease on missing key = %d, want 0", n)
+	}
+
+	wev := mvccpb.Event{
+		Type: mvccpb.LEASE,
+		Kv: &mvccpb.KeyValue{
+			Key:            testKey,
+			Value:          testValue,
+			CreateRevision: 2,
+			ModRevision:    3,
+			Version:        1,
+			Lease:          1,
+		},
+	}
+	select {
+	case resp := <-w.Chan():
+		if len(resp.Events) != 1 || !reflect.DeepEqual(resp.Events[0], wev) {
+			t.Fatalf("events = %v, want [%v]", resp.Events, wev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive event in 1 second.")
+	}
+
+	// an unsynced watcher reads the lease event back from the backend
+	w.Watch(0, testKey, nil, 3)
+	select {
+	case resp := <-w.Chan():
+		if len(resp.Events) != 1 || !reflect.DeepEqual(resp.Events[0], wev) {
+			t.Fatalf("events = %v, want [%v]", resp.Events, wev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("failed to receive event in 1 second.")
+	}
+
+	// the stored key keeps its mod revision and version, also once the
+	// index is restored from the backend
+	s.Put(testKey, testValue, 1)
+	wkv := mvccpb.KeyValue{Key: testKey, Value: testValue, CreateRevision: 2, ModRevision: 4, Version: 2, Lease: 1}
+	s.Commit()
+	restored := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer restored.Close()
+	for i, kv := range []KV{s, restored} {
+		r, err := kv.Range(context.TODO(), testKey, nil, RangeOptions{Rev: 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rkv := r.KVs[0]; rkv.ModRevision != 2 || rkv.Version != 1 {
+			t.Errorf("#%d: kv at lease change = %+v, want mod revision 2 and version 1", i, rkv)
+		}
+		r, err = kv.Range(context.TODO(), testKey, nil, RangeOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(r.KVs[0], wkv) {
+			t.Errorf("#%d: kv = %+v, want %+v", i, r.KVs[0], wkv)
+		}
+	}
+	tx := b.ReadTx()
+	tx.RLock()
+	defer tx.RUnlock()
+	if !schema.UnsafeReadLeaseChanged(tx) {
+		t.Error("lease change is not recorded in the meta bucket")
+	}
+}
+
 func TestWatchRestore(t *testing.T) {
 	test := func(delay time.Duration) func(t *testing.T) {
 		return func(t *testing.T) {
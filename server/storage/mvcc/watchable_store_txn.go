@@ -17,6 +17,7 @@ package mvcc
 import (
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
 )
 
 func (tw *watchableStoreTxnWrite) End() {
@@ -33,6 +34,9 @@ func (tw *watchableStoreTxnWrite) End() {
 		if change.CreateRevision == 0 {
 			evs[i].Type = mvccpb.DELETE
 			evs[i].Kv.ModRevision = rev
+		} else if _, ok := tw.leaseChanges[i]; ok {
+			evs[i].Type = mvccpb.LEASE
+			evs[i].Kv.ModRevision = rev
 		} else {
 			evs[i].Type = mvccpb.PUT
 		}
@@ -46,11 +50,25 @@ func (tw *watchableStoreTxnWrite) End() {
 	tw.s.mu.Unlock()
 }
 
+func (tw *watchableStoreTxnWrite) SetLease(key []byte, lease lease.LeaseID) (n, rev int64) {
+	n, rev = tw.TxnWrite.SetLease(key, lease)
+	if n != 0 {
+		if tw.leaseChanges == nil {
+			tw.leaseChanges = make(map[int]struct{})
+		}
+		tw.leaseChanges[len(tw.Changes())-1] = struct{}{}
+	}
+	return n, rev
+}
+
 type watchableStoreTxnWrite struct {
 	TxnWrite
 	s *watchableStore
+	// leaseChanges holds the indexes of the changes that only changed the
+	// lease of a key.
+	leaseChanges map[int]struct{}
 }
 
 func (s *watchableStore) Write(trace *traceutil.Trace) TxnWrite {
-	return &watchableStoreTxnWrite{s.store.Write(trace), s}
+	return &watchableStoreTxnWrite{TxnWrite: s.store.Write(trace), s: s}
 }
@@ -0,0 +1,71 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"errors"
+	"path"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+var ErrInvalidKeyGlob = errors.New("mvcc: invalid key glob pattern")
+
+// NewKeySuffixFilter returns a FilterFunc that filters out events
+// on keys not ending with the given suffix.
+func NewKeySuffixFilter(suffix []byte) FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return !bytes.HasSuffix(e.Kv.Key, suffix)
+	}
+}
+
+// NewKeyGlobFilter returns a FilterFunc that filters out events on keys
+// not matching the given shell pattern, as interpreted by path.Match.
+// It returns ErrInvalidKeyGlob if the pattern is malformed.
+func NewKeyGlobFilter(pattern string) (FilterFunc, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, ErrInvalidKeyGlob
+	}
+	return func(e mvccpb.Event) bool {
+		matched, _ := path.Match(pattern, string(e.Kv.Key))
+		return !matched
+	}, nil
+}
+
+// NewValuePrefixFilter returns a FilterFunc that filters out put events
+// whose value does not begin with the given prefix.
+func NewValuePrefixFilter(prefix []byte) FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.PUT && !bytes.HasPrefix(e.Kv.Value, prefix)
+	}
+}
+
+// NewValueFilter returns a FilterFunc that filters out put events
+// whose value is not equal to the given value.
+func NewValueFilter(value []byte) FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.PUT && !bytes.Equal(e.Kv.Value, value)
+	}
+}
+
+// NewLeaseFilter returns a FilterFunc that filters out put events on keys
+// not attached to a lease if attached is true, or on keys attached to a
+// lease otherwise.
+func NewLeaseFilter(attached bool) FilterFunc {
+	return func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.PUT && (e.Kv.Lease != 0) != attached
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestWatcherFilters(t *testing.T) {
+	put := func(key, val string, lease int64) mvccpb.Event {
+		return mvccpb.Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte(key), Value: []byte(val), Lease: lease}}
+	}
+	del := func(key string) mvccpb.Event {
+		return mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte(key)}}
+	}
+	glob, err := NewKeyGlobFilter("/pods/*/status")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		filter    FilterFunc
+		ev        mvccpb.Event
+		wFiltered bool
+	}{
+		{"suffix match", NewKeySuffixFilter([]byte("/status")), put("/pods/a/status", "", 0), false},
+		{"suffix mismatch", NewKeySuffixFilter([]byte("/status")), put("/pods/a/spec", "", 0), true},
+		{"suffix mismatch delete", NewKeySuffixFilter([]byte("/status")), del("/pods/a/spec"), true},
+		{"glob match", glob, put("/pods/a/status", "", 0), false},
+		{"glob mismatch nested", glob, put("/pods/a/b/status", "", 0), true},
+		{"glob match delete", glob, del("/pods/b/status"), false},
+		{"value prefix match", NewValuePrefixFilter([]byte("{")), put("k", "{}", 0), false},
+		{"value prefix mismatch", NewValuePrefixFilter([]byte("{")), put("k", "[]", 0), true},
+		{"value prefix delete", NewValuePrefixFilter([]byte("{")), del("k"), false},
+		{"value match", NewValueFilter([]byte("ready")), put("k", "ready", 0), false},
+		{"value mismatch", NewValueFilter([]byte("ready")), put("k", "ready2", 0), true},
+		{"empty value match", NewValueFilter(nil), put("k", "", 0), false},
+		{"lease attached match", NewLeaseFilter(true), put("k", "", 1), false},
+		{"lease attached mismatch", NewLeaseFilter(true), put("k", "", 0), true},
+		{"lease detached match", NewLeaseFilter(false), put("k", "", 0), false},
+		{"lease detached mismatch", NewLeaseFilter(false), put("k", "", 1), true},
+		{"lease delete", NewLeaseFilter(true), del("k"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if filtered := tt.filter(tt.ev); filtered != tt.wFiltered {
+				t.Errorf("filtered = %v, want %v", filtered, tt.wFiltered)
+			}
+		})
+	}
+}
+
+func TestNewKeyGlobFilterInvalid(t *testing.T) {
+	if _, err := NewKeyGlobFilter("/pods/[a"); err != ErrInvalidKeyGlob {
+		t.Fatalf("expected %v, got %v", ErrInvalidKeyGlob, err)
+	}
+}
@@ -146,6 +146,8 @@ func (b *BackendQuota) Cost(v interface{}) int {
 	}
 }
 
+// costPut charges values by their uncompressed size, so that the cost is the
+// same on all members whatever their value compression.
 func costPut(r *pb.PutRequest) int { return kvOverhead + len(r.Key) + len(r.Value) }
 
 func costTxnReq(u *pb.RequestOp) int {
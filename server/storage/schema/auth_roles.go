@@ -81,7 +81,15 @@ func (atx *authReadTx) UnsafeGetRole(roleName string) *authpb.Role {
 }
 
 func (atx *authReadTx) UnsafeGetAllRoles() []*authpb.Role {
-	_, vs := atx.tx.UnsafeRange(AuthRoles, []byte{0}, []byte{0xff}, -1)
+	var vs [][]byte
+	err := atx.tx.UnsafeForEach(AuthRoles, func(k []byte, v []byte) error {
+		vs = append(vs, v)
+		return nil
+	})
+	if err != nil {
+		atx.lg.Panic("failed to get roles",
+			zap.Error(err))
+	}
 	if len(vs) == 0 {
 		return nil
 	}
@@ -26,6 +26,7 @@ var (
 	metaBucketName  = []byte("meta")
 	leaseBucketName = []byte("lease")
 	alarmBucketName = []byte("alarm")
+	quotaBucketName = []byte("quota")
 
 	clusterBucketName = []byte("cluster")
 
@@ -45,6 +46,7 @@ var (
 	Lease   = backend.Bucket(bucket{id: 3, name: leaseBucketName, safeRangeBucket: false})
 	Alarm   = backend.Bucket(bucket{id: 4, name: alarmBucketName, safeRangeBucket: false})
 	Cluster = backend.Bucket(bucket{id: 5, name: clusterBucketName, safeRangeBucket: false})
+	Quota   = backend.Bucket(bucket{id: 6, name: quotaBucketName, safeRangeBucket: false})
 
 	Members        = backend.Bucket(bucket{id: 10, name: membersBucketName, safeRangeBucket: false})
 	MembersRemoved = backend.Bucket(bucket{id: 11, name: membersRemovedBucketName, safeRangeBucket: false})
@@ -56,6 +58,9 @@ var (
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 )
 
+// AllBuckets lists the buckets that hold etcd state, excluding the test bucket.
+var AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Quota, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
+
 type bucket struct {
 	id              backend.BucketID
 	name            []byte
@@ -81,6 +86,19 @@ var (
 	ClusterDowngradeKeyName      = []byte("downgrade")
 	// Since v3.6
 	MetaStorageVersionName = []byte("storageVersion")
+	// MetaIncrementalBaseRevName is only present in incremental snapshot files
+	// and records the revision the increment was taken against.
+	MetaIncrementalBaseRevName = []byte("incrementalBaseRev")
+	// CompactRetentionKeyName records the prefixes retained by the last
+	// scheduled compaction.
+	CompactRetentionKeyName = []byte("compactRetention")
+	// MetaValueCompressedName is present once the key bucket may hold
+	// compressed values, which etcd before v3.6 cannot read.
+	MetaValueCompressedName = []byte("valueCompressed")
+	// MetaLeaseChangedName is present once the key bucket may hold
+	// revisions that only changed the lease of a key, which etcd before
+	// v3.6 cannot read.
+	MetaLeaseChangedName = []byte("leaseChanged")
 	// Before adding new meta key please update server/etcdserver/version
 )
 
@@ -0,0 +1,46 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/binary"
+
+	"go.etcd.io/bbolt"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeSetIncrementalBaseRev marks the backend as an incremental snapshot
+// taken against the given base revision.
+func UnsafeSetIncrementalBaseRev(tx backend.BatchTx, rev int64) {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, uint64(rev))
+	tx.UnsafePut(Meta, MetaIncrementalBaseRevName, bs)
+}
+
+// ReadIncrementalBaseRevFromSnapshot loads the base revision of an incremental
+// snapshot from given bbolt transaction. It returns false if the snapshot is
+// not incremental.
+func ReadIncrementalBaseRevFromSnapshot(tx *bbolt.Tx) (int64, bool) {
+	b := tx.Bucket(Meta.Name())
+	if b == nil {
+		return 0, false
+	}
+	v := b.Get(MetaIncrementalBaseRevName)
+	if len(v) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(v)), true
+}
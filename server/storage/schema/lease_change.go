@@ -0,0 +1,33 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeReadLeaseChanged returns true if the key bucket may hold revisions
+// that only changed the lease of a key.
+func UnsafeReadLeaseChanged(tx backend.ReadTx) bool {
+	_, vs := tx.UnsafeRange(Meta, MetaLeaseChangedName, nil, 0)
+	return len(vs) != 0
+}
+
+// UnsafeSetLeaseChanged records that the key bucket may hold revisions that
+// only changed the lease of a key. The record is never removed, as such
+// revisions may remain until they are compacted.
+func UnsafeSetLeaseChanged(tx backend.BatchTx) {
+	tx.UnsafePut(Meta, MetaLeaseChangedName, []byte{1})
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+type quotaBackend struct {
+	lg *zap.Logger
+	be backend.Backend
+}
+
+func NewQuotaBackend(lg *zap.Logger, be backend.Backend) *quotaBackend {
+	return &quotaBackend{
+		lg: lg,
+		be: be,
+	}
+}
+
+func (s *quotaBackend) CreateQuotaBucket() {
+	tx := s.be.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	tx.UnsafeCreateBucket(Quota)
+}
+
+func (s *quotaBackend) MustPutQuota(status *etcdserverpb.PrefixQuotaStatus) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	s.MustUnsafePutQuota(tx, status)
+}
+
+func (s *quotaBackend) MustUnsafePutQuota(tx backend.BatchTx, status *etcdserverpb.PrefixQuotaStatus) {
+	v, err := status.Marshal()
+	if err != nil {
+		s.lg.Panic("failed to marshal prefix quota", zap.Error(err))
+	}
+
+	tx.UnsafePut(Quota, status.Quota.Prefix, v)
+}
+
+func (s *quotaBackend) MustDeleteQuota(prefix []byte) {
+	tx := s.be.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	tx.UnsafeDelete(Quota, prefix)
+}
+
+func (s *quotaBackend) GetAllQuotas() ([]*etcdserverpb.PrefixQuotaStatus, error) {
+	tx := s.be.ReadTx()
+	tx.Lock()
+	defer tx.Unlock()
+	return s.unsafeGetAllQuotas(tx)
+}
+
+func (s *quotaBackend) unsafeGetAllQuotas(tx backend.ReadTx) ([]*etcdserverpb.PrefixQuotaStatus, error) {
+	var qs []*etcdserverpb.PrefixQuotaStatus
+	err := tx.UnsafeForEach(Quota, func(k, v []byte) error {
+		var q etcdserverpb.PrefixQuotaStatus
+		if err := q.Unmarshal(v); err != nil {
+			return err
+		}
+		qs = append(qs, &q)
+		return nil
+	})
+	return qs, err
+}
+
+func (s quotaBackend) ForceCommit() {
+	s.be.ForceCommit()
+}
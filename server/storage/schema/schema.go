@@ -76,6 +76,12 @@ func UnsafeMigrate(lg *zap.Logger, tx backend.BatchTx, w WALVersion, target semv
 		if minVersion != nil && target.LessThan(*minVersion) {
 			return fmt.Errorf("cannot downgrade storage, WAL contains newer entries")
 		}
+		if target.LessThan(version.V3_6) && UnsafeReadValueCompressed(tx) {
+			return fmt.Errorf("cannot downgrade storage, key values may be compressed")
+		}
+		if target.LessThan(version.V3_6) && UnsafeReadLeaseChanged(tx) {
+			return fmt.Errorf("cannot downgrade storage, keys may have lease-only revisions")
+		}
 	}
 	return plan.unsafeExecute(lg, tx)
 }
@@ -191,6 +191,34 @@ func TestMigrate(t *testing.T) {
 			expectError:    true,
 			expectErrorMsg: "cannot downgrade storage, WAL contains newer entries",
 		},
+		{
+			name:          "Downgrading v3.6 to v3.5 fails if values may be compressed",
+			version:       version.V3_6,
+			targetVersion: version.V3_5,
+			overrideKeys: func(tx backend.BatchTx) {
+				MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+				UnsafeUpdateConsistentIndex(tx, 1, 1)
+				UnsafeSetStorageVersion(tx, &version.V3_6)
+				UnsafeSetValueCompressed(tx)
+			},
+			expectVersion:  &version.V3_6,
+			expectError:    true,
+			expectErrorMsg: "cannot downgrade storage, key values may be compressed",
+		},
+		{
+			name:          "Downgrading v3.6 to v3.5 fails if keys may have lease-only revisions",
+			version:       version.V3_6,
+			targetVersion: version.V3_5,
+			overrideKeys: func(tx backend.BatchTx) {
+				MustUnsafeSaveConfStateToBackend(zap.NewNop(), tx, &raftpb.ConfState{})
+				UnsafeUpdateConsistentIndex(tx, 1, 1)
+				UnsafeSetStorageVersion(tx, &version.V3_6)
+				UnsafeSetLeaseChanged(tx)
+			},
+			expectVersion:  &version.V3_6,
+			expectError:    true,
+			expectErrorMsg: "cannot downgrade storage, keys may have lease-only revisions",
+		},
 		{
 			name:           "Downgrading v3.5 to v3.4 is not supported as schema was introduced in v3.6",
 			version:        version.V3_5,
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"go.etcd.io/etcd/api/v3/authpb"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/membershippb"
 	"go.etcd.io/etcd/api/v3/version"
@@ -128,6 +129,11 @@ func TestEtcdVersionFromMessage(t *testing.T) {
 			input:  &etcdserverpb.RequestHeader{AuthRevision: 1, Username: "Alice"},
 			expect: &version.V3_1,
 		},
+		{
+			name:   "RequestHeader Time set implies v3.6",
+			input:  &etcdserverpb.RequestHeader{Time: 1},
+			expect: &version.V3_6,
+		},
 		{
 			name:   "Setting a RequestHeader AuthRevision in subfield implies v3.1",
 			input:  &etcdserverpb.InternalRaftRequest{Header: &etcdserverpb.RequestHeader{AuthRevision: 1}},
@@ -158,6 +164,16 @@ func TestEtcdVersionFromMessage(t *testing.T) {
 			input:  &etcdserverpb.Compare{TargetUnion: &etcdserverpb.Compare_Lease{}},
 			expect: &version.V3_3,
 		},
+		{
+			name:   "Permission deny set implies v3.6",
+			input:  &etcdserverpb.AuthRoleGrantPermissionRequest{Perm: &authpb.Permission{Deny: true}},
+			expect: &version.V3_6,
+		},
+		{
+			name:   "Enum Permission type set to WATCH implies v3.6",
+			input:  &etcdserverpb.AuthRoleGrantPermissionRequest{Perm: &authpb.Permission{PermType: authpb.WATCH}},
+			expect: &version.V3_6,
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
@@ -31,6 +31,10 @@ func TestCtlV3Elect(t *testing.T) {
 	testCtl(t, testElect)
 }
 
+func TestCtlV3ElectTransfer(t *testing.T) {
+	testCtl(t, testElectTransfer)
+}
+
 func testElect(cx ctlCtx) {
 	name := "a"
 
@@ -106,6 +110,70 @@ func testElect(cx ctlCtx) {
 	}
 }
 
+func testElectTransfer(cx ctlCtx) {
+	name := "a"
+
+	holder, ch, err := ctlV3Elect(cx, name, "p1", false)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	l1 := ""
+	select {
+	case <-time.After(2 * time.Second):
+		cx.t.Fatalf("timed out electing")
+	case l1 = <-ch:
+	}
+
+	candidate, ch, err := ctlV3Elect(cx, name, "p2", false)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer func() {
+		require.NoError(cx.t, candidate.Stop())
+		candidate.Wait()
+	}()
+	select {
+	case <-time.After(100 * time.Millisecond):
+	case <-ch:
+		cx.t.Fatalf("should block")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// find the candidate key among the election keys
+	proc, err := e2e.SpawnCmd(append(cx.PrefixArgs(), "get", "--prefix", "--keys-only", name+"/"), cx.envMap)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	l2, err := proc.ExpectFunc(ctx, func(l string) bool {
+		return strings.HasPrefix(l, name+"/") && strings.TrimSpace(l) != strings.TrimSpace(l1)
+	})
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	l2 = strings.TrimSpace(l2)
+	proc.Wait()
+
+	transferArgs := append(cx.PrefixArgs(), "elect", "--transfer-to", l2, name)
+	if err = e2e.SpawnWithExpectsContext(ctx, transferArgs, cx.envMap, "Transferred leadership of "+name); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// the candidate wins the election and the former leader exits
+	select {
+	case <-time.After(time.Second):
+		cx.t.Fatalf("timed out from waiting to holding")
+	case l := <-ch:
+		if strings.TrimSpace(l) != l2 {
+			cx.t.Fatalf("expected leader key %q, got %q", l2, l)
+		}
+	}
+	if _, err = holder.Expect("elect: leadership lost"); err != nil {
+		cx.t.Fatal(err)
+	}
+	holder.Wait()
+}
+
 // ctlV3Elect creates a elect process with a channel listening for when it wins the election.
 func ctlV3Elect(cx ctlCtx, name, proposal string, expectFailure bool) (*expect.ExpectProcess, <-chan string, error) {
 	cmdArgs := append(cx.PrefixArgs(), "elect", name, proposal)
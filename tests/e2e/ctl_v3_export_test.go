@@ -0,0 +1,144 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3ExportImport(t *testing.T)           { testCtl(t, exportImportTest) }
+func TestCtlV3ExportImportDestPrefix(t *testing.T) { testCtl(t, exportImportDestPrefixTest) }
+func TestCtlV3ImportCorrupted(t *testing.T)        { testCtl(t, importCorruptedTest) }
+func TestCtlV3ExportPrefixPermission(t *testing.T) { testCtl(t, exportPrefixPermissionTest) }
+
+func exportImportTest(cx ctlCtx) {
+	kvs := []kv{{"o_key1", "val1"}, {"o_key2", "val2"}, {"o_key3", "val3"}}
+	fpath := exportTestKeys(cx, kvs)
+
+	if err := ctlV3Del(cx, []string{"o_", "--prefix"}, len(kvs)); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Import(cx, fpath, "Imported 3 of 3 keys"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"o_", "--prefix"}, kvs...); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+func exportImportDestPrefixTest(cx ctlCtx) {
+	kvs := []kv{{"o_key1", "val1"}, {"o_key2", "val2"}, {"o_key3", "val3"}}
+	fpath := exportTestKeys(cx, kvs)
+
+	if err := ctlV3Import(cx, fpath, "Imported 3 of 3 keys", "--dest-prefix", "d_"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3Get(cx, []string{"d_", "--prefix"}, kv{"d_key1", "val1"}, kv{"d_key2", "val2"}, kv{"d_key3", "val3"}); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// exportPrefixPermissionTest ensures a user only permitted to read the
+// exported prefix can export it.
+func exportPrefixPermissionTest(cx ctlCtx) {
+	kvs := []kv{{"o_key1", "val1"}, {"o_key2", "val2"}}
+	for _, v := range kvs {
+		if err := ctlV3Put(cx, v.key, v.val, ""); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+	if err := authEnable(cx); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "root", "root"
+	if err := ctlV3User(cx, []string{"add", "export-user", "--interactive=false"}, "User export-user created", []string{"pass"}); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := e2e.SpawnWithExpectWithEnv(append(cx.PrefixArgs(), "role", "add", "export-role"), cx.envMap, "Role export-role created"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := e2e.SpawnWithExpectWithEnv(append(cx.PrefixArgs(), "role", "grant-permission", "--prefix", "export-role", "read", "o_"), cx.envMap, "Role export-role updated"); err != nil {
+		cx.t.Fatal(err)
+	}
+	if err := ctlV3User(cx, []string{"grant-role", "export-user", "export-role"}, "Role export-role is granted to user export-user", nil); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	cx.user, cx.pass = "export-user", "pass"
+	fpath := filepath.Join(cx.t.TempDir(), "export")
+	cmdArgs := append(cx.PrefixArgs(), "export", "--prefix", "o_", fpath)
+	if err := e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "Exported 2 keys"); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+func importCorruptedTest(cx ctlCtx) {
+	fpath := exportTestKeys(cx, []kv{{"o_key1", "val1"}})
+
+	data, err := os.ReadFile(fpath)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	// base64("val1") -> base64("val2")
+	data = bytes.Replace(data, []byte("dmFsMQ=="), []byte("dmFsMg=="), 1)
+	if err = os.WriteFile(fpath, data, 0600); err != nil {
+		cx.t.Fatal(err)
+	}
+	err = ctlV3Import(cx, fpath, "export file checksum mismatch")
+	if err == nil || !strings.Contains(err.Error(), "unexpected exit code") {
+		cx.t.Fatalf("expected import to fail on checksum mismatch, got %v", err)
+	}
+	if err = ctlV3Get(cx, []string{"o_", "--prefix"}, kv{"o_key1", "val1"}); err != nil {
+		cx.t.Fatal(err)
+	}
+}
+
+// exportTestKeys puts kvs and exports the "o_" prefix, returning the file path.
+func exportTestKeys(cx ctlCtx, kvs []kv) string {
+	leaseID, err := ctlV3LeaseGrant(cx, 100)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	for i, v := range kvs {
+		lease := ""
+		if i == 0 {
+			lease = leaseID
+		}
+		if err = ctlV3Put(cx, v.key, v.val, lease); err != nil {
+			cx.t.Fatal(err)
+		}
+	}
+
+	fpath := filepath.Join(cx.t.TempDir(), "export")
+	cmdArgs := append(cx.PrefixArgs(), "export", "--prefix", "o_", fpath)
+	if err = e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, "Exported"); err != nil {
+		cx.t.Fatal(err)
+	}
+	return fpath
+}
+
+func ctlV3Import(cx ctlCtx, fpath, expect string, flags ...string) error {
+	cmdArgs := append(cx.PrefixArgs(), "import")
+	cmdArgs = append(cmdArgs, flags...)
+	cmdArgs = append(cmdArgs, fpath)
+	return e2e.SpawnWithExpectWithEnv(cmdArgs, cx.envMap, expect)
+}
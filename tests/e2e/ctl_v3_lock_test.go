@@ -36,6 +36,10 @@ func TestCtlV3LockWithCmd(t *testing.T) {
 	testCtl(t, testLockWithCmd)
 }
 
+func TestCtlV3LockRead(t *testing.T) {
+	testCtl(t, testLockRead)
+}
+
 func testLock(cx ctlCtx) {
 	name := "a"
 
@@ -111,6 +115,47 @@ func testLock(cx ctlCtx) {
 	}
 }
 
+func testLockRead(cx ctlCtx) {
+	name := "a"
+
+	reader, ch, err := ctlV3Lock(cx, name, "--read")
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer func() {
+		require.NoError(cx.t, reader.Stop())
+		reader.Wait()
+	}()
+	select {
+	case <-time.After(2 * time.Second):
+		cx.t.Fatalf("timed out read locking")
+	case l := <-ch:
+		if !strings.HasPrefix(l, name+"/read/") {
+			cx.t.Errorf("got %q, expected %q prefix", l, name+"/read/")
+		}
+	}
+
+	// readers share the lock
+	if err = ctlV3LockWithCmd(cx, []string{"echo"}, "", "--read", name); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// a writer waits for the reader
+	writer, ch, err := ctlV3Lock(cx, name)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	defer func() {
+		require.NoError(cx.t, writer.Stop())
+		writer.Wait()
+	}()
+	select {
+	case <-time.After(100 * time.Millisecond):
+	case <-ch:
+		cx.t.Fatalf("should block")
+	}
+}
+
 func testLockWithCmd(cx ctlCtx) {
 	// exec command with zero exit code
 	echoCmd := []string{"echo"}
@@ -127,8 +172,10 @@ func testLockWithCmd(cx ctlCtx) {
 }
 
 // ctlV3Lock creates a lock process with a channel listening for when it acquires the lock.
-func ctlV3Lock(cx ctlCtx, name string) (*expect.ExpectProcess, <-chan string, error) {
-	cmdArgs := append(cx.PrefixArgs(), "lock", name)
+func ctlV3Lock(cx ctlCtx, name string, flags ...string) (*expect.ExpectProcess, <-chan string, error) {
+	cmdArgs := append(cx.PrefixArgs(), "lock")
+	cmdArgs = append(cmdArgs, flags...)
+	cmdArgs = append(cmdArgs, name)
 	proc, err := e2e.SpawnCmd(cmdArgs, cx.envMap)
 	outc := make(chan string, 1)
 	if err != nil {
@@ -149,11 +196,15 @@ func ctlV3Lock(cx ctlCtx, name string) (*expect.ExpectProcess, <-chan string, er
 }
 
 // ctlV3LockWithCmd creates a lock process to exec command.
-func ctlV3LockWithCmd(cx ctlCtx, execCmd []string, as ...string) error {
-	// use command as lock name
-	cmdArgs := append(cx.PrefixArgs(), "lock", execCmd[0])
+func ctlV3LockWithCmd(cx ctlCtx, execCmd []string, as string, args ...string) error {
+	cmdArgs := append(cx.PrefixArgs(), "lock")
+	if len(args) == 0 {
+		// use command as lock name
+		args = execCmd[:1]
+	}
+	cmdArgs = append(cmdArgs, args...)
 	cmdArgs = append(cmdArgs, execCmd...)
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	return e2e.SpawnWithExpectsContext(ctx, cmdArgs, cx.envMap, as...)
+	return e2e.SpawnWithExpectsContext(ctx, cmdArgs, cx.envMap, as)
 }
@@ -0,0 +1,50 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/tests/v3/framework/e2e"
+)
+
+func TestCtlV3SemaphoreWithCmd(t *testing.T) {
+	testCtl(t, testSemaphoreWithCmd)
+}
+
+func testSemaphoreWithCmd(cx ctlCtx) {
+	// exec command with the holder key in its environment
+	if err := ctlV3SemaphoreWithCmd(cx, []string{"--weight", "2", "mysem", "2", "env"}, "ETCD_SEMAPHORE_KEY=mysem/"); err != nil {
+		cx.t.Fatal(err)
+	}
+
+	// weight over the limit
+	expect := "Error: semaphore: weight must be between 1 and the limit"
+	err := ctlV3SemaphoreWithCmd(cx, []string{"--weight", "3", "mysem", "2", "echo"}, expect)
+	require.ErrorContains(cx.t, err, expect)
+}
+
+// ctlV3SemaphoreWithCmd creates a semaphore process to exec command.
+func ctlV3SemaphoreWithCmd(cx ctlCtx, args []string, as ...string) error {
+	cmdArgs := append(cx.PrefixArgs(), "semaphore")
+	cmdArgs = append(cmdArgs, args...)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return e2e.SpawnWithExpectsContext(ctx, cmdArgs, cx.envMap, as...)
+}
@@ -56,6 +56,8 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock"
 	lockpb "go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore"
+	semaphorepb "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
 	"go.etcd.io/etcd/server/v3/verify"
 	framecfg "go.etcd.io/etcd/tests/v3/framework/config"
 	"go.etcd.io/etcd/tests/v3/framework/testutils"
@@ -173,6 +175,10 @@ type ClusterConfig struct {
 	ExperimentalMaxLearners     int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
+	AutoDefragRatio             float64
+	AutoDefragCheckTime         time.Duration
+	ValueCompression            string
+	PeerCompression             bool
 }
 
 type Cluster struct {
@@ -288,6 +294,10 @@ func (c *Cluster) mustNewMember(t testutil.TB) *Member {
 			ExperimentalMaxLearners:     c.Cfg.ExperimentalMaxLearners,
 			DisableStrictReconfigCheck:  c.Cfg.DisableStrictReconfigCheck,
 			CorruptCheckTime:            c.Cfg.CorruptCheckTime,
+			AutoDefragRatio:             c.Cfg.AutoDefragRatio,
+			AutoDefragCheckTime:         c.Cfg.AutoDefragCheckTime,
+			ValueCompression:            c.Cfg.ValueCompression,
+			PeerCompression:             c.Cfg.PeerCompression,
 		})
 	m.DiscoveryURL = c.Cfg.DiscoveryURL
 	return m
@@ -612,6 +622,10 @@ type MemberConfig struct {
 	ExperimentalMaxLearners     int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
+	AutoDefragRatio             float64
+	AutoDefragCheckTime         time.Duration
+	ValueCompression            string
+	PeerCompression             bool
 }
 
 // MustNewMember return an inited member with the given name. If peerTLS is
@@ -720,6 +734,13 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	if mcfg.CorruptCheckTime > time.Duration(0) {
 		m.CorruptCheckTime = mcfg.CorruptCheckTime
 	}
+	m.ExperimentalAutoDefragRatio = mcfg.AutoDefragRatio
+	m.ExperimentalAutoDefragCheckTime = embed.DefaultAutoDefragCheckTime
+	if mcfg.AutoDefragCheckTime > time.Duration(0) {
+		m.ExperimentalAutoDefragCheckTime = mcfg.AutoDefragCheckTime
+	}
+	m.ExperimentalValueCompression = mcfg.ValueCompression
+	m.ExperimentalPeerCompression = mcfg.PeerCompression
 	m.WarningApplyDuration = embed.DefaultWarningApplyDuration
 	m.WarningUnaryRequestDuration = embed.DefaultWarningUnaryRequestDuration
 	m.ExperimentalMaxLearners = membership.DefaultMaxLearners
@@ -974,6 +995,7 @@ func (m *Member) Launch() error {
 		m.ServerClient = v3client.New(m.Server)
 		lockpb.RegisterLockServer(m.GrpcServer, v3lock.NewLockServer(m.ServerClient))
 		epb.RegisterElectionServer(m.GrpcServer, v3election.NewElectionServer(m.ServerClient))
+		semaphorepb.RegisterSemaphoreServer(m.GrpcServer, v3semaphore.NewSemaphoreServer(m.ServerClient))
 		go m.GrpcServer.Serve(m.GrpcListener)
 	}
 
@@ -1540,6 +1562,8 @@ type GrpcAPI struct {
 	Lock lockpb.LockClient
 	// Election is the election API for the client'Server connection.
 	Election epb.ElectionClient
+	// Semaphore is the semaphore API for the client'Server connection.
+	Semaphore semaphorepb.SemaphoreClient
 }
 
 // GetLearnerMembers returns the list of learner members in Cluster using MemberList API.
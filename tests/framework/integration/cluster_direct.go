@@ -21,6 +21,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3election/v3electionpb"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
 )
 
 const ThroughProxy = false
@@ -35,6 +36,7 @@ func ToGRPC(c *clientv3.Client) GrpcAPI {
 		pb.NewAuthClient(c.ActiveConnection()),
 		v3lockpb.NewLockClient(c.ActiveConnection()),
 		v3electionpb.NewElectionClient(c.ActiveConnection()),
+		v3semaphorepb.NewSemaphoreClient(c.ActiveConnection()),
 	}
 }
 
@@ -72,6 +72,7 @@ func ToGRPC(c *clientv3.Client) GrpcAPI {
 	authp := grpcproxy.NewAuthProxy(c)
 	lockp := grpcproxy.NewLockProxy(c)
 	electp := grpcproxy.NewElectionProxy(c)
+	semp := grpcproxy.NewSemaphoreProxy(c)
 
 	grpc := GrpcAPI{
 		adapter.ClusterServerToClusterClient(clp),
@@ -82,6 +83,7 @@ func ToGRPC(c *clientv3.Client) GrpcAPI {
 		adapter.AuthServerToAuthClient(authp),
 		adapter.LockServerToLockClient(lockp),
 		adapter.ElectionServerToElectionClient(electp),
+		adapter.SemaphoreServerToSemaphoreClient(semp),
 	}
 	proxies[c] = grpcClientProxy{ctx: ctx, ctxCancel: ctxCancel, grpc: grpc, wdonec: wpch, kvdonec: kvpch, lpdonec: lpch}
 	return grpc
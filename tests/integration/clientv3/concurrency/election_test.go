@@ -111,3 +111,157 @@ func TestResumeElection(t *testing.T) {
 		t.Errorf("expected new leader to be 'candidate1' got %q", string(kv.Value))
 	}
 }
+
+func newElections(t *testing.T, cli *clientv3.Client, pfx string, n int) ([]*concurrency.Session, []*concurrency.Election) {
+	var ss []*concurrency.Session
+	var es []*concurrency.Election
+	for i := 0; i < n; i++ {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ss = append(ss, s)
+		es = append(es, concurrency.NewElection(s, pfx))
+	}
+	return ss, es
+}
+
+// campaignAsync campaigns in the background and waits for the candidate key
+// to be queued.
+func campaignAsync(t *testing.T, cli *clientv3.Client, pfx string, e *concurrency.Election, val string, opts ...concurrency.CampaignOption) <-chan error {
+	donec := make(chan error, 1)
+	go func() { donec <- e.Campaign(context.TODO(), val, opts...) }()
+	for {
+		resp, err := cli.Get(context.TODO(), pfx+"/", clientv3.WithPrefix())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, kv := range resp.Kvs {
+			if string(kv.Value) == val {
+				return donec
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func expectLeader(t *testing.T, e *concurrency.Election, val string) {
+	resp, err := e.Leader(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Kvs[0].Value) != val {
+		t.Fatalf("expected leader %q, got %q", val, resp.Kvs[0].Value)
+	}
+}
+
+func expectElected(t *testing.T, donec <-chan error) {
+	select {
+	case err := <-donec:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("candidate was not elected")
+	}
+}
+
+// TestElectionPriority ensures that a waiting candidate with a higher priority
+// is elected before the earlier ones without preempting the leader.
+func TestElectionPriority(t *testing.T) {
+	const prefix = "/priority-election"
+
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, es := newElections(t, cli, prefix, 3)
+	defer closeSessions(ss)
+
+	if err = es[0].Campaign(context.TODO(), "e0"); err != nil {
+		t.Fatal(err)
+	}
+	donec1 := campaignAsync(t, cli, prefix, es[1], "e1")
+	donec2 := campaignAsync(t, cli, prefix, es[2], "e2", concurrency.WithPriority(5))
+	expectLeader(t, es[0], "e0")
+
+	if err = es[0].Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	expectElected(t, donec2)
+	expectLeader(t, es[0], "e2")
+	select {
+	case err = <-donec1:
+		t.Fatalf("elected while another candidate leads (%v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err = es[2].Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	expectElected(t, donec1)
+	expectLeader(t, es[0], "e1")
+	if es[1].Rev() <= es[2].Rev() {
+		t.Errorf("expected increasing leader revisions, got %d <= %d", es[1].Rev(), es[2].Rev())
+	}
+
+	if err = es[0].Campaign(context.TODO(), "e0", concurrency.WithPriority(-1)); err != concurrency.ErrElectionInvalidPriority {
+		t.Errorf("expected %v, got %v", concurrency.ErrElectionInvalidPriority, err)
+	}
+}
+
+// TestElectionTransferTo ensures that the leader can hand leadership over to
+// a waiting candidate, which moves the earlier candidates behind it.
+func TestElectionTransferTo(t *testing.T) {
+	const prefix = "/transfer-election"
+
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, es := newElections(t, cli, prefix, 3)
+	defer closeSessions(ss)
+
+	if err = es[0].Campaign(context.TODO(), "e0"); err != nil {
+		t.Fatal(err)
+	}
+	donec1 := campaignAsync(t, cli, prefix, es[1], "e1")
+	donec2 := campaignAsync(t, cli, prefix, es[2], "e2")
+
+	resp, err := cli.Get(context.TODO(), prefix+"/", clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidate := string(resp.Kvs[2].Key)
+	if err = es[0].TransferTo(context.TODO(), es[0].Key()); err != concurrency.ErrElectionNotCandidate {
+		t.Fatalf("expected %v, got %v", concurrency.ErrElectionNotCandidate, err)
+	}
+	rev0 := es[0].Rev()
+	if err = es[0].TransferTo(context.TODO(), candidate); err != nil {
+		t.Fatal(err)
+	}
+	expectElected(t, donec2)
+	expectLeader(t, es[0], "e2")
+	if es[2].Key() != candidate || es[2].Rev() <= rev0 {
+		t.Errorf("expected leader %q after revision %d, got %q at %d", candidate, rev0, es[2].Key(), es[2].Rev())
+	}
+	if err = es[0].Proclaim(context.TODO(), "e0"); err != concurrency.ErrElectionNotLeader {
+		t.Errorf("expected %v, got %v", concurrency.ErrElectionNotLeader, err)
+	}
+
+	// the earlier candidate rejoined behind the new leader
+	select {
+	case err = <-donec1:
+		t.Fatalf("elected while another candidate leads (%v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err = es[2].Resign(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	expectElected(t, donec1)
+	expectLeader(t, es[0], "e1")
+}
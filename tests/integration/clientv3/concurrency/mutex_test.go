@@ -110,3 +110,56 @@ func TestMutexUnlock(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestMutexFencingToken ensures that a previous holder cannot pass the fencing
+// token check once another session acquired the lock.
+func TestMutexFencingToken(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	s1, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m1 := concurrency.NewMutex(s1, "/my-lock/")
+	s2, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s2.Close()
+	m2 := concurrency.NewMutex(s2, "/my-lock/")
+
+	if err = m1.Lock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	key1, token1 := m1.Key(), m1.Rev()
+
+	// the session of m1 expires and m2 takes over the lock
+	if err = s1.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = m2.Lock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if m2.Rev() <= token1 {
+		t.Fatalf("expected increasing fencing tokens, got %d <= %d", m2.Rev(), token1)
+	}
+
+	resp, err := cli.Txn(context.TODO()).If(clientv3.FencingToken(key1, token1)).Then(clientv3.OpPut("foo", "bar")).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Succeeded {
+		t.Error("expected guarded txn with a stale fencing token to fail")
+	}
+	resp, err = cli.Txn(context.TODO()).If(clientv3.FencingToken(m2.Key(), m2.Rev())).Then(clientv3.OpPut("foo", "bar")).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Succeeded {
+		t.Error("expected guarded txn to succeed while holding the lock")
+	}
+}
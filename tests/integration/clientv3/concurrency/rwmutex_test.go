@@ -0,0 +1,189 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+func newRWMutexes(t *testing.T, cli *clientv3.Client, n int) ([]*concurrency.Session, []*concurrency.RWMutex) {
+	var ss []*concurrency.Session
+	var rwms []*concurrency.RWMutex
+	for i := 0; i < n; i++ {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ss = append(ss, s)
+		rwms = append(rwms, concurrency.NewRWMutex(s, "/my-rwlock"))
+	}
+	return ss, rwms
+}
+
+// TestRWMutexWriterPreference ensures that readers arriving while a writer
+// waits are queued behind the writer.
+func TestRWMutexWriterPreference(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, rwms := newRWMutexes(t, cli, 3)
+	defer closeSessions(ss)
+
+	if err = rwms[0].RLock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	wlocked := make(chan error, 1)
+	go func() { wlocked <- rwms[1].Lock(context.TODO()) }()
+	// wait for the writer to enqueue
+	for {
+		resp, err := cli.Get(context.TODO(), "/my-rwlock/", clientv3.WithRange("/my-rwlock/read/"), clientv3.WithCountOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Count == 1 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err = rwms[2].TryRLock(context.TODO()); err != concurrency.ErrLocked {
+		t.Fatalf("expected %v, got %v", concurrency.ErrLocked, err)
+	}
+	rlocked := make(chan error, 1)
+	go func() { rlocked <- rwms[2].RLock(context.TODO()) }()
+
+	select {
+	case err = <-wlocked:
+		t.Fatalf("write locked while read locked (%v)", err)
+	case err = <-rlocked:
+		t.Fatalf("read locked while a writer is waiting (%v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err = rwms[0].RUnlock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err = <-wlocked:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("writer did not lock after read unlock")
+	}
+	select {
+	case err = <-rlocked:
+		t.Fatalf("read locked while write locked (%v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err = rwms[1].Unlock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err = <-rlocked:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reader did not lock after write unlock")
+	}
+	if rwms[1].Rev() != -1 || rwms[2].Rev() <= rwms[0].Rev() {
+		t.Errorf("unexpected lock revisions %d, %d, %d", rwms[0].Rev(), rwms[1].Rev(), rwms[2].Rev())
+	}
+}
+
+// TestRWMutexIsOwner ensures that transactions guarded by IsOwner only succeed
+// while the lock is held.
+func TestRWMutexIsOwner(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, rwms := newRWMutexes(t, cli, 1)
+	defer closeSessions(ss)
+
+	if err = rwms[0].TryLock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if rwms[0].Rev() != rwms[0].Header().Revision {
+		t.Errorf("expected revision %d, got %d", rwms[0].Header().Revision, rwms[0].Rev())
+	}
+	cmp := rwms[0].IsOwner()
+	resp, err := cli.Txn(context.TODO()).If(cmp).Then(clientv3.OpPut("foo", "bar")).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Succeeded {
+		t.Error("expected guarded txn to succeed while locked")
+	}
+
+	if err = rwms[0].Unlock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = cli.Txn(context.TODO()).If(cmp).Then(clientv3.OpPut("foo", "baz")).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Succeeded {
+		t.Error("expected guarded txn to fail after unlock")
+	}
+	if err = rwms[0].Unlock(context.TODO()); err != concurrency.ErrLockReleased {
+		t.Errorf("expected %v, got %v", concurrency.ErrLockReleased, err)
+	}
+}
+
+// TestRWMutexMutexExclusion ensures that a Mutex on the same prefix excludes
+// readers and is excluded by them.
+func TestRWMutexMutexExclusion(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, rwms := newRWMutexes(t, cli, 2)
+	defer closeSessions(ss)
+	m := concurrency.NewMutex(ss[1], "/my-rwlock")
+
+	if err = m.Lock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if err = rwms[0].TryRLock(context.TODO()); err != concurrency.ErrLocked {
+		t.Fatalf("expected %v, got %v", concurrency.ErrLocked, err)
+	}
+	if err = m.Unlock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = rwms[0].RLock(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.TryLock(context.TODO()); err != concurrency.ErrLocked {
+		t.Fatalf("expected %v, got %v", concurrency.ErrLocked, err)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrency_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+func newSemaphores(t *testing.T, cli *clientv3.Client, n int, limit int64) ([]*concurrency.Session, []*concurrency.Semaphore) {
+	var ss []*concurrency.Session
+	var sms []*concurrency.Semaphore
+	for i := 0; i < n; i++ {
+		s, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ss = append(ss, s)
+		sms = append(sms, concurrency.NewSemaphore(s, "/my-semaphore", limit))
+	}
+	return ss, sms
+}
+
+func closeSessions(ss []*concurrency.Session) {
+	for _, s := range ss {
+		s.Close()
+	}
+}
+
+// TestSemaphoreFIFO ensures that a waiter is not overtaken by a later one with
+// a smaller weight.
+func TestSemaphoreFIFO(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, sms := newSemaphores(t, cli, 3, 3)
+	defer closeSessions(ss)
+	if err = sms[0].Acquire(context.TODO(), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- sms[1].Acquire(context.TODO(), 2) }()
+	// wait for the waiter to enqueue
+	for {
+		resp, err := cli.Get(context.TODO(), "/my-semaphore/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Count == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// one is free, but the earlier waiter goes first
+	if err = sms[2].TryAcquire(context.TODO(), 1); err != concurrency.ErrSemaphoreFull {
+		t.Fatalf("expected %v, got %v", concurrency.ErrSemaphoreFull, err)
+	}
+	select {
+	case err = <-acquired:
+		t.Fatalf("acquired before release (%v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if err = sms[0].Release(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err = <-acquired:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter did not acquire after release")
+	}
+	if err = sms[2].TryAcquire(context.TODO(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if sms[1].Header().Revision >= sms[2].Header().Revision {
+		t.Errorf("expected revision %d < %d", sms[1].Header().Revision, sms[2].Header().Revision)
+	}
+}
+
+func TestSemaphoreAcquireSessionExpired(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, sms := newSemaphores(t, cli, 2, 1)
+	defer closeSessions(ss)
+	if err = sms[0].Acquire(context.TODO(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- sms[1].Acquire(context.TODO(), 1) }()
+
+	// revoke the session of the waiter before releasing
+	time.Sleep(100 * time.Millisecond)
+	if err = ss[1].Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = sms[0].Release(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-acquired; err == nil {
+		t.Error("expect session expired error")
+	}
+}
+
+func TestSemaphoreInvalidWeight(t *testing.T) {
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: exampleEndpoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	ss, sms := newSemaphores(t, cli, 1, 2)
+	defer closeSessions(ss)
+	for _, w := range []int64{0, 3} {
+		if err = sms[0].Acquire(context.TODO(), w); err != concurrency.ErrInvalidWeight {
+			t.Errorf("weight %d: expected %v, got %v", w, concurrency.ErrInvalidWeight, err)
+		}
+	}
+	if err = sms[0].Release(context.TODO()); err != concurrency.ErrSemaphoreReleased {
+		t.Errorf("expected %v, got %v", concurrency.ErrSemaphoreReleased, err)
+	}
+}
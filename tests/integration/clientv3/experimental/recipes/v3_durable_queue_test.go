@@ -0,0 +1,182 @@
+// Copyright 2016 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	recipe "go.etcd.io/etcd/client/v3/experimental/recipes"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestDurableQueueAck ensures acknowledged items are removed from the queue.
+func TestDurableQueueAck(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	s, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	q := recipe.NewDurableQueue(s, "testdq", 0)
+	for _, v := range []string{"a", "b"} {
+		if err = q.Enqueue(v); err != nil {
+			t.Fatalf("error enqueuing (%v)", err)
+		}
+	}
+	for _, v := range []string{"a", "b"} {
+		qi, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("error dequeueing (%v)", err)
+		}
+		if qi.Value() != v || qi.Retries() != 0 {
+			t.Fatalf("expected %q with 0 retries, got %q with %d", v, qi.Value(), qi.Retries())
+		}
+		if err = q.Ack(qi); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := cli.Get(context.TODO(), "testdq/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 0 {
+		t.Fatalf("expected empty queue, got %d keys", len(resp.Kvs))
+	}
+}
+
+// TestDurableQueueNack ensures negatively acknowledged items are redelivered
+// and moved to the dead-letter prefix once out of retries.
+func TestDurableQueueNack(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	s, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	q := recipe.NewDurableQueue(s, "testdq", 1)
+	if err = q.Enqueue("a"); err != nil {
+		t.Fatalf("error enqueuing (%v)", err)
+	}
+	for i := 0; i < 2; i++ {
+		qi, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("error dequeueing (%v)", err)
+		}
+		if qi.Value() != "a" || qi.Retries() != i {
+			t.Fatalf("expected %q with %d retries, got %q with %d", "a", i, qi.Value(), qi.Retries())
+		}
+		if err = q.Nack(qi); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = q.Enqueue("b"); err != nil {
+		t.Fatalf("error enqueuing (%v)", err)
+	}
+	qi, err := q.Dequeue()
+	if err != nil {
+		t.Fatalf("error dequeueing (%v)", err)
+	}
+	if qi.Value() != "b" {
+		t.Fatalf("expected %q, got %q", "b", qi.Value())
+	}
+
+	resp, err := cli.Get(context.TODO(), q.DeadLetterPrefix(), clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "a" {
+		t.Fatalf("expected dead-lettered %q, got %+v", "a", resp.Kvs)
+	}
+}
+
+// TestDurableQueueClaimExpired ensures an item claimed by an expired session
+// becomes visible to other consumers.
+func TestDurableQueueClaimExpired(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	s1, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s1.Close()
+	s2, err := concurrency.NewSession(cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s2.Close()
+
+	q1 := recipe.NewDurableQueue(s1, "testdq", -1)
+	q2 := recipe.NewDurableQueue(s2, "testdq", -1)
+	if err = q1.Enqueue("a"); err != nil {
+		t.Fatalf("error enqueuing (%v)", err)
+	}
+	qi1, err := q1.Dequeue()
+	if err != nil {
+		t.Fatalf("error dequeueing (%v)", err)
+	}
+
+	donec := make(chan *recipe.QueueItem, 1)
+	go func() {
+		qi, err := q2.Dequeue()
+		if err != nil {
+			t.Errorf("error dequeueing (%v)", err)
+		}
+		donec <- qi
+	}()
+	select {
+	case <-donec:
+		t.Fatal("dequeued claimed item")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// simulate a crashed consumer
+	if _, err = cli.Revoke(context.TODO(), s1.Lease()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case qi2 := <-donec:
+		if qi2 == nil || qi2.Value() != "a" || qi2.Retries() != 1 {
+			t.Fatalf("expected redelivered %q, got %+v", "a", qi2)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for redelivery")
+	}
+
+	if err = q1.Ack(qi1); err != recipe.ErrClaimLost {
+		t.Fatalf("expected %v, got %v", recipe.ErrClaimLost, err)
+	}
+}
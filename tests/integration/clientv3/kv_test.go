@@ -181,6 +181,66 @@ func TestKVPutWithIgnoreLease(t *testing.T) {
 	}
 }
 
+func TestKVPutWithTTL(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	if _, err := kv.Put(ctx, "foo", "bar", clientv3.WithTTL(-5*time.Second)); err != rpctypes.ErrInvalidKeyTTL {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrInvalidKeyTTL, err)
+	}
+
+	for _, key := range []string{"foo", "bar", "baz"} {
+		if _, err := kv.Put(ctx, key, "v", clientv3.WithTTL(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a put without ttl clears the expiry; a put with a new ttl extends it
+	if _, err := kv.Put(ctx, "bar", "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.Put(ctx, "baz", "v1", clientv3.WithTTL(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	rr, err := kv.Get(ctx, "baz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rr.Kvs) != 1 || rr.Kvs[0].ExpireTime == 0 {
+		t.Fatalf("expected baz with an expire time, got %v", rr.Kvs)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		rr, err = kv.Get(ctx, "foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rr.Kvs) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected foo to expire")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	for _, key := range []string{"bar", "baz"} {
+		rr, err = kv.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rr.Kvs) != 1 || string(rr.Kvs[0].Value) != "v1" {
+			t.Errorf("expected %s to survive, got %v", key, rr.Kvs)
+		}
+	}
+}
+
 func TestKVPutWithRequireLeader(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -288,6 +348,128 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
+func TestKVRangeContinue(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	keySet := []string{"foo/a", "foo/b", "foo/c", "foo/d", "foo/e"}
+	for i, key := range keySet {
+		if _, err := kv.Put(ctx, key, "v1"); err != nil {
+			t.Fatalf("#%d: couldn't put %q (%v)", i, key, err)
+		}
+	}
+
+	resp, err := kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(2))
+	if err != nil {
+		t.Fatalf("couldn't get keys (%v)", err)
+	}
+	rev := resp.Header.Revision
+	// modifications after the first page must not be observed by later pages
+	if _, err = kv.Put(ctx, "foo/d", "v2"); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	for {
+		for _, kv := range resp.Kvs {
+			if string(kv.Value) != "v1" {
+				t.Fatalf("expected value of %q at revision %d, got %q", kv.Key, rev, kv.Value)
+			}
+			keys = append(keys, string(kv.Key))
+		}
+		if len(resp.ContinueToken) == 0 {
+			break
+		}
+		resp, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(2), clientv3.WithContinue(resp.ContinueToken))
+		if err != nil {
+			t.Fatalf("couldn't get next page (%v)", err)
+		}
+	}
+	if !reflect.DeepEqual(keys, keySet) {
+		t.Fatalf("expected keys %v, got %v", keySet, keys)
+	}
+
+	_, err = kv.Get(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithContinue([]byte("garbage")))
+	if err != rpctypes.ErrInvalidContinueToken {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidContinueToken, err)
+	}
+}
+
+// TestKVHistory ensures the history of a range is listed in revision order,
+// page by page, and is bounded by the compaction revision.
+func TestKVHistory(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	type change struct {
+		typ mvccpb.Event_EventType
+		key string
+		val string
+	}
+	var want []change
+	for i := 0; i < 3; i++ {
+		for _, key := range []string{"foo/a", "foo/b"} {
+			val := fmt.Sprintf("v%d", i)
+			if _, err := kv.Put(ctx, key, val); err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, change{clientv3.EventTypePut, key, val})
+		}
+	}
+	if _, err := kv.Delete(ctx, "foo/a"); err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, change{clientv3.EventTypeDelete, "foo/a", ""})
+	if _, err := kv.Put(ctx, "bar", "v0"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []change
+	var startRev, endRev int64
+	for {
+		resp, err := kv.History(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithLimit(3),
+			clientv3.WithMinModRev(startRev), clientv3.WithMaxModRev(endRev))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, ev := range resp.Events {
+			got = append(got, change{ev.Type, string(ev.Kv.Key), string(ev.Kv.Value)})
+		}
+		if !resp.More {
+			break
+		}
+		startRev, endRev = resp.NextRevision, resp.Header.Revision
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected history %v, got %v", want, got)
+	}
+
+	if _, err := kv.Compact(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kv.History(ctx, "foo/a", clientv3.WithMinModRev(4)); err != rpctypes.ErrCompacted {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrCompacted, err)
+	}
+	resp, err := kv.History(ctx, "foo/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the put of foo/a at revision 4 is gone with the compaction
+	if len(resp.Events) != 2 || resp.Events[0].Kv.ModRevision != 6 || resp.Events[1].Type != clientv3.EventTypeDelete {
+		t.Fatalf("unexpected history after compaction %v", resp.Events)
+	}
+}
+
 func TestKVGetErrConnClosed(t *testing.T) {
 	integration2.BeforeTest(t)
 
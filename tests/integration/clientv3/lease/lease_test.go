@@ -652,6 +652,188 @@ func TestLeaseLeases(t *testing.T) {
 	}
 }
 
+// TestLeaseAttachDetach ensures keys can be moved between leases without
+// rewriting their values, and that watchers see lease events for the moves
+// only if they ask for them.
+func TestLeaseAttachDetach(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := context.TODO()
+
+	lresp, err := cli.Grant(ctx, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	presp, err := cli.Put(ctx, "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = cli.Attach(ctx, lresp.ID, "foo", "missing"); err != rpctypes.ErrKeyNotFound {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrKeyNotFound, err)
+	}
+	if _, err = cli.Attach(ctx, clientv3.LeaseID(500), "foo"); err != rpctypes.ErrLeaseNotFound {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrLeaseNotFound, err)
+	}
+
+	wch := cli.Watch(ctx, "foo", clientv3.WithRev(presp.Header.Revision+1), clientv3.WithLeaseEvents())
+	wchNoLease := cli.Watch(ctx, "foo", clientv3.WithRev(presp.Header.Revision+1))
+
+	aresp, err := cli.Attach(ctx, lresp.ID, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aresp.Attached != 1 {
+		t.Fatalf("attached expected 1, got %d", aresp.Attached)
+	}
+	if aresp, err = cli.Attach(ctx, lresp.ID, "foo"); err != nil || aresp.Attached != 0 {
+		t.Fatalf("attaching again expected 0 keys, got %v (%v)", aresp, err)
+	}
+
+	ttl, err := cli.TimeToLive(ctx, lresp.ID, clientv3.WithAttachedKeys())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ttl.Keys) != 1 || string(ttl.Keys[0]) != "foo" {
+		t.Fatalf("attached keys expected [foo], got %q", ttl.Keys)
+	}
+
+	wresp := <-wch
+	if len(wresp.Events) != 1 {
+		t.Fatalf("expected 1 event, got %v", wresp.Events)
+	}
+	ev := wresp.Events[0]
+	if ev.Type != clientv3.EventTypeLease || ev.Kv.Lease != int64(lresp.ID) || string(ev.Kv.Value) != "bar" || ev.Kv.Version != 1 {
+		t.Fatalf("unexpected lease event %v", ev)
+	}
+	if ev.Kv.ModRevision != aresp.Header.Revision {
+		t.Fatalf("lease event mod revision expected %d, got %d", aresp.Header.Revision, ev.Kv.ModRevision)
+	}
+
+	dresp, err := cli.Detach(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dresp.Detached != 1 {
+		t.Fatalf("detached expected 1, got %d", dresp.Detached)
+	}
+	wresp = <-wch
+	if len(wresp.Events) != 1 || wresp.Events[0].Type != clientv3.EventTypeLease || wresp.Events[0].Kv.Lease != 0 {
+		t.Fatalf("unexpected events %v", wresp.Events)
+	}
+
+	// revoking the lease no longer deletes the detached key
+	if _, err = cli.Revoke(ctx, lresp.ID); err != nil {
+		t.Fatal(err)
+	}
+	gresp, err := cli.Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gresp.Kvs) != 1 || string(gresp.Kvs[0].Value) != "bar" || gresp.Kvs[0].Version != 1 || gresp.Kvs[0].ModRevision != presp.Header.Revision {
+		t.Fatalf("expected foo to survive unchanged, got %v", gresp.Kvs)
+	}
+
+	// a watcher without lease events only sees the content change
+	if _, err = cli.Put(ctx, "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+	wresp = <-wchNoLease
+	if len(wresp.Events) != 1 || wresp.Events[0].Type != clientv3.EventTypePut || string(wresp.Events[0].Kv.Value) != "baz" {
+		t.Fatalf("unexpected events %v", wresp.Events)
+	}
+}
+
+// TestLeaseGrantChild ensures child leases are reported by TimeToLive and
+// are revoked, with their keys, when the parent is revoked or expires.
+func TestLeaseGrantChild(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+
+	if _, err := clus.RandClient().GrantChild(ctx, clientv3.LeaseID(500), 60); err != rpctypes.ErrParentLeaseNotFound {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrParentLeaseNotFound, err)
+	}
+
+	parent, err := clus.RandClient().Grant(ctx, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := clus.RandClient().GrantChild(ctx, parent.ID, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grandchild, err := clus.RandClient().GrantChild(ctx, child.ID, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clus.RandClient().Put(ctx, "foo", "bar", clientv3.WithLease(child.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	// query every member so both the local and the forwarded path are used
+	for i := range clus.Members {
+		cli := clus.Client(i)
+		ttl, err := cli.TimeToLive(ctx, parent.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the whole tree revoked with the lease is reported
+		if ttl.Parent != clientv3.NoLease || !reflect.DeepEqual(ttl.Children, []clientv3.LeaseID{child.ID, grandchild.ID}) {
+			t.Fatalf("unexpected parent lease tree %+v", ttl)
+		}
+		if ttl, err = cli.TimeToLive(ctx, child.ID); err != nil {
+			t.Fatal(err)
+		}
+		if ttl.Parent != parent.ID || !reflect.DeepEqual(ttl.Children, []clientv3.LeaseID{grandchild.ID}) {
+			t.Fatalf("unexpected child lease tree %+v", ttl)
+		}
+	}
+
+	if _, err = clus.RandClient().Revoke(ctx, parent.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clus.RandClient().Revoke(ctx, child.ID); err != rpctypes.ErrLeaseNotFound {
+		t.Fatalf("err expected %v, got %v", rpctypes.ErrLeaseNotFound, err)
+	}
+	gresp, err := clus.RandClient().Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gresp.Kvs) != 0 {
+		t.Fatalf("expected foo to be deleted with the child lease, got %v", gresp.Kvs)
+	}
+
+	// an expiring parent takes its children with it
+	cli := clus.RandClient()
+	if parent, err = cli.Grant(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if child, err = cli.GrantChild(ctx, parent.ID, 60); err != nil {
+		t.Fatal(err)
+	}
+	presp, err := cli.Put(ctx, "foo", "bar", clientv3.WithLease(child.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wch := cli.Watch(ctx, "foo", clientv3.WithRev(presp.Header.Revision+1))
+	select {
+	case wresp := <-wch:
+		if len(wresp.Events) != 1 || wresp.Events[0].Type != clientv3.EventTypeDelete {
+			t.Fatalf("unexpected events %v", wresp.Events)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the child lease to expire with its parent")
+	}
+}
+
 // TestLeaseRenewLostQuorum ensures keepalives work after losing quorum
 // for a while.
 func TestLeaseRenewLostQuorum(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -435,3 +436,212 @@ func TestMaintenanceStatus(t *testing.T) {
 		t.Fatal("no leader found")
 	}
 }
+
+func TestMaintenanceQuota(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+
+	_, err := cli.QuotaGet(ctx, "t/")
+	require.ErrorIs(t, err, rpctypes.ErrQuotaNotFound)
+
+	_, err = cli.QuotaSet(ctx, "t/", 2, 0)
+	require.NoError(t, err)
+	_, err = cli.QuotaSet(ctx, "u/", 0, 10)
+	require.NoError(t, err)
+
+	_, err = cli.Put(ctx, "t/a", "1")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "t/b", "2")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "t/c", "3")
+	require.ErrorIs(t, err, rpctypes.ErrPrefixQuotaExceeded)
+	_, err = cli.Txn(ctx).Then(clientv3.OpPut("t/c", "3")).Commit()
+	require.ErrorIs(t, err, rpctypes.ErrPrefixQuotaExceeded)
+	// overwriting an existing key does not grow the key count
+	_, err = cli.Put(ctx, "t/a", "11")
+	require.NoError(t, err)
+	// other prefixes remain writable
+	_, err = cli.Put(ctx, "v/a", "1")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "u/a", "12345678901")
+	require.ErrorIs(t, err, rpctypes.ErrPrefixQuotaExceeded)
+
+	resp, err := clus.Client(0).QuotaGet(ctx, "t/")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.Status.KeyCount)
+	require.Equal(t, int64(len("t/a11t/b2")), resp.Status.ByteCount)
+
+	_, err = cli.Delete(ctx, "t/b")
+	require.NoError(t, err)
+	_, err = cli.Put(ctx, "t/c", "3")
+	require.NoError(t, err)
+	// a delete in the same txn makes room for the put
+	_, err = cli.Txn(ctx).Then(clientv3.OpDelete("t/c"), clientv3.OpPut("t/d", "4")).Commit()
+	require.NoError(t, err)
+	resp, err = clus.Client(2).QuotaGet(ctx, "t/")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.Status.KeyCount)
+	require.Equal(t, int64(len("t/a11t/d4")), resp.Status.ByteCount)
+
+	_, err = cli.QuotaSet(ctx, "t/", 0, 0)
+	require.NoError(t, err)
+	list, err := clus.Client(1).QuotaList(ctx)
+	require.NoError(t, err)
+	require.Len(t, list.Statuses, 1)
+	require.Equal(t, []byte("u/"), list.Statuses[0].Quota.Prefix)
+	_, err = cli.Put(ctx, "t/e", "5")
+	require.NoError(t, err)
+}
+
+func TestMaintenanceCompactEstimate(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+
+	for i := 0; i < 3; i++ {
+		_, err := cli.Put(ctx, "foo", fmt.Sprintf("bar%d", i))
+		require.NoError(t, err)
+	}
+	_, err := cli.Put(ctx, "baz", "bar")
+	require.NoError(t, err)
+	resp, err := cli.Delete(ctx, "baz")
+	require.NoError(t, err)
+	rev := resp.Header.Revision
+
+	est, err := cli.CompactEstimate(ctx, rev)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), est.Keys)
+	require.Equal(t, int64(4), est.Revisions)
+	require.Positive(t, est.Bytes)
+	require.Equal(t, rev, est.Header.Revision)
+
+	// the estimate does not compact
+	_, err = cli.Get(ctx, "foo", clientv3.WithRev(2))
+	require.NoError(t, err)
+
+	_, err = cli.CompactEstimate(ctx, rev+1)
+	require.ErrorIs(t, err, rpctypes.ErrFutureRev)
+	_, err = cli.Compact(ctx, rev)
+	require.NoError(t, err)
+	_, err = cli.CompactEstimate(ctx, rev)
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+}
+
+func TestMaintenanceDefragmentOnline(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+	ep := clus.Members[0].GRPCURL()
+
+	for i := 0; i < 100; i++ {
+		_, err := cli.Put(ctx, fmt.Sprintf("foo%d", i), "bar")
+		require.NoError(t, err)
+	}
+	for i := 0; i < 10; i++ {
+		_, err := cli.Put(ctx, fmt.Sprintf("bar%d", i), "bar")
+		require.NoError(t, err)
+	}
+	_, err := cli.Delete(ctx, "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+
+	// keep writing while defragmenting
+	donec, errc := make(chan struct{}), make(chan error, 1)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-donec:
+				errc <- nil
+				return
+			default:
+			}
+			if _, err := cli.Put(ctx, fmt.Sprintf("bar%d", i%10), fmt.Sprint(i)); err != nil {
+				errc <- err
+				return
+			}
+		}
+	}()
+	_, err = cli.DefragmentOnline(ctx, ep)
+	close(donec)
+	require.NoError(t, err)
+	require.NoError(t, <-errc)
+
+	status, err := cli.Status(ctx, ep)
+	require.NoError(t, err)
+	require.Nil(t, status.Defrag)
+
+	resp, err := cli.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	require.Zero(t, resp.Count)
+	resp, err = cli.Get(ctx, "bar", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 10)
+
+	// the member keeps working after restarting on the defragmented file
+	clus.Members[0].Stop(t)
+	require.NoError(t, clus.Members[0].Restart(t))
+	clus.WaitLeader(t)
+	cli = clus.Client(0)
+	resp2, err := cli.Get(ctx, "bar", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Equal(t, resp.Kvs, resp2.Kvs)
+}
+
+func TestMaintenanceAutoDefrag(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{
+		Size:                3,
+		AutoDefragRatio:     0.5,
+		AutoDefragCheckTime: 100 * time.Millisecond,
+	})
+	defer clus.Terminate(t)
+
+	ctx := context.TODO()
+	cli := clus.RandClient()
+	val := strings.Repeat("a", 10*1024)
+	var rev int64
+	for i := 0; i < 200; i++ {
+		resp, err := cli.Put(ctx, fmt.Sprintf("foo%d", i), val)
+		require.NoError(t, err)
+		rev = resp.Header.Revision
+	}
+	// let every member commit the writes before freeing their space
+	time.Sleep(time.Second)
+	sizes := make([]int64, len(clus.Members))
+	for i, m := range clus.Members {
+		status, err := cli.Status(ctx, m.GRPCURL())
+		require.NoError(t, err)
+		sizes[i] = status.DbSize
+	}
+	_, err := cli.Delete(ctx, "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	_, err = cli.Compact(ctx, rev+1, clientv3.WithCompactPhysical())
+	require.NoError(t, err)
+	// the pages freed by the compaction are only reused, and counted as free,
+	// after later commits
+	for i := 0; i < 10; i++ {
+		_, err = cli.Put(ctx, "bar", "bar")
+		require.NoError(t, err)
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	for i, m := range clus.Members {
+		require.Eventually(t, func() bool {
+			status, err := cli.Status(ctx, m.GRPCURL())
+			return err == nil && status.Defrag == nil && status.DbSize < sizes[i]/2
+		}, 30*time.Second, 100*time.Millisecond, "member %d was not defragmented", i)
+	}
+}
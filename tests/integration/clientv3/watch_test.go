@@ -924,6 +924,72 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestWatchWithEventFilter checks that key, value and lease filters
+// discard the events at server side.
+func TestWatchWithEventFilter(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	cluster := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	lresp, err := client.Grant(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts  []clientv3.OpOption
+		wkeys []string
+	}{
+		{[]clientv3.OpOption{clientv3.WithFilterKeySuffix("/status")}, []string{"/pods/a/status", "/pods/b/status"}},
+		{[]clientv3.OpOption{clientv3.WithFilterKeyGlob("/pods/*/spec")}, []string{"/pods/a/spec"}},
+		{[]clientv3.OpOption{clientv3.WithFilterValuePrefix("ready")}, []string{"/pods/a/status"}},
+		{[]clientv3.OpOption{clientv3.WithFilterValue("ready")}, []string{"/pods/a/status"}},
+		{[]clientv3.OpOption{clientv3.WithFilterLeaseAttached()}, []string{"/pods/b/status"}},
+		{[]clientv3.OpOption{clientv3.WithFilterLeaseDetached(), clientv3.WithFilterKeySuffix("/spec")}, []string{"/pods/a/spec"}},
+	}
+	wchs := make([]clientv3.WatchChan, len(tests))
+	for i, tt := range tests {
+		wchs[i] = client.Watch(ctx, "/pods/", append(tt.opts, clientv3.WithPrefix())...)
+	}
+
+	if _, err = client.Put(ctx, "/pods/a/spec", "{}"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Put(ctx, "/pods/a/status", "ready"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Put(ctx, "/pods/b/status", "pending", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, tt := range tests {
+		var keys []string
+		for len(keys) < len(tt.wkeys) {
+			select {
+			case resp := <-wchs[i]:
+				for _, ev := range resp.Events {
+					keys = append(keys, string(ev.Kv.Key))
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for events, got %v", i, keys)
+			}
+		}
+		if !reflect.DeepEqual(keys, tt.wkeys) {
+			t.Errorf("#%d: expected keys %v, got %v", i, tt.wkeys, keys)
+		}
+	}
+
+	wch := client.Watch(ctx, "/pods/", clientv3.WithPrefix(), clientv3.WithFilterKeyGlob("/pods/[a"))
+	resp := <-wch
+	if !resp.Canceled {
+		t.Fatalf("expected watch with invalid glob to be canceled, got %+v", resp)
+	}
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
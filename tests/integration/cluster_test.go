@@ -518,3 +518,51 @@ func TestSpeedyTerminate(t *testing.T) {
 	case <-donec:
 	}
 }
+
+// TestClusterPeerCompression ensures that members with peer compression
+// replicate entries and snapshots to each other.
+func TestClusterPeerCompression(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:                   3,
+		SnapshotCount:          10,
+		SnapshotCatchUpEntries: 5,
+		PeerCompression:        true,
+	})
+	defer clus.Terminate(t)
+
+	clus.Members[0].InjectPartition(t, clus.Members[1:]...)
+	clus.WaitMembersForLeader(t, clus.Members[1:])
+
+	// enough entries for the leader to send a snapshot to the partitioned member
+	value := strings.Repeat("compressible", 100)
+	for i := 0; i < 15; i++ {
+		if _, err := clus.Client(1).Put(context.TODO(), fmt.Sprintf("foo%d", i), value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clus.Members[0].RecoverPartition(t, clus.Members[1:]...)
+	clus.WaitLeader(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for {
+		resp, err := clus.Client(0).Get(ctx, "foo14", clientv3.WithSerializable())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) == 1 && string(resp.Kvs[0].Value) == value {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	compressed, err := clus.Members[1].Metric("etcd_network_peer_sent_compressed_bytes_total")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed == "" || compressed == "0" {
+		t.Errorf("expected compressed peer traffic, got %q", compressed)
+	}
+}
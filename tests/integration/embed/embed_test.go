@@ -21,6 +21,7 @@ package embed_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
@@ -33,6 +34,8 @@ import (
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/archive"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 	"go.etcd.io/etcd/tests/v3/framework/testutils"
 )
@@ -185,6 +188,124 @@ func testEmbedEtcdGracefulStop(t *testing.T, secure bool) {
 	}
 }
 
+// TestEmbedEtcdAuditLog ensures audited requests are written to the audit log file.
+func TestEmbedEtcdAuditLog(t *testing.T) {
+	testutil.SkipTestIfShortMode(t, "Cannot start embedded cluster in --short tests")
+
+	cfg := embed.NewConfig()
+	urls := newEmbedURLs(false, 2)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[1]})
+	cfg.Dir = filepath.Join(t.TempDir(), "embed-etcd")
+	cfg.AuditLogOutput = filepath.Join(t.TempDir(), "audit.log")
+	cfg.AuditLogPrefixes = []string{"audited/"}
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-e.Server.ReadyNotify()
+
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{urls[0].String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "audited/foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "other/foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Delete(context.TODO(), "audited/", clientv3.WithPrefix()); err != nil {
+		t.Fatal(err)
+	}
+	cli.Close()
+	e.Close()
+
+	data, err := os.ReadFile(cfg.AuditLogOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var rec map[string]interface{}
+		if err = json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("invalid audit record %q: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 audit records, got %d: %s", len(records), data)
+	}
+	if records[0]["verb"] != "put" || records[0]["outcome"] != "success" || records[0]["revision"] != float64(2) {
+		t.Errorf("unexpected put record %v", records[0])
+	}
+	if records[1]["verb"] != "delete" || records[1]["method"] != "/etcdserverpb.KV/DeleteRange" {
+		t.Errorf("unexpected delete record %v", records[1])
+	}
+}
+
+func TestEmbedEtcdWALArchive(t *testing.T) {
+	testutil.SkipTestIfShortMode(t, "Cannot start embedded cluster in --short tests")
+
+	defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+	wal.SegmentSizeBytes = 64 * 1024
+
+	cfg := embed.NewConfig()
+	urls := newEmbedURLs(false, 2)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[1]})
+	cfg.Dir = filepath.Join(t.TempDir(), "embed-etcd")
+	cfg.WALArchiveDir = filepath.Join(t.TempDir(), "archive")
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-e.Server.ReadyNotify()
+
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{urls[0].String()}})
+	if err != nil {
+		e.Close()
+		t.Fatal(err)
+	}
+	val := strings.Repeat("a", 4096)
+	for i := 0; i < 64; i++ {
+		if _, err = cli.Put(context.TODO(), fmt.Sprintf("foo%d", i), val); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cli.Close()
+	e.Close()
+
+	// completed segments are archived when the member starts
+	e, err = embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-e.Server.ReadyNotify()
+	var walEntries int
+	for i := 0; i < 50 && walEntries < 2; i++ {
+		time.Sleep(100 * time.Millisecond)
+		m, merr := archive.ReadManifest(cfg.WALArchiveDir)
+		if merr != nil {
+			continue
+		}
+		walEntries = 0
+		for _, entry := range m.Entries {
+			if entry.Kind == archive.KindWAL {
+				walEntries++
+			}
+		}
+	}
+	e.Close()
+
+	if walEntries < 2 {
+		t.Fatalf("expected at least 2 archived WAL segments, got %d", walEntries)
+	}
+	if err = archive.Verify(cfg.WALArchiveDir); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func newEmbedURLs(secure bool, n int) (urls []url.URL) {
 	scheme := "unix"
 	if secure {
@@ -0,0 +1,167 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	clientsnapshot "go.etcd.io/etcd/client/v3/snapshot"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestSnapshotV3MergeIncremental ensures that a full snapshot merged with a
+// chain of incremental snapshots restores to the state of the latest increment.
+func TestSnapshotV3MergeIncremental(t *testing.T) {
+	integration2.BeforeTest(t)
+	testutil.SkipTestIfShortMode(t,
+		"Snapshot creation tests are depending on embedded etcd server so are integration-level tests.")
+
+	srcCfg := integration2.NewEmbedConfig(t, "default")
+	srcURLs := newEmbedURLs(t, 2)
+	srcCfg.ClusterState = "new"
+	srcCfg.LCUrls, srcCfg.ACUrls = srcURLs[:1], srcURLs[:1]
+	srcCfg.LPUrls, srcCfg.APUrls = srcURLs[1:], srcURLs[1:]
+	srcCfg.InitialCluster = fmt.Sprintf("%s=%s", srcCfg.Name, srcURLs[1].String())
+	srv, err := embed.StartEtcd(srcCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{srcCfg.ACUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	ctx := context.Background()
+	mustPut := func(k, v string) int64 {
+		resp, perr := cli.Put(ctx, k, v)
+		if perr != nil {
+			t.Fatal(perr)
+		}
+		return resp.Header.Revision
+	}
+
+	lg := zaptest.NewLogger(t)
+	dir := t.TempDir()
+	mustPut("foo1", "bar1")
+	baseRev := mustPut("foo2", "bar2")
+	basePath := filepath.Join(dir, "base.db")
+	if _, err = clientsnapshot.SaveWithVersion(ctx, lg, ccfg, basePath); err != nil {
+		t.Fatal(err)
+	}
+
+	mustPut("foo3", "bar3")
+	mustPut("foo2", "bar22")
+	dresp, err := cli.Delete(ctx, "foo1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	incPath1 := filepath.Join(dir, "inc1.db")
+	rev1, _, err := clientsnapshot.SaveIncremental(ctx, lg, ccfg, baseRev, incPath1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev1 != dresp.Header.Revision {
+		t.Fatalf("incremental snapshot revision expected %d, got %d", dresp.Header.Revision, rev1)
+	}
+
+	mustPut("foo4", "bar4")
+	if _, err = cli.Compact(ctx, rev1); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = clientsnapshot.SaveIncremental(ctx, lg, ccfg, baseRev, filepath.Join(dir, "compacted.db")); err != rpctypes.ErrCompacted {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrCompacted, err)
+	}
+	incPath2 := filepath.Join(dir, "inc2.db")
+	rev2, _, err := clientsnapshot.SaveIncremental(ctx, lg, ccfg, rev1, incPath2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sp := snapshot.NewV3(lg)
+	if err = sp.Merge(snapshot.MergeConfig{
+		BasePath:       basePath,
+		IncrementPaths: []string{incPath2},
+		OutputPath:     filepath.Join(dir, "gap.db"),
+	}); err == nil {
+		t.Fatal("expected merge to fail when increments are missing")
+	}
+	mergedPath := filepath.Join(dir, "merged.db")
+	if err = sp.Merge(snapshot.MergeConfig{
+		BasePath:       basePath,
+		IncrementPaths: []string{incPath1, incPath2},
+		OutputPath:     mergedPath,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// an increment on its own is not a restorable snapshot
+	if err = sp.Restore(snapshot.RestoreConfig{
+		SnapshotPath:        incPath1,
+		Name:                "s1",
+		OutputDataDir:       t.TempDir(),
+		InitialCluster:      "s1=http://localhost:2380",
+		InitialClusterToken: testClusterTkn,
+		PeerURLs:            []string{"http://localhost:2380"},
+	}); err == nil {
+		t.Fatal("expected restore of an incremental snapshot to fail")
+	}
+
+	cURLs, _, srvs := restoreCluster(t, 1, mergedPath)
+	defer srvs[0].Close()
+	rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{cURLs[0].String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rcli.Close()
+
+	gresp, err := rcli.Get(ctx, "foo", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gresp.Header.Revision != rev2 {
+		t.Errorf("revision expected %d, got %d", rev2, gresp.Header.Revision)
+	}
+	wantKVs := []kv{{"foo2", "bar22"}, {"foo3", "bar3"}, {"foo4", "bar4"}}
+	if len(gresp.Kvs) != len(wantKVs) {
+		t.Fatalf("expected %d keys, got %d", len(wantKVs), len(gresp.Kvs))
+	}
+	for i, want := range wantKVs {
+		if string(gresp.Kvs[i].Key) != want.k || string(gresp.Kvs[i].Value) != want.v {
+			t.Errorf("#%d: expected %s=%s, got %s=%s", i, want.k, want.v, gresp.Kvs[i].Key, gresp.Kvs[i].Value)
+		}
+	}
+	if _, err = rcli.Get(ctx, "foo", clientv3.WithPrefix(), clientv3.WithRev(baseRev)); err != rpctypes.ErrCompacted {
+		t.Errorf("expected compaction of the increment to be resumed, got %v", err)
+	}
+}
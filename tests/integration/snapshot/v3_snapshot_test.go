@@ -163,6 +163,171 @@ func TestCorruptedBackupFileCheck(t *testing.T) {
 	}
 }
 
+// TestSnapshotV3RestoreReplayWAL ensures that restoring a snapshot with WAL
+// replay recovers the keyspace as of a revision taken after the snapshot.
+func TestSnapshotV3RestoreReplayWAL(t *testing.T) {
+	integration2.BeforeTest(t)
+	testutil.SkipTestIfShortMode(t,
+		"Snapshot creation tests are depending on embedded etcd server so are integration-level tests.")
+
+	srcCfg := integration2.NewEmbedConfig(t, "default")
+	srcURLs := newEmbedURLs(t, 2)
+	srcCfg.ClusterState = "new"
+	srcCfg.LCUrls, srcCfg.ACUrls = srcURLs[:1], srcURLs[:1]
+	srcCfg.LPUrls, srcCfg.APUrls = srcURLs[1:], srcURLs[1:]
+	srcCfg.InitialCluster = fmt.Sprintf("%s=%s", srcCfg.Name, srcURLs[1].String())
+	srv, err := embed.StartEtcd(srcCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		srv.Close()
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{srcCfg.ACUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	mustPut := func(k, v string) int64 {
+		resp, perr := cli.Put(ctx, k, v)
+		if perr != nil {
+			t.Fatal(perr)
+		}
+		return resp.Header.Revision
+	}
+	mustPut("foo1", "bar1")
+	mustPut("foo2", "bar2")
+
+	sp := snapshot.NewV3(zaptest.NewLogger(t))
+	dbPath := filepath.Join(t.TempDir(), "snapshot.db")
+	if _, err = sp.Save(ctx, ccfg, dbPath); err != nil {
+		t.Fatal(err)
+	}
+
+	mustPut("foo3", "bar3")
+	targetRev := mustPut("foo2", "bar22")
+	// separate the delete from the target time
+	time.Sleep(10 * time.Millisecond)
+	targetTime := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	if _, err = cli.Delete(ctx, "foo", clientv3.WithPrefix()); err != nil {
+		t.Fatal(err)
+	}
+	cli.Close()
+	srv.Close()
+
+	srcWALDir := filepath.Join(srcCfg.Dir, "member", "wal")
+	tests := []struct {
+		name       string
+		toRevision int64
+		toTime     time.Time
+		wantRev    int64
+		wantKVs    []kv
+	}{
+		{
+			name:       "to revision before delete",
+			toRevision: targetRev,
+			wantRev:    targetRev,
+			wantKVs:    []kv{{"foo1", "bar1"}, {"foo2", "bar22"}, {"foo3", "bar3"}},
+		},
+		{
+			name:    "to time before delete",
+			toTime:  targetTime,
+			wantRev: targetRev,
+			wantKVs: []kv{{"foo1", "bar1"}, {"foo2", "bar22"}, {"foo3", "bar3"}},
+		},
+		{
+			name:    "all entries",
+			wantRev: targetRev + 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			urls := newEmbedURLs(t, 2)
+			cfg := integration2.NewEmbedConfig(t, "s1")
+			cfg.InitialClusterToken = testClusterTkn
+			cfg.ClusterState = "existing"
+			cfg.LCUrls, cfg.ACUrls = urls[:1], urls[:1]
+			cfg.LPUrls, cfg.APUrls = urls[1:], urls[1:]
+			cfg.InitialCluster = fmt.Sprintf("%s=%s", cfg.Name, urls[1].String())
+
+			if err := snapshot.NewV3(zaptest.NewLogger(t)).Restore(snapshot.RestoreConfig{
+				SnapshotPath:        dbPath,
+				Name:                cfg.Name,
+				OutputDataDir:       cfg.Dir,
+				InitialCluster:      cfg.InitialCluster,
+				InitialClusterToken: cfg.InitialClusterToken,
+				PeerURLs:            []string{urls[1].String()},
+				ReplayWALDir:        srcWALDir,
+				ToRevision:          tc.toRevision,
+				ToTime:              tc.toTime,
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			rsrv, err := embed.StartEtcd(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rsrv.Close()
+			select {
+			case <-rsrv.Server.ReadyNotify():
+			case <-time.After(3 * time.Second):
+				t.Fatalf("failed to start restored etcd member")
+			}
+
+			rcli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{cfg.ACUrls[0].String()}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rcli.Close()
+			gresp, err := rcli.Get(context.Background(), "foo", clientv3.WithPrefix())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gresp.Header.Revision != tc.wantRev {
+				t.Errorf("revision expected %d, got %d", tc.wantRev, gresp.Header.Revision)
+			}
+			if len(gresp.Kvs) != len(tc.wantKVs) {
+				t.Fatalf("expected %d keys, got %d", len(tc.wantKVs), len(gresp.Kvs))
+			}
+			for i, want := range tc.wantKVs {
+				if string(gresp.Kvs[i].Key) != want.k || string(gresp.Kvs[i].Value) != want.v {
+					t.Errorf("#%d: expected %s=%s, got %s=%s", i, want.k, want.v, gresp.Kvs[i].Key, gresp.Kvs[i].Value)
+				}
+			}
+		})
+	}
+}
+
+// TestSnapshotV3RestoreReplayWALPastEnd ensures restore fails when the WAL
+// does not reach the requested revision.
+func TestSnapshotV3RestoreReplayWALPastEnd(t *testing.T) {
+	integration2.BeforeTest(t)
+	dbPath := createSnapshotFile(t, []kv{{"foo1", "bar1"}})
+
+	cfg := integration2.NewEmbedConfig(t, "s1")
+	err := snapshot.NewV3(zaptest.NewLogger(t)).Restore(snapshot.RestoreConfig{
+		SnapshotPath:        dbPath,
+		Name:                cfg.Name,
+		OutputDataDir:       cfg.Dir,
+		InitialCluster:      fmt.Sprintf("%s=http://localhost:2380", cfg.Name),
+		InitialClusterToken: testClusterTkn,
+		PeerURLs:            []string{"http://localhost:2380"},
+		ReplayWALDir:        t.TempDir(),
+		ToRevision:          100,
+	})
+	if err == nil {
+		t.Fatal("expected restore to fail without WAL files to replay")
+	}
+}
+
 type kv struct {
 	k, v string
 }
@@ -153,6 +153,57 @@ func TestV3CompactCurrentRev(t *testing.T) {
 	}
 }
 
+// TestV3CompactRetention ensures that compaction keeps the history of the keys
+// under a retained prefix on every member.
+func TestV3CompactRetention(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	kvc := integration.ToGRPC(clus.RandClient()).KV
+	for i := 0; i < 3; i++ {
+		for _, key := range []string{"/config/a", "/status/a"} {
+			if _, err := kvc.Put(context.Background(), &pb.PutRequest{Key: []byte(key), Value: []byte(fmt.Sprintf("v%d", i))}); err != nil {
+				t.Fatalf("couldn't put key (%v)", err)
+			}
+		}
+	}
+	// revisions 2-7, compact to 6 retaining /config/ from 3
+	creq := &pb.CompactionRequest{
+		Revision:  6,
+		Physical:  true,
+		Retention: []*pb.CompactionRetention{{Prefix: []byte("/config/"), Revision: 3}},
+	}
+	if _, err := kvc.Compact(context.Background(), creq); err != nil {
+		t.Fatalf("couldn't compact kv space (%v)", err)
+	}
+
+	var hash uint32
+	for i := range clus.Members {
+		kvc := integration.ToGRPC(clus.Client(i)).KV
+		resp, err := kvc.Range(context.Background(), &pb.RangeRequest{Key: []byte("/config/a"), Revision: 3, Serializable: true})
+		if err != nil {
+			t.Fatalf("#%d: couldn't get retained key (%v)", i, err)
+		}
+		if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "v0" {
+			t.Errorf("#%d: kvs = %+v, want value v0", i, resp.Kvs)
+		}
+		_, err = kvc.Range(context.Background(), &pb.RangeRequest{Key: []byte("/status/a"), Revision: 3, Serializable: true})
+		if !eqErrGRPC(err, rpctypes.ErrGRPCCompacted) {
+			t.Errorf("#%d: err = %v, want %v", i, err, rpctypes.ErrGRPCCompacted)
+		}
+
+		hresp, err := integration.ToGRPC(clus.Client(i)).Maintenance.HashKV(context.Background(), &pb.HashKVRequest{Revision: 7})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 && hresp.Hash != hash {
+			t.Errorf("#%d: hash = %d, want %d", i, hresp.Hash, hash)
+		}
+		hash = hresp.Hash
+	}
+}
+
 // TestV3HashKV ensures that multiple calls of HashKV on same node return same hash and compact rev.
 func TestV3HashKV(t *testing.T) {
 	integration.BeforeTest(t)
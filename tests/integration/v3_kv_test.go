@@ -16,6 +16,7 @@ package integration
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -78,3 +79,46 @@ func TestKVWithEmptyValue(t *testing.T) {
 	}
 	t.Logf("delete keys:%d", respDel.Deleted)
 }
+
+// TestKVValueCompression ensures that compressed values are returned unchanged
+// by ranges and watches on every member.
+func TestKVValueCompression(t *testing.T) {
+	for _, compressor := range []string{"flate", "snappy", "zstd"} {
+		t.Run(compressor, func(t *testing.T) {
+			testKVValueCompression(t, compressor)
+		})
+	}
+}
+
+func testKVValueCompression(t *testing.T, compressor string) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, ValueCompression: compressor})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	value := strings.Repeat("compressible", 1000)
+	presp, err := clus.RandClient().Put(ctx, "foo", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range clus.Members {
+		c := clus.Client(i)
+		resp, err := c.Get(ctx, "foo", clientv3.WithRev(presp.Header.Revision))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != value {
+			t.Fatalf("#%d: unexpected range response %+v", i, resp.Kvs)
+		}
+
+		wresp := <-c.Watch(ctx, "foo", clientv3.WithRev(presp.Header.Revision))
+		if err := wresp.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Value) != value {
+			t.Fatalf("#%d: unexpected watch events %+v", i, wresp.Events)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	epb "go.etcd.io/etcd/server/v3/etcdserver/api/v3election/v3electionpb"
 	"go.etcd.io/etcd/tests/v3/framework/integration"
 )
@@ -175,3 +176,87 @@ func TestV3ElectionObserve(t *testing.T) {
 
 	<-leader2c
 }
+
+// TestV3ElectionTransfer checks that Transfer hands leadership over to the
+// given campaigner, and that priorities order the waiting campaigners.
+func TestV3ElectionTransfer(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	var leases []int64
+	for i := 0; i < 3; i++ {
+		resp, err := integration.ToGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, resp.ID)
+	}
+
+	lc := integration.ToGRPC(clus.Client(0)).Election
+	req := &epb.CampaignRequest{Name: []byte("foo"), Lease: leases[0], Value: []byte("abc")}
+	l1, err := lc.Campaign(context.TODO(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	campaignc := make(chan *epb.CampaignResponse, 2)
+	for i, prio := range []int64{0, 3} {
+		req := &epb.CampaignRequest{Name: []byte("foo"), Lease: leases[i+1], Value: []byte(fmt.Sprintf("c%d", i)), Priority: prio}
+		go func() {
+			resp, cerr := lc.Campaign(context.TODO(), req)
+			if cerr != nil {
+				t.Error(cerr)
+			}
+			campaignc <- resp
+		}()
+	}
+
+	// wait for both campaigners to queue
+	cli := clus.Client(0)
+	var candidate []byte
+	for candidate == nil {
+		resp, err := cli.Get(context.TODO(), "foo/", clientv3.WithPrefix())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) == 3 {
+			for _, kv := range resp.Kvs {
+				if string(kv.Value) == "c0" {
+					candidate = kv.Key
+				}
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the prioritized campaigner queued ahead, but the transfer picks c0
+	if _, err = lc.Transfer(context.TODO(), &epb.TransferRequest{Leader: l1.Leader, Candidate: candidate}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-campaignc:
+		if string(resp.Leader.Key) != string(candidate) {
+			t.Fatalf("expected leader %q, got %q", candidate, resp.Leader.Key)
+		}
+		if resp.Leader.Rev <= l1.Leader.Rev {
+			t.Fatalf("expected increasing leader revisions, got %d <= %d", resp.Leader.Rev, l1.Leader.Rev)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("campaigner was not elected after transfer")
+	}
+
+	if _, err = lc.Transfer(context.TODO(), &epb.TransferRequest{Leader: l1.Leader, Candidate: candidate}); err == nil {
+		t.Fatal("expected transfer by a former leader to fail")
+	}
+
+	// the remaining campaigner is elected once the new leader is gone
+	if _, err = integration.ToGRPC(cli).Lease.LeaseRevoke(context.TODO(), &pb.LeaseRevokeRequest{ID: leases[1]}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-campaignc:
+	case <-time.After(5 * time.Second):
+		t.Fatal("campaigner was not elected after leader lease revocation")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	lockpb "go.etcd.io/etcd/server/v3/etcdserver/api/v3lock/v3lockpb"
 	"go.etcd.io/etcd/tests/v3/framework/integration"
 )
@@ -74,3 +75,59 @@ func TestV3LockLockWaiter(t *testing.T) {
 	case <-lockc:
 	}
 }
+
+// TestV3LockFencingToken tests that Lock returns increasing fencing tokens that
+// stop guarding transactions once the lock is lost.
+func TestV3LockFencingToken(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	lease1, err := integration.ToGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease2, err := integration.ToGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lc := integration.ToGRPC(clus.Client(0)).Lock
+	l1, err := lc.Lock(context.TODO(), &lockpb.LockRequest{Name: []byte("foo"), Lease: lease1.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l1.Rev != l1.Header.Revision {
+		t.Fatalf("expected fencing token %d, got %d", l1.Header.Revision, l1.Rev)
+	}
+
+	cli := clus.Client(0)
+	guarded := func(l *lockpb.LockResponse) bool {
+		resp, err := cli.Txn(context.TODO()).If(clientv3.FencingToken(string(l.Key), l.Rev)).Then(clientv3.OpPut("bar", "baz")).Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Succeeded
+	}
+	if !guarded(l1) {
+		t.Fatal("expected guarded txn to succeed while holding the lock")
+	}
+
+	// the lease of the first holder expires while it is paused
+	if _, err = integration.ToGRPC(cli).Lease.LeaseRevoke(context.TODO(), &pb.LeaseRevokeRequest{ID: lease1.ID}); err != nil {
+		t.Fatal(err)
+	}
+	l2, err := lc.Lock(context.TODO(), &lockpb.LockRequest{Name: []byte("foo"), Lease: lease2.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l2.Rev <= l1.Rev {
+		t.Fatalf("expected increasing fencing tokens, got %d <= %d", l2.Rev, l1.Rev)
+	}
+	if guarded(l1) {
+		t.Fatal("expected guarded txn with a stale fencing token to fail")
+	}
+	if !guarded(l2) {
+		t.Fatal("expected guarded txn to succeed while holding the lock")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	semaphorepb "go.etcd.io/etcd/server/v3/etcdserver/api/v3semaphore/v3semaphorepb"
+	"go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+// TestV3SemaphoreAcquireWaiter tests that a client will wait for enough weight
+// on a semaphore, then acquire it once an earlier holder releases.
+func TestV3SemaphoreAcquireWaiter(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	var leases []int64
+	for i := 0; i < 3; i++ {
+		resp, err := integration.ToGRPC(clus.RandClient()).Lease.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+		if err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, resp.ID)
+	}
+
+	sc := integration.ToGRPC(clus.Client(0)).Semaphore
+	// the default weight of 1 leaves room for a second holder
+	s1, err := sc.Acquire(context.TODO(), &semaphorepb.AcquireRequest{Name: []byte("foo"), Limit: 2, Lease: leases[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := sc.Acquire(context.TODO(), &semaphorepb.AcquireRequest{Name: []byte("foo"), Limit: 2, Lease: leases[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acquirec := make(chan struct{})
+	go func() {
+		s3, serr := sc.Acquire(context.TODO(), &semaphorepb.AcquireRequest{Name: []byte("foo"), Limit: 2, Weight: 2, Lease: leases[2]})
+		if serr != nil {
+			t.Error(serr)
+		} else if s2.Header.Revision >= s3.Header.Revision {
+			t.Errorf("expected s2 revision < s3 revision, got %d >= %d", s2.Header.Revision, s3.Header.Revision)
+		}
+		close(acquirec)
+	}()
+
+	if _, err = sc.Release(context.TODO(), &semaphorepb.ReleaseRequest{Key: s1.Key}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-time.After(200 * time.Millisecond):
+	case <-acquirec:
+		t.Fatalf("acquired before enough weight was released")
+	}
+
+	if _, err = sc.Release(context.TODO(), &semaphorepb.ReleaseRequest{Key: s2.Key}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-time.After(200 * time.Millisecond):
+		t.Fatalf("waiter did not acquire after release")
+	case <-acquirec:
+	}
+}
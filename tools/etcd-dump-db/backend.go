@@ -26,6 +26,7 @@ import (
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/server/v3/lease/leasepb"
 	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
@@ -81,6 +82,10 @@ func defaultDecoder(k, v []byte) {
 
 func keyDecoder(k, v []byte) {
 	rev := bytesToRev(k)
+	v, err := mvcc.DecodeValue(v)
+	if err != nil {
+		panic(err)
+	}
 	var kv mvccpb.KeyValue
 	if err := kv.Unmarshal(v); err != nil {
 		panic(err)